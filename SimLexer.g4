@@ -2,9 +2,25 @@ lexer grammar SimLexer;
 
 // Keywords
 FUNCTION: 'function';
+FN: 'fn';
+TYPE: 'type';
+CONST: 'const';
+VAR: 'var';
+STRUCT: 'struct';
+ENUM: 'enum';
+ORDERED: 'ordered';
+BY: 'by';
+MATCH: 'match';
+SWITCH: 'switch';
+CASE: 'case';
+DEFAULT: 'default';
 IF: 'if';
+ELSE: 'else';
 LOOP: 'loop';
 TO: 'to';
+THROUGH: 'through';
+STEP: 'step';
+IN: 'in';
 RETURN: 'return';
 BREAK: 'break';
 CONTINUE: 'continue';
@@ -24,13 +40,24 @@ DIVIDE: '/';
 ADD: '+';
 SUBTRACT: '-';
 MODULO: '%';
+BITWISE_AND: '&';
+BITWISE_XOR: '^';
+BITWISE_NOT: '~';
+LEFT_SHIFT: '<<';
+RIGHT_SHIFT: '>>';
 
 ASSIGNMENT: '=';
+DECLARE_ASSIGNMENT: ':=';
 ADD_ASSIGNMENT: '+=';
 SUB_ASSIGNMENT: '-=';
 MUL_ASSIGNMENT: '*=';
 DIV_ASSIGNMENT: '/=';
 MOD_ASSIGNMENT: '%=';
+BITWISE_AND_ASSIGNMENT: '&=';
+BITWISE_OR_ASSIGNMENT: '|=';
+BITWISE_XOR_ASSIGNMENT: '^=';
+LEFT_SHIFT_ASSIGNMENT: '<<=';
+RIGHT_SHIFT_ASSIGNMENT: '>>=';
 
 EQUALS: '==';
 NOT_EQUALS: '!=';
@@ -45,15 +72,27 @@ RPAREN: ')';
 LBRACE: '{';
 RBRACE: '}';
 
+LBRACKET: '[';
+RBRACKET: ']';
+
 COLON: ':';
+SEMICOLON: ';';
 
 COMMA: ',';
+DOT: '.';
+PIPE: '|';
+ARROW: '=>';
 
-fragment LETTER: [a-z|A-Z] | '_';
+fragment LETTER: [a-zA-Z_];
 fragment DIGIT: [0-9];
 
 NUMBER: DIGIT+ ([.] DIGIT+)?;
 
+// Escape sequences are checked by the interpreter so that invalid ones can be reported clearly
+MULTILINE_STRING: '"""' .*? '"""';
+STRING: '"' ('\\' . | ~["\\\r\n])* '"';
+RAW_STRING: '`' ~'`'* '`';
+
 IDENTIFIER: LETTER (LETTER | DIGIT)*;
 
 NEWLINE: [\r\n]+ -> channel(HIDDEN);
@@ -8,28 +8,50 @@ start: (statement eos)*;
 
 statement:
 	LBRACE statement* RBRACE																# BlockStatement
-	| IF expression statement																# IfStatement
-	| LOOP statement																		# InfiniteLoopStatement
-	| LOOP expression statement																# ConditionalLoopStatement
-	| LOOP IDENTIFIER ASSIGNMENT min = expression TO max = expression statement				# LoopStatement
-	| FUNCTION IDENTIFIER LPAREN (expression (COMMA expression)*)? RPAREN COLON IDENTIFIER	#
-		FunctionStatement
-	| type_ = IDENTIFIER varName = IDENTIFIER (
+	| IF expression body = statement (ELSE elseBody = statement)?							# IfStatement
+	| (label = IDENTIFIER COLON)? LOOP statement												# InfiniteLoopStatement
+	| (label = IDENTIFIER COLON)? LOOP expression statement										# ConditionalLoopStatement
+	| (label = IDENTIFIER COLON)? LOOP (indexName = IDENTIFIER COMMA)? varName = IDENTIFIER ASSIGNMENT min = expression (
+		TO
+		| inclusive = THROUGH
+	) max = expression (STEP step = expression)? statement	# LoopStatement
+	| (label = IDENTIFIER COLON)? LOOP (indexName = IDENTIFIER COMMA)? varName = IDENTIFIER IN value = expression statement	# ForEachStatement
+	| FUNCTION funcName = IDENTIFIER LPAREN (
+		parameter (COMMA parameter)*
+	)? RPAREN COLON returnType = typeSpec body = statement			# FunctionStatement
+	| TYPE typeName = IDENTIFIER STRUCT LBRACE (structField SEMICOLON?)* RBRACE (ORDERED BY compareName = IDENTIFIER)?	# StructStatement
+	| ENUM typeName = IDENTIFIER LBRACE enumMember (COMMA enumMember)* COMMA? RBRACE	# EnumStatement
+	| TYPE typeName = IDENTIFIER ASSIGNMENT unionVariant (PIPE unionVariant)* (ORDERED BY compareName = IDENTIFIER)?	# UnionStatement
+	| MATCH value = expression LBRACE matchCase* RBRACE								# MatchStatement
+	| SWITCH value = expression LBRACE switchCase* RBRACE							# SwitchStatement
+	| type_ = typeSpec varName = IDENTIFIER (
 		ASSIGNMENT expression
 	)?												# DeclarationStatement
-	| varName = IDENTIFIER assignment_op expression	# AssignmentStatement
+	| CONST type_ = typeSpec varName = IDENTIFIER ASSIGNMENT value = expression	# ConstStatement
+	| VAR varName = IDENTIFIER ASSIGNMENT value = expression				# InferredDeclarationStatement
+	| varName = IDENTIFIER DECLARE_ASSIGNMENT value = expression			# InferredDeclarationStatement
+	| target = expression assignment_op value = expression	# AssignmentStatement
 	| RETURN expression								# ReturnStatement
 	| PRINT LPAREN expression RPAREN				# PrintStatement // TODO: remove this
 	| RETURN										# ReturnStatement
-	| BREAK											# BreakStatement
-	| CONTINUE										# ContinueStatement;
+	| BREAK ({!lineTerminatorAhead(p)}? label = IDENTIFIER)?		# BreakStatement
+	| CONTINUE ({!lineTerminatorAhead(p)}? label = IDENTIFIER)?	# ContinueStatement;
 
 expression:
 	LPAREN expression RPAREN													# ParensExpression
+	| value = expression LBRACKET index = expression RBRACKET					# IndexExpression
+	| value = expression LBRACKET low = expression? COLON high = expression? RBRACKET	# SliceExpression
+	| value = expression DOT fieldName = IDENTIFIER								# FieldExpression
+	| callee = expression LPAREN (expression (COMMA expression)*)? RPAREN		# InvokeExpression
 	| SUBTRACT expression														# NegateExpression
 	| NOT expression															# NotExpression
+	| BITWISE_NOT expression													# BitwiseNotExpression
 	| left = expression op = (MULTIPLY | DIVIDE | MODULO) right = expression	# MulDivModExpression
 	| left = expression op = (ADD | SUBTRACT) right = expression				# AddSubExpression
+	| left = expression op = (LEFT_SHIFT | RIGHT_SHIFT) right = expression		# ShiftExpression
+	| left = expression op = BITWISE_AND right = expression					# BitwiseAndExpression
+	| left = expression op = BITWISE_XOR right = expression					# BitwiseXorExpression
+	| left = expression op = PIPE right = expression							# BitwiseOrExpression
 	| left = expression op = (
 		GREATER
 		| LESSER
@@ -39,8 +61,48 @@ expression:
 	| left = expression op = (EQUALS | NOT_EQUALS) right = expression	# EqualityExpression
 	| left = expression AND right = expression							# AndExpression
 	| left = expression OR right = expression							# OrExpression
+	| FN LPAREN (parameter (COMMA parameter)*)? RPAREN COLON returnType = typeSpec body = statement	# FunctionExpression
+	| IDENTIFIER LPAREN (expression (COMMA expression)*)? RPAREN		# CallExpression
 	| IDENTIFIER														# VariableExpression
-	| (NUMBER | TRUE | FALSE)											# LiteralExpression;
+	| LBRACKET (expression (COMMA expression)*)? RBRACKET				# ArrayExpression
+	| LBRACE (mapEntry (COMMA mapEntry)*)? RBRACE						# MapExpression
+	| (
+		NUMBER
+		| TRUE
+		| FALSE
+		| STRING
+		| MULTILINE_STRING
+		| RAW_STRING
+	) # LiteralExpression;
+
+typeSpec:
+	IDENTIFIER (
+		LBRACKET keyType = typeSpec RBRACKET valueType = typeSpec
+		| (LBRACKET NUMBER? RBRACKET)*
+	)
+	| FN LPAREN (typeSpec (COMMA typeSpec)*)? RPAREN COLON returnType = typeSpec;
+
+parameter: type_ = typeSpec paramName = IDENTIFIER;
+
+structField: type_ = typeSpec fieldName = IDENTIFIER;
+
+enumMember: memberName = IDENTIFIER;
+
+unionVariant:
+	variantName = IDENTIFIER (
+		LPAREN (structField (COMMA structField)*)? RPAREN
+	)?;
+
+matchCase:
+	caseName = IDENTIFIER (
+		LPAREN (matchBinding (COMMA matchBinding)*)? RPAREN
+	)? ARROW body = statement;
+
+matchBinding: bindingName = IDENTIFIER;
+
+switchCase: (CASE expression (COMMA expression)* | DEFAULT) COLON statement*;
+
+mapEntry: key = expression COLON value = expression;
 
 assignment_op:
 	ASSIGNMENT
@@ -48,7 +110,12 @@ assignment_op:
 	| SUB_ASSIGNMENT
 	| MUL_ASSIGNMENT
 	| DIV_ASSIGNMENT
-	| MOD_ASSIGNMENT;
+	| MOD_ASSIGNMENT
+	| BITWISE_AND_ASSIGNMENT
+	| BITWISE_OR_ASSIGNMENT
+	| BITWISE_XOR_ASSIGNMENT
+	| LEFT_SHIFT_ASSIGNMENT
+	| RIGHT_SHIFT_ASSIGNMENT;
 
 eos:
 	EOF
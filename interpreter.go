@@ -29,6 +29,8 @@ func main() {
 
 	p := parser.NewSimParser(stream)
 	errListener := listener.NewSimErrorListener(antlr.NewDiagnosticErrorListener(false))
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errListener)
 	p.BuildParseTrees = true
 
 	var buf bytes.Buffer
@@ -41,10 +43,30 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err, ok := visitor.Visit(tree).(error); ok && err != nil {
+	exitCode, err := run(visitor, tree)
+
+	fmt.Print(buf.String())
+
+	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
-	fmt.Println(buf.String())
+	os.Exit(int(exitCode))
+}
+
+// run visits the top level statements, which declares everything that main can use, then calls main.
+// Returning a value from the top level ends the program with that value before main is called.
+func run(simVisitor *visitor.SimVisitor, tree parser.IStartContext) (int32, error) {
+	result := simVisitor.Visit(tree)
+	if err, ok := result.(error); ok && err != nil {
+		return 0, err
+	}
+
+	if value, ok := result.(interpreter.Value); ok {
+		exitCode, err := value.GetInt(interpreter.NewParseContext(tree.GetStop().GetLine(), tree.GetStop().GetColumn()))
+		return int32(exitCode), err
+	}
+
+	return simVisitor.CallMain()
 }
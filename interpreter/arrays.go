@@ -0,0 +1,240 @@
+package interpreter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ArrayTypeName returns the name of the array type with the given element type and length.
+// Arrays of arrays put the outer length first, so an array of 3 int[4]s is an int[3][4].
+func ArrayTypeName(elementTypeName string, length int) string {
+	dimensions := strconv.Itoa(length)
+
+	if i := strings.Index(elementTypeName, "["); i >= 0 {
+		return elementTypeName[:i] + "[" + dimensions + "]" + elementTypeName[i:]
+	}
+
+	return elementTypeName + "[" + dimensions + "]"
+}
+
+// ConstructArray returns a new value of the given array type holding the given elements.
+// There must be exactly as many elements as the array's length.
+// Each value's parse context is used to report a mismatched element type.
+func (interpreter *SimInterpreter) ConstructArray(context ParseContext, typeName string, values []Value, valueContexts []ParseContext) (Value, error) {
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if !typeData.IsArray() {
+		err := InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+		return NewErrorValue(err), err
+	}
+
+	if len(values) != typeData.length {
+		err := MismatchedArrayLengthErr{Context: context, TypeName: typeName, Expected: typeData.length, Actual: len(values)}
+		return NewErrorValue(err), err
+	}
+
+	elements := make([]Value, len(values))
+	for i, value := range values {
+		element, err := interpreter.castElement(valueContexts[i], typeData, value)
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		elements[i] = element
+	}
+
+	return NewArrayValue(typeName, elements), nil
+}
+
+// IndexArray returns the element at the given index of an array or list value.
+func (interpreter *SimInterpreter) IndexArray(context ParseContext, value Value, index int64) (Value, error) {
+	elements, err := value.GetElements()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if index < 0 || int(index) >= len(elements) {
+		err := IndexOutOfRangeErr{Context: context, Index: int(index), Length: len(elements)}
+		return NewErrorValue(err), err
+	}
+
+	return elements[index], nil
+}
+
+// SetElement returns a copy of an array or list value with the element at the given index set to the given value.
+// The value must be implicitly castable to the element type.
+func (interpreter *SimInterpreter) SetElement(context ParseContext, value Value, index int64, element Value) (Value, error) {
+	typeName, err := value.GetType()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if !typeData.IsArray() && !typeData.IsList() {
+		err := InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+		return NewErrorValue(err), err
+	}
+
+	elements, err := value.GetElements()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if index < 0 || int(index) >= len(elements) {
+		err := IndexOutOfRangeErr{Context: context, Index: int(index), Length: len(elements)}
+		return NewErrorValue(err), err
+	}
+
+	element, err = interpreter.castElement(context, typeData, element)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	elements[index] = element
+
+	return Value{typeName: typeName, items: elements}, nil
+}
+
+// Helper function to declare an array type of the given element type and length.
+func (interpreter *SimInterpreter) addArrayType(context ParseContext, typeName string, elementTypeName string, length int) (TypeData, error) {
+	elementTypeData, err := interpreter.GetTypeData(context, elementTypeName)
+	if err != nil {
+		return TypeData{}, err
+	}
+
+	elements := make([]Value, length)
+	for i := range elements {
+		elements[i] = elementTypeData.zeroValue
+	}
+
+	typeData := TypeData{
+		zeroValue:       NewArrayValue(typeName, elements),
+		typeInfo:        TypeInfoArray,
+		elementTypeName: elementTypeName,
+		length:          length,
+		implicitCastMap: map[string]struct{}{},
+	}
+
+	interpreter.types[typeName] = typeData
+
+	return typeData, nil
+}
+
+// Helper function to cast a value to the element type of an array.
+func (interpreter *SimInterpreter) castElement(context ParseContext, typeData TypeData, value Value) (Value, error) {
+	valueTypeName, err := value.GetType()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	result, ok := interpreter.ImplicitlyCast(context, value, typeData.elementTypeName)
+	if !ok {
+		err := MismatchedElementTypeErr{Context: context, TypeName: typeData.GetTypeName(), ElementTypeName: typeData.elementTypeName, ValueTypeName: valueTypeName}
+		return NewErrorValue(err), err
+	}
+
+	return result, nil
+}
+
+// Helper function to cast an array literal to the given array or list type.
+// Returns false if the literal's length or any of its elements don't fit the type.
+func (interpreter *SimInterpreter) castUntypedArray(context ParseContext, value Value, typeData TypeData) (Value, bool) {
+	if !typeData.IsList() && (!typeData.IsArray() || len(value.items) != typeData.length) {
+		return value, false
+	}
+
+	elements := make([]Value, len(value.items))
+	for i, element := range value.items {
+		castElement, ok := interpreter.ImplicitlyCast(context, element, typeData.elementTypeName)
+		if !ok {
+			return value, false
+		}
+
+		elements[i] = castElement
+	}
+
+	return Value{typeName: typeData.GetTypeName(), items: elements}, true
+}
+
+// Helper function to give an array literal a concrete array type when there is no type for it to take on.
+func (interpreter *SimInterpreter) typeUntypedArray(context ParseContext, value Value) (Value, error) {
+	if len(value.items) == 0 {
+		err := UntypedArrayErr{Context: context}
+		return NewErrorValue(err), err
+	}
+
+	elementTypeName, err := interpreter.defaultTypeName(context, value.items)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	valueContexts := make([]ParseContext, len(value.items))
+	for i := range valueContexts {
+		valueContexts[i] = context
+	}
+
+	return interpreter.ConstructArray(context, ArrayTypeName(elementTypeName, len(value.items)), value.items, valueContexts)
+}
+
+// Helper function to choose the type of the values in an array or map literal when there is no type for them to take on.
+// The type is the type of the first value, using float for untyped numbers if any of the values is an untyped float.
+func (interpreter *SimInterpreter) defaultTypeName(context ParseContext, values []Value) (string, error) {
+	first := values[0]
+
+	switch first.typeName {
+	case "untyped array":
+		typedFirst, err := interpreter.typeUntypedArray(context, first)
+		if err != nil {
+			return "", err
+		}
+
+		first = typedFirst
+
+	case "untyped map":
+		typedFirst, err := interpreter.typeUntypedMap(context, first)
+		if err != nil {
+			return "", err
+		}
+
+		first = typedFirst
+	}
+
+	typeName := first.typeName
+	if typeName == "untyped int" || typeName == "untyped float" {
+		typeName = "int"
+
+		for _, value := range values {
+			if value.typeName == "untyped float" {
+				typeName = "float"
+				break
+			}
+		}
+	}
+
+	return typeName, nil
+}
+
+// Helper function to split an array type name, such as int[3][4], into its element type name and length, such as int[4] and 3.
+// Returns false if the type name isn't an array type name.
+func parseArrayTypeName(typeName string) (string, int, bool) {
+	open := strings.Index(typeName, "[")
+	if open <= 0 || !strings.HasSuffix(typeName, "]") {
+		return "", 0, false
+	}
+
+	end := open + strings.Index(typeName[open:], "]")
+
+	length, err := strconv.Atoi(typeName[open+1 : end])
+	if err != nil || length < 0 {
+		return "", 0, false
+	}
+
+	return typeName[:open] + typeName[end+1:], length, true
+}
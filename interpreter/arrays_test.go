@@ -0,0 +1,227 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayTypeName(t *testing.T) {
+	assert.Equal(t, "int[3]", ArrayTypeName("int", 3))
+	assert.Equal(t, "int[3][4]", ArrayTypeName("int[4]", 3))
+
+	elementTypeName, length, ok := parseArrayTypeName("int[3][4]")
+	assert.True(t, ok)
+	assert.Equal(t, "int[4]", elementTypeName)
+	assert.Equal(t, 3, length)
+
+	for _, typeName := range []string{"int", "[3]", "int[]", "int[x]", "int[1.5]", "int[3]x"} {
+		_, _, ok := parseArrayTypeName(typeName)
+		assert.False(t, ok, typeName)
+	}
+}
+
+func TestInterpreterGetArrayTypeData(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	t.Run("unknown element type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.GetTypeData(context, "vec[3]")
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "vec"}.Error())
+		assert.NotContains(t, interpreter.types, "vec[3]")
+	})
+
+	interpreter := NewSimInterpreter(nil)
+
+	typeData, err := interpreter.GetTypeData(context, "int[2][3]")
+	assert.NoError(t, err)
+	assert.True(t, typeData.IsArray())
+	assert.Equal(t, "int[3]", typeData.ElementTypeName())
+	assert.Equal(t, 2, typeData.Length())
+
+	row := NewArrayValue("int[3]", []Value{NewValue("int", "0"), NewValue("int", "0"), NewValue("int", "0")})
+	assert.Equal(t, NewArrayValue("int[2][3]", []Value{row, row}), typeData.zeroValue)
+
+	// Element array types are declared along with the array type
+	assert.Contains(t, interpreter.types, "int[3]")
+}
+
+func TestInterpreterConstructArray(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+	contexts := []ParseContext{context, context}
+
+	t.Run("mismatched length", func(t *testing.T) {
+		value, err := interpreter.ConstructArray(context, "int[3]", []Value{NewValue("int", "1"), NewValue("int", "2")}, contexts)
+		expectedErr := MismatchedArrayLengthErr{TypeName: "int[3]", Expected: 3, Actual: 2}
+		assert.EqualError(t, err, expectedErr.Error())
+		assert.Equal(t, NewErrorValue(expectedErr), value)
+	})
+
+	t.Run("mismatched element type", func(t *testing.T) {
+		_, err := interpreter.ConstructArray(context, "int[2]", []Value{NewValue("int", "1"), NewValue("bool", "true")}, contexts)
+		assert.EqualError(t, err, MismatchedElementTypeErr{TypeName: "int[2]", ElementTypeName: "int", ValueTypeName: "bool"}.Error())
+	})
+
+	t.Run("not an array", func(t *testing.T) {
+		_, err := interpreter.ConstructArray(context, "int", []Value{NewValue("int", "1")}, contexts)
+		assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"int"}}.Error())
+	})
+
+	value, err := interpreter.ConstructArray(context, "int64[2]", []Value{NewValue("int", "1"), NewValue("untyped int", "2")}, contexts)
+	assert.NoError(t, err)
+	assert.Equal(t, NewArrayValue("int64[2]", []Value{NewValue("int64", "1"), NewValue("int64", "2")}), value)
+}
+
+func TestInterpreterIndexAndSetElement(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "float[2]")
+	assert.NoError(t, err)
+
+	array := NewArrayValue("float[2]", []Value{NewValue("float", "1"), NewValue("float", "2")})
+
+	t.Run("out of range", func(t *testing.T) {
+		_, err := interpreter.IndexArray(context, array, 2)
+		assert.EqualError(t, err, IndexOutOfRangeErr{Index: 2, Length: 2}.Error())
+
+		_, err = interpreter.IndexArray(context, array, -1)
+		assert.EqualError(t, err, IndexOutOfRangeErr{Index: -1, Length: 2}.Error())
+
+		_, err = interpreter.SetElement(context, array, 2, NewValue("float", "3"))
+		assert.EqualError(t, err, IndexOutOfRangeErr{Index: 2, Length: 2}.Error())
+	})
+
+	t.Run("mismatched element type", func(t *testing.T) {
+		_, err := interpreter.SetElement(context, array, 0, NewValue("string", `"3"`))
+		assert.EqualError(t, err, MismatchedElementTypeErr{TypeName: "float[2]", ElementTypeName: "float", ValueTypeName: "string"}.Error())
+	})
+
+	t.Run("not an array", func(t *testing.T) {
+		_, err := interpreter.SetElement(context, NewValue("string", `"ab"`), 0, NewValue("string", `"c"`))
+		assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"string"}}.Error())
+	})
+
+	value, err := interpreter.IndexArray(context, array, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("float", "2"), value)
+
+	result, err := interpreter.SetElement(context, array, 1, NewValue("float", "3"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewArrayValue("float[2]", []Value{NewValue("float", "1"), NewValue("float", "3")}), result)
+
+	// Setting an element returns a new value, leaving the original untouched
+	assert.Equal(t, NewArrayValue("float[2]", []Value{NewValue("float", "1"), NewValue("float", "2")}), array)
+}
+
+func TestInterpreterUntypedArrays(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	literal := func(elements ...Value) Value {
+		return NewArrayValue("untyped array", elements)
+	}
+
+	t.Run("implicitly cast", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		value, ok := interpreter.ImplicitlyCast(context, literal(NewValue("untyped int", "1"), NewValue("untyped int", "2")), "uint8[2]")
+		assert.True(t, ok)
+		assert.Equal(t, NewArrayValue("uint8[2]", []Value{NewValue("uint8", "1"), NewValue("uint8", "2")}), value)
+
+		_, ok = interpreter.ImplicitlyCast(context, literal(NewValue("untyped int", "1")), "uint8[2]")
+		assert.False(t, ok)
+
+		_, ok = interpreter.ImplicitlyCast(context, literal(NewValue("untyped float", "1.5"), NewValue("untyped int", "2")), "uint8[2]")
+		assert.False(t, ok)
+
+		_, ok = interpreter.ImplicitlyCast(context, literal(), "int")
+		assert.False(t, ok)
+	})
+
+	t.Run("add var", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddVar(context, NewVariable("a", literal(NewValue("untyped int", "1"), NewValue("untyped float", "2.5"))))
+		assert.NoError(t, err)
+
+		err = interpreter.AddVar(context, NewVariable("b", literal(literal(NewValue("untyped int", "1")), literal(NewValue("untyped int", "2")))))
+		assert.NoError(t, err)
+
+		err = interpreter.AddVar(context, NewVariable("c", literal()))
+		assert.EqualError(t, err, UntypedArrayErr{}.Error())
+
+		err = interpreter.AddVar(context, NewVariable("d", literal(NewValue("untyped int", "1"), NewValue("bool", "true"))))
+		assert.EqualError(t, err, MismatchedElementTypeErr{TypeName: "int[2]", ElementTypeName: "int", ValueTypeName: "bool"}.Error())
+
+		vars := interpreter.GetAllVars()
+		assert.Equal(t, NewArrayValue("float[2]", []Value{NewValue("float", "1"), NewValue("float", "2.5")}), vars["a"].Value())
+		assert.Equal(t, NewArrayValue("int[2][1]", []Value{NewArrayValue("int[1]", []Value{NewValue("int", "1")}), NewArrayValue("int[1]", []Value{NewValue("int", "2")})}), vars["b"].Value())
+	})
+
+	t.Run("set var value", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddVar(context, NewVariable("a", literal(NewValue("untyped int", "1"), NewValue("untyped int", "2"))))
+		assert.NoError(t, err)
+
+		err = interpreter.SetVarValue(context, "a", literal(NewValue("untyped int", "3"), NewValue("untyped int", "4")))
+		assert.NoError(t, err)
+
+		err = interpreter.SetVarValue(context, "a", literal(NewValue("untyped int", "3")))
+		assert.Error(t, err)
+
+		vars := interpreter.GetAllVars()
+		assert.Equal(t, NewArrayValue("int[2]", []Value{NewValue("int", "3"), NewValue("int", "4")}), vars["a"].Value())
+	})
+}
+
+func TestInterpreterResolveArrayBinaryOperations(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "int[2]")
+	assert.NoError(t, err)
+
+	array := func(x, y string) Value {
+		return NewArrayValue("int[2]", []Value{NewValue("int", x), NewValue("int", y)})
+	}
+
+	literal := NewArrayValue("untyped array", []Value{NewValue("untyped int", "1"), NewValue("untyped int", "2")})
+
+	value, err := interpreter.ResolveBinaryOperations(context, context, array("1", "2"), array("1", "2"), "==")
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("bool", "true"), value)
+
+	value, err = interpreter.ResolveBinaryOperations(context, context, array("1", "2"), array("1", "3"), "!=")
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("bool", "true"), value)
+
+	value, err = interpreter.ResolveBinaryOperations(context, context, array("1", "2"), literal, "==")
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("bool", "true"), value)
+
+	value, err = interpreter.ResolveBinaryOperations(context, context, literal, literal, "==")
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("bool", "true"), value)
+
+	_, err = interpreter.ResolveBinaryOperations(context, context, array("1", "2"), array("1", "2"), "+")
+	assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"int[2]", "int[2]"}}.Error())
+}
+
+func TestInterpreterFormatArray(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "string[2][1]")
+	assert.NoError(t, err)
+
+	row := func(s string) Value {
+		return NewArrayValue("string[1]", []Value{NewValue("string", quoteString(s))})
+	}
+
+	text, err := interpreter.FormatValue(context, NewArrayValue("string[2][1]", []Value{row("a"), row("b")}))
+	assert.NoError(t, err)
+	assert.Equal(t, `[["a"], ["b"]]`, text)
+}
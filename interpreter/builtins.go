@@ -0,0 +1,237 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// AnyTypeName is the type of built-in function parameters that accept a value of any type,
+// and the return type of built-in functions that return a value whose type depends on their arguments.
+// It can't be used as the type of a variable.
+const AnyTypeName = "any"
+
+// BuiltinFunc is the body of a function that is implemented by the interpreter rather than in Sim.
+// The arguments have already been checked against the function's parameters when it is called.
+type BuiltinFunc func(context ParseContext, args []Value) (Value, error)
+
+// Returns the functions that are built into the interpreter, keyed by function name.
+// Built-ins that need to look up types are bound to the given interpreter.
+func getBuiltinFunctions(interpreter *SimInterpreter) map[string]Function {
+	builtins := []Function{
+		NewFunction("len", []Parameter{NewParameter("value", AnyTypeName)}, "int", BuiltinFunc(builtinLen)),
+		NewFunction("substr", []Parameter{NewParameter("s", "string"), NewParameter("start", "int"), NewParameter("length", "int")}, "string", BuiltinFunc(builtinSubstr)),
+		NewFunction("find", []Parameter{NewParameter("s", "string"), NewParameter("substr", "string")}, "int", BuiltinFunc(builtinFind)),
+		NewFunction("replace", []Parameter{NewParameter("s", "string"), NewParameter("old", "string"), NewParameter("new", "string")}, "string", BuiltinFunc(builtinReplace)),
+		NewFunction("upper", []Parameter{NewParameter("s", "string")}, "string", BuiltinFunc(builtinUpper)),
+		NewFunction("lower", []Parameter{NewParameter("s", "string")}, "string", BuiltinFunc(builtinLower)),
+		NewFunction("trim", []Parameter{NewParameter("s", "string")}, "string", BuiltinFunc(builtinTrim)),
+		NewFunction("startsWith", []Parameter{NewParameter("s", "string"), NewParameter("prefix", "string")}, "bool", BuiltinFunc(builtinStartsWith)),
+		NewFunction("split", []Parameter{NewParameter("s", "string"), NewParameter("sep", "string")}, ListTypeName("string"), BuiltinFunc(interpreter.builtinSplit)),
+		NewFunction("append", []Parameter{NewParameter("list", AnyTypeName), NewParameter("value", AnyTypeName)}, AnyTypeName, BuiltinFunc(interpreter.builtinAppend)),
+		NewFunction("insert", []Parameter{NewParameter("list", AnyTypeName), NewParameter("index", "int"), NewParameter("value", AnyTypeName)}, AnyTypeName, BuiltinFunc(interpreter.builtinInsert)),
+		NewFunction("remove", []Parameter{NewParameter("list", AnyTypeName), NewParameter("index", "int")}, AnyTypeName, BuiltinFunc(interpreter.builtinRemove)),
+		NewFunction("has", []Parameter{NewParameter("map", AnyTypeName), NewParameter("key", AnyTypeName)}, "bool", BuiltinFunc(interpreter.builtinHas)),
+		NewFunction("delete", []Parameter{NewParameter("map", AnyTypeName), NewParameter("key", AnyTypeName)}, AnyTypeName, BuiltinFunc(interpreter.builtinDelete)),
+		NewFunction("keys", []Parameter{NewParameter("map", AnyTypeName)}, AnyTypeName, BuiltinFunc(interpreter.builtinKeys)),
+		NewFunction("values", []Parameter{NewParameter("map", AnyTypeName)}, AnyTypeName, BuiltinFunc(interpreter.builtinValues)),
+	}
+
+	functions := make(map[string]Function)
+	for _, builtin := range builtins {
+		functions[builtin.name] = builtin
+	}
+
+	return functions
+}
+
+// IndexString returns the rune at the given rune index of a string value as a new string value.
+func IndexString(context ParseContext, value Value, index int64) (Value, error) {
+	s, err := value.GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	runes := []rune(s)
+	if index < 0 || int(index) >= len(runes) {
+		err := IndexOutOfRangeErr{Context: context, Index: int(index), Length: len(runes)}
+		return NewErrorValue(err), err
+	}
+
+	return NewValue("string", quoteString(string(runes[index]))), nil
+}
+
+// Returns the number of runes in a string, the number of elements in an array or list, or the number of entries in a map.
+func builtinLen(context ParseContext, args []Value) (Value, error) {
+	typeName, err := args[0].GetType()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if _, _, isMap := parseMapTypeName(typeName); isMap || typeName == "untyped map" {
+		return NewValue("int", fmt.Sprintf("%d", len(args[0].items)/2)), nil
+	}
+
+	_, _, isArray := parseArrayTypeName(typeName)
+	_, isList := parseListTypeName(typeName)
+
+	if isArray || isList || typeName == "untyped array" {
+		return NewValue("int", fmt.Sprintf("%d", len(args[0].items))), nil
+	}
+
+	if typeName != "string" {
+		err := InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+		return NewErrorValue(err), err
+	}
+
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewValue("int", fmt.Sprintf("%d", utf8.RuneCountInString(s))), nil
+}
+
+// Returns the part of a string that starts at the given rune index and is the given number of runes long.
+func builtinSubstr(context ParseContext, args []Value) (Value, error) {
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	start, err := args[1].GetInt(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	length, err := args[2].GetInt(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	runes := []rune(s)
+	if start < 0 || int(start) > len(runes) {
+		err := IndexOutOfRangeErr{Context: context, Index: int(start), Length: len(runes)}
+		return NewErrorValue(err), err
+	}
+
+	end := int(start) + int(length)
+	if length < 0 || end > len(runes) {
+		err := IndexOutOfRangeErr{Context: context, Index: end, Length: len(runes)}
+		return NewErrorValue(err), err
+	}
+
+	return NewValue("string", quoteString(string(runes[start:end]))), nil
+}
+
+// Returns the rune index of the first occurrence of a substring, or -1 if the substring isn't found.
+func builtinFind(context ParseContext, args []Value) (Value, error) {
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	substr, err := args[1].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	index := strings.Index(s, substr)
+	if index >= 0 {
+		index = utf8.RuneCountInString(s[:index])
+	}
+
+	return NewValue("int", fmt.Sprintf("%d", index)), nil
+}
+
+// Returns a copy of a string with all occurrences of old replaced by new.
+func builtinReplace(context ParseContext, args []Value) (Value, error) {
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	oldSubstr, err := args[1].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	newSubstr, err := args[2].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewValue("string", quoteString(strings.ReplaceAll(s, oldSubstr, newSubstr))), nil
+}
+
+// Returns a copy of a string with all letters converted to upper case.
+func builtinUpper(context ParseContext, args []Value) (Value, error) {
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewValue("string", quoteString(strings.ToUpper(s))), nil
+}
+
+// Returns a copy of a string with all letters converted to lower case.
+func builtinLower(context ParseContext, args []Value) (Value, error) {
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewValue("string", quoteString(strings.ToLower(s))), nil
+}
+
+// Returns a copy of a string without leading and trailing whitespace.
+func builtinTrim(context ParseContext, args []Value) (Value, error) {
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewValue("string", quoteString(strings.TrimSpace(s))), nil
+}
+
+// Returns true if a string begins with the given prefix.
+func builtinStartsWith(context ParseContext, args []Value) (Value, error) {
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	prefix, err := args[1].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewValue("bool", fmt.Sprintf("%t", strings.HasPrefix(s, prefix))), nil
+}
+
+// Returns the substrings of a string between each occurrence of the separator as a list of strings.
+// An empty separator splits the string into its runes.
+func (interpreter *SimInterpreter) builtinSplit(context ParseContext, args []Value) (Value, error) {
+	typeData, err := interpreter.GetTypeData(context, ListTypeName("string"))
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	s, err := args[0].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	sep, err := args[1].GetString(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	substrs := strings.Split(s, sep)
+	elements := make([]Value, len(substrs))
+	for i, substr := range substrs {
+		elements[i] = NewValue("string", quoteString(substr))
+	}
+
+	return NewListValue(typeData.GetTypeName(), elements), nil
+}
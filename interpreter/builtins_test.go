@@ -0,0 +1,84 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinFunctions(t *testing.T) {
+	context := NewParseContext(0, 0)
+	functions := getBuiltinFunctions(NewSimInterpreter(nil))
+
+	str := func(s string) Value {
+		return NewValue("string", quoteString(s))
+	}
+
+	tests := []struct {
+		funcName string
+		args     []Value
+		expected Value
+		err      error
+	}{
+		{funcName: "len", args: []Value{str("")}, expected: NewValue("int", "0")},
+		{funcName: "len", args: []Value{str("héllo, 世界")}, expected: NewValue("int", "9")},
+		{funcName: "len", args: []Value{NewArrayValue("int[2]", []Value{NewValue("int", "1"), NewValue("int", "2")})}, expected: NewValue("int", "2")},
+		{funcName: "len", args: []Value{NewValue("int", "1")}, err: InvalidOperationErr{Context: context, TypeNames: []string{"int"}}},
+		{funcName: "substr", args: []Value{str("héllo, 世界"), NewValue("int", "1"), NewValue("int", "4")}, expected: str("éllo")},
+		{funcName: "substr", args: []Value{str("héllo, 世界"), NewValue("int", "7"), NewValue("int", "2")}, expected: str("世界")},
+		{funcName: "substr", args: []Value{str("abc"), NewValue("int", "3"), NewValue("int", "0")}, expected: str("")},
+		{funcName: "substr", args: []Value{str("abc"), NewValue("int", "-1"), NewValue("int", "1")}, err: IndexOutOfRangeErr{Context: context, Index: -1, Length: 3}},
+		{funcName: "substr", args: []Value{str("abc"), NewValue("int", "1"), NewValue("int", "3")}, err: IndexOutOfRangeErr{Context: context, Index: 4, Length: 3}},
+		{funcName: "substr", args: []Value{str("abc"), NewValue("int", "1"), NewValue("int", "-1")}, err: IndexOutOfRangeErr{Context: context, Index: 0, Length: 3}},
+		{funcName: "find", args: []Value{str("héllo, 世界"), str("世")}, expected: NewValue("int", "7")},
+		{funcName: "find", args: []Value{str("hello"), str("z")}, expected: NewValue("int", "-1")},
+		{funcName: "replace", args: []Value{str("a-b-c"), str("-"), str("+")}, expected: str("a+b+c")},
+		{funcName: "upper", args: []Value{str("héllo")}, expected: str("HÉLLO")},
+		{funcName: "lower", args: []Value{str("HÉLLO")}, expected: str("héllo")},
+		{funcName: "trim", args: []Value{str(" \t hello \n")}, expected: str("hello")},
+		{funcName: "startsWith", args: []Value{str("héllo"), str("hé")}, expected: NewValue("bool", "true")},
+		{funcName: "startsWith", args: []Value{str("héllo"), str("llo")}, expected: NewValue("bool", "false")},
+		{funcName: "split", args: []Value{str("a,b,,c"), str(",")}, expected: NewListValue("string[]", []Value{str("a"), str("b"), str(""), str("c")})},
+		{funcName: "split", args: []Value{str("hé"), str("")}, expected: NewListValue("string[]", []Value{str("h"), str("é")})},
+	}
+
+	for _, test := range tests {
+		t.Run(test.funcName, func(t *testing.T) {
+			function, ok := functions[test.funcName]
+			assert.True(t, ok)
+
+			builtin, ok := function.Body().(BuiltinFunc)
+			assert.True(t, ok)
+
+			value, err := builtin(context, test.args)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Equal(t, NewErrorValue(test.err), value)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+			assert.Equal(t, function.ReturnTypeName(), value.typeName)
+		})
+	}
+}
+
+func TestIndexString(t *testing.T) {
+	context := NewParseContext(0, 0)
+	value := NewValue("string", quoteString("h€llo"))
+
+	t.Run("negative index", func(t *testing.T) {
+		_, err := IndexString(context, value, -1)
+		assert.EqualError(t, err, IndexOutOfRangeErr{Context: context, Index: -1, Length: 5}.Error())
+	})
+
+	t.Run("index past the end", func(t *testing.T) {
+		_, err := IndexString(context, value, 5)
+		assert.EqualError(t, err, IndexOutOfRangeErr{Context: context, Index: 5, Length: 5}.Error())
+	})
+
+	result, err := IndexString(context, value, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("string", quoteString("€")), result)
+}
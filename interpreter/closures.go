@@ -0,0 +1,154 @@
+package interpreter
+
+import "strings"
+
+// Functions are values with function types, such as fn(int,int):int, so they can be stored in variables, passed as arguments
+// and returned. A function literal captures the scope that it is evaluated in, and calls to it are nested in that scope
+// rather than the global scope. Scopes are kept alive for as long as a closure refers to them, so captured variables
+// keep working after the block that declared them has ended, and every closure that captures a variable sees changes to it.
+
+// FunctionTypeName returns the name of the function type with the given parameter and return types, such as fn(int,int):int.
+func FunctionTypeName(paramTypeNames []string, returnTypeName string) string {
+	return "fn(" + strings.Join(paramTypeNames, ",") + "):" + returnTypeName
+}
+
+// NewClosure returns a function value that calls the given function literal, capturing the current scope.
+func (interpreter *SimInterpreter) NewClosure(context ParseContext, function Function) (Value, error) {
+	if err := interpreter.checkSignature(context, function); err != nil {
+		return NewErrorValue(err), err
+	}
+
+	typeData, err := interpreter.GetTypeData(context, function.TypeName())
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	function.scope = interpreter.currScope
+
+	return NewFunctionValue(typeData.GetTypeName(), function), nil
+}
+
+// GetFunctionValue returns the declared function with the given name as a function value.
+// Built-in functions that accept values of any type have no function type, so they can't be used as values.
+func (interpreter *SimInterpreter) GetFunctionValue(context ParseContext, funcName string) (Value, error) {
+	function, err := interpreter.GetFunction(context, funcName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if function.returnTypeName == AnyTypeName {
+		err := InvalidFunctionValueErr{Context: context, FuncName: funcName}
+		return NewErrorValue(err), err
+	}
+
+	for _, param := range function.params {
+		if param.typeName == AnyTypeName {
+			err := InvalidFunctionValueErr{Context: context, FuncName: funcName}
+			return NewErrorValue(err), err
+		}
+	}
+
+	typeData, err := interpreter.GetTypeData(context, function.TypeName())
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewFunctionValue(typeData.GetTypeName(), function), nil
+}
+
+// GetCallee returns the function that a function value calls.
+func (interpreter *SimInterpreter) GetCallee(context ParseContext, value Value) (Function, error) {
+	typeName, err := value.GetType()
+	if err != nil {
+		return Function{}, err
+	}
+
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil || !typeData.IsFunction() {
+		return Function{}, InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+	}
+
+	if value.function == nil {
+		return Function{}, NilFunctionErr{Context: context}
+	}
+
+	return *value.function, nil
+}
+
+// Helper function to declare a function type with the given parameter and return types.
+// The zero value of a function type is nil, which can't be called.
+func (interpreter *SimInterpreter) addFunctionType(context ParseContext, typeName string, paramTypeNames []string, returnTypeName string) (TypeData, error) {
+	for _, paramTypeName := range paramTypeNames {
+		if _, err := interpreter.GetTypeData(context, paramTypeName); err != nil {
+			return TypeData{}, err
+		}
+	}
+
+	if _, err := interpreter.GetTypeData(context, returnTypeName); err != nil {
+		return TypeData{}, err
+	}
+
+	typeData := TypeData{
+		zeroValue:       NewValue(typeName, "nil"),
+		typeInfo:        TypeInfoFunction,
+		paramTypeNames:  paramTypeNames,
+		returnTypeName:  returnTypeName,
+		implicitCastMap: map[string]struct{}{},
+	}
+
+	interpreter.types[typeName] = typeData
+
+	return typeData, nil
+}
+
+// Helper function to split a function type name, such as fn(int,map[string]int):bool, into its parameter type names and return type name.
+// Returns false if the type name isn't a function type name.
+func parseFunctionTypeName(typeName string) ([]string, string, bool) {
+	if !strings.HasPrefix(typeName, "fn(") {
+		return nil, "", false
+	}
+
+	// Parameter types can have parentheses and brackets of their own, so only split on commas outside of them
+	var paramTypeNames []string
+
+	depth := 0
+	start := len("fn(")
+
+	for i := len("fn"); i < len(typeName); i++ {
+		switch typeName[i] {
+		case '(', '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth == 1 {
+				paramTypeNames = append(paramTypeNames, typeName[start:i])
+				start = i + 1
+			}
+		case ')':
+			depth--
+			if depth > 0 {
+				continue
+			}
+
+			if i > start || len(paramTypeNames) > 0 {
+				paramTypeNames = append(paramTypeNames, typeName[start:i])
+			}
+
+			returnTypeName := strings.TrimPrefix(typeName[i+1:], ":")
+			if returnTypeName == "" || returnTypeName == typeName[i+1:] {
+				return nil, "", false
+			}
+
+			for _, paramTypeName := range paramTypeNames {
+				if paramTypeName == "" {
+					return nil, "", false
+				}
+			}
+
+			return paramTypeNames, returnTypeName, true
+		}
+	}
+
+	return nil, "", false
+}
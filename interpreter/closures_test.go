@@ -0,0 +1,225 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFunctionTypeName(t *testing.T) {
+	assert.Equal(t, "fn(int,float):bool", FunctionTypeName([]string{"int", "float"}, "bool"))
+	assert.Equal(t, "fn():int", FunctionTypeName(nil, "int"))
+	assert.Equal(t, "fn(int,int):int", NewFunction("add", []Parameter{NewParameter("a", "int"), NewParameter("b", "int")}, "int", nil).TypeName())
+
+	tests := []struct {
+		typeName       string
+		paramTypeNames []string
+		returnTypeName string
+	}{
+		{typeName: "fn():int", returnTypeName: "int"},
+		{typeName: "fn(int):int[]", paramTypeNames: []string{"int"}, returnTypeName: "int[]"},
+		{typeName: "fn(map[string]int,fn(int,int):int):fn():bool", paramTypeNames: []string{"map[string]int", "fn(int,int):int"}, returnTypeName: "fn():bool"},
+	}
+
+	for _, test := range tests {
+		paramTypeNames, returnTypeName, ok := parseFunctionTypeName(test.typeName)
+		assert.True(t, ok, test.typeName)
+		assert.Equal(t, test.paramTypeNames, paramTypeNames, test.typeName)
+		assert.Equal(t, test.returnTypeName, returnTypeName, test.typeName)
+	}
+
+	for _, typeName := range []string{"int", "fn", "fn()", "fn():", "fn(int)int", "fn(,int):int", "fn(int:int", "function():int"} {
+		_, _, ok := parseFunctionTypeName(typeName)
+		assert.False(t, ok, typeName)
+	}
+}
+
+func TestInterpreterGetFunctionTypeData(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	t.Run("unknown param type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.GetTypeData(context, "fn(vec):int")
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "vec"}.Error())
+		assert.NotContains(t, interpreter.types, "fn(vec):int")
+	})
+
+	t.Run("unknown return type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.GetTypeData(context, "fn(int):vec")
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "vec"}.Error())
+	})
+
+	t.Run("map keys", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.GetTypeData(context, "map[fn():int]int")
+		assert.EqualError(t, err, InvalidKeyTypeErr{TypeName: "map[fn():int]int", KeyTypeName: "fn():int"}.Error())
+	})
+
+	interpreter := NewSimInterpreter(nil)
+
+	typeData, err := interpreter.GetTypeData(context, "fn(int,string):int[]")
+	assert.NoError(t, err)
+	assert.True(t, typeData.IsFunction())
+	assert.Equal(t, []string{"int", "string"}, typeData.ParamTypeNames())
+	assert.Equal(t, "int[]", typeData.ReturnTypeName())
+	assert.Equal(t, NewValue("fn(int,string):int[]", "nil"), typeData.zeroValue)
+}
+
+func TestInterpreterNewClosure(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	t.Run("unknown param type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.NewClosure(context, NewFunction("fn", []Parameter{NewParameter("a", "vec")}, "int", nil))
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "vec"}.Error())
+	})
+
+	t.Run("duplicate parameter", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.NewClosure(context, NewFunction("fn", []Parameter{NewParameter("a", "int"), NewParameter("a", "int")}, "int", nil))
+		assert.EqualError(t, err, VarExistsErr{VarName: "a"}.Error())
+	})
+
+	interpreter := NewSimInterpreter(nil)
+
+	count := NewVariable("count", NewValue("int", "0"))
+
+	interpreter.PushScope()
+	err := interpreter.AddVar(context, count)
+	assert.NoError(t, err)
+
+	value, err := interpreter.NewClosure(context, NewFunction("fn", nil, "int", nil))
+	assert.NoError(t, err)
+
+	typeName, err := value.GetType()
+	assert.NoError(t, err)
+	assert.Equal(t, "fn():int", typeName)
+
+	err = interpreter.PopScope(context)
+	assert.NoError(t, err)
+
+	_, err = interpreter.GetVar(context, count.name)
+	assert.EqualError(t, err, UnknownVarErr{VarName: count.name}.Error())
+
+	// Calling the closure brings back the scope that it captured, even though the scope has been popped
+	function, err := interpreter.GetCallee(context, value)
+	assert.NoError(t, err)
+
+	err = interpreter.PushFrame(context, function)
+	assert.NoError(t, err)
+
+	v, err := interpreter.GetVar(context, count.name)
+	assert.NoError(t, err)
+	assert.Equal(t, count, v)
+
+	err = interpreter.SetVarValue(context, count.name, NewValue("int", "1"))
+	assert.NoError(t, err)
+
+	err = interpreter.PopFrame(context)
+	assert.NoError(t, err)
+
+	// Changes to captured variables last between calls
+	err = interpreter.PushFrame(context, function)
+	assert.NoError(t, err)
+
+	v, err = interpreter.GetVar(context, count.name)
+	assert.NoError(t, err)
+	assert.Equal(t, NewVariable(count.name, NewValue("int", "1")), v)
+
+	err = interpreter.PopFrame(context)
+	assert.NoError(t, err)
+}
+
+func TestInterpreterGetFunctionValue(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	add := NewFunction("add", []Parameter{NewParameter("a", "int"), NewParameter("b", "int")}, "int", nil)
+
+	err := interpreter.AddFunction(context, add)
+	assert.NoError(t, err)
+
+	t.Run("unknown function", func(t *testing.T) {
+		_, err := interpreter.GetFunctionValue(context, "sub")
+		assert.EqualError(t, err, UnknownFunctionErr{FuncName: "sub"}.Error())
+	})
+
+	t.Run("builtin with any params", func(t *testing.T) {
+		value, err := interpreter.GetFunctionValue(context, "len")
+		expectedErr := InvalidFunctionValueErr{FuncName: "len"}
+		assert.EqualError(t, err, expectedErr.Error())
+		assert.Equal(t, NewErrorValue(expectedErr), value)
+	})
+
+	value, err := interpreter.GetFunctionValue(context, "add")
+	assert.NoError(t, err)
+	assert.Equal(t, NewFunctionValue("fn(int,int):int", add), value)
+
+	text, err := interpreter.FormatValue(context, value)
+	assert.NoError(t, err)
+	assert.Equal(t, "add", text)
+
+	value, err = interpreter.GetFunctionValue(context, "upper")
+	assert.NoError(t, err)
+
+	typeName, err := value.GetType()
+	assert.NoError(t, err)
+	assert.Equal(t, "fn(string):string", typeName)
+}
+
+func TestInterpreterFunctionValues(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	add := NewFunction("add", []Parameter{NewParameter("a", "int"), NewParameter("b", "int")}, "int", nil)
+
+	_, err := interpreter.GetTypeData(context, "fn(int,int):int")
+	assert.NoError(t, err)
+
+	err = interpreter.AddVar(context, NewVariable("f", NewValue("fn(int,int):int", "")))
+	assert.NoError(t, err)
+	assert.Equal(t, NewVariable("f", NewValue("fn(int,int):int", "nil")), interpreter.GetAllVars()["f"])
+
+	t.Run("nil function", func(t *testing.T) {
+		_, err := interpreter.GetCallee(context, NewValue("fn(int,int):int", "nil"))
+		assert.EqualError(t, err, NilFunctionErr{}.Error())
+	})
+
+	t.Run("not a function", func(t *testing.T) {
+		_, err := interpreter.GetCallee(context, NewValue("int", "1"))
+		assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"int"}}.Error())
+	})
+
+	t.Run("mismatched function type", func(t *testing.T) {
+		value := NewFunctionValue("fn(int):int", NewFunction("neg", []Parameter{NewParameter("a", "int")}, "int", nil))
+
+		_, err := interpreter.GetTypeData(context, "fn(int):int")
+		assert.NoError(t, err)
+
+		err = interpreter.SetVarValue(context, "f", value)
+		assert.EqualError(t, err, MismatchedTypeAssignErr{Var: NewVariable("f", NewValue("fn(int,int):int", "nil"))}.Error())
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		value := NewFunctionValue("fn(int,int):int", add)
+
+		_, err := interpreter.ResolveBinaryOperations(context, context, value, value, "==")
+		assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"fn(int,int):int", "fn(int,int):int"}}.Error())
+	})
+
+	err = interpreter.SetVarValue(context, "f", NewFunctionValue("fn(int,int):int", add))
+	assert.NoError(t, err)
+
+	variable, err := interpreter.GetVar(context, "f")
+	assert.NoError(t, err)
+
+	function, err := interpreter.GetCallee(context, variable.Value())
+	assert.NoError(t, err)
+	assert.Equal(t, add, function)
+}
@@ -0,0 +1,334 @@
+package interpreter
+
+import (
+	"math"
+	"strconv"
+)
+
+// ConvertValue explicitly converts a value to the given type. Conversions are allowed between
+// all numeric types and bool, from numeric types, bool and enums to string, and from string to numeric types and bool.
+//
+// Conversions follow these rules:
+//   - Typed integers wrap around when converted to a smaller integer type, keeping the low bits of the
+//     two's complement representation. Untyped integer literals must fit in the type they are converted to.
+//   - Floating point numbers are truncated toward zero when converted to an integer type,
+//     and must fit in that integer type once truncated.
+//   - Integers and float64s are rounded to the nearest representable number when converted to a 32-bit floating point type,
+//     and must not overflow it.
+//   - Numbers convert to false when they are zero and true otherwise, and bools convert to 1 for true and 0 for false.
+//   - Numbers convert to their decimal string, bools convert to "true" or "false", and enums convert to the name of their member.
+//   - Strings must hold a number in the same format as a literal to be converted to a numeric type,
+//     or exactly "true" or "false" to be converted to bool.
+func (interpreter *SimInterpreter) ConvertValue(context ParseContext, value Value, typeName string) (Value, error) {
+	valueTypeName, err := value.GetType()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if valueTypeName == typeName {
+		return value, nil
+	}
+
+	valueTypeData, err := interpreter.getConversionTypeData(context, valueTypeName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	// Untyped integer literals above the range of int64 are read as a uint64 instead
+	if _, parseErr := strconv.ParseInt(value.data, 10, 64); valueTypeName == "untyped int" && parseErr != nil {
+		valueTypeData, err = interpreter.GetTypeData(context, "uint64")
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+	}
+
+	var data string
+	var ok bool
+
+	switch {
+	case valueTypeData.IsSignedInteger() || valueTypeData.IsUnsignedInteger():
+		data, ok, err = convertInteger(value.data, valueTypeData, typeData, valueTypeName == "untyped int")
+	case valueTypeData.IsFloatingPoint():
+		data, ok, err = convertFloat(value.data, typeData)
+	case valueTypeData.IsBool():
+		data, ok = convertBool(value.data, typeData)
+	case valueTypeData.IsEnum():
+		data, ok = value.data, typeData.IsString()
+		if ok {
+			data = quoteString(data)
+		}
+	case valueTypeData.IsString():
+		data, ok, err = convertString(value.data, typeData)
+	}
+
+	if !ok {
+		err := InvalidConversionErr{Context: context, FromTypeName: valueTypeName, ToTypeName: typeName}
+		return NewErrorValue(err), err
+	}
+
+	if err != nil {
+		err := ConversionErr{Context: context, Data: value.data, TypeName: typeName}
+		return NewErrorValue(err), err
+	}
+
+	return NewValue(typeName, data), nil
+}
+
+// Helper function to get the type data of a value being converted,
+// treating untyped literals as the widest type of their kind.
+func (interpreter *SimInterpreter) getConversionTypeData(context ParseContext, typeName string) (TypeData, error) {
+	switch typeName {
+	case "untyped int":
+		return interpreter.GetTypeData(context, "int64")
+	case "untyped float":
+		return interpreter.GetTypeData(context, "float64")
+	}
+
+	return interpreter.GetTypeData(context, typeName)
+}
+
+// Helper function to convert integer data to the given type.
+// Returns false if the conversion isn't allowed, or an error if the data can't be converted.
+func convertInteger(data string, from TypeData, to TypeData, untyped bool) (string, bool, error) {
+	var signed int64
+	var unsigned uint64
+	var err error
+
+	// Read the integer as both signed and unsigned 64-bit integers sharing the same bits
+	if from.IsSignedInteger() {
+		signed, err = strconv.ParseInt(data, 10, 64)
+		unsigned = uint64(signed)
+	} else {
+		unsigned, err = strconv.ParseUint(data, 10, 64)
+		signed = int64(unsigned)
+	}
+
+	if err != nil {
+		return "", true, err
+	}
+
+	negative := from.IsSignedInteger() && signed < 0
+
+	switch {
+	case to.IsSignedInteger():
+		// An untyped unsigned literal that reads as negative is above the range of every signed type
+		if untyped && (!fitsSigned(signed, to.bitSize) || from.IsUnsignedInteger() && signed < 0) {
+			return "", true, strconv.ErrRange
+		}
+
+		return strconv.FormatInt(wrapSigned(signed, to.bitSize), 10), true, nil
+
+	case to.IsUnsignedInteger():
+		if untyped && (negative || !fitsUnsigned(unsigned, to.bitSize)) {
+			return "", true, strconv.ErrRange
+		}
+
+		return strconv.FormatUint(wrapUnsigned(unsigned, to.bitSize), 10), true, nil
+
+	case to.IsFloatingPoint():
+		if negative {
+			return formatFloat(float64(signed), to.bitSize)
+		}
+
+		return formatFloat(float64(unsigned), to.bitSize)
+
+	case to.IsString():
+		if negative {
+			return quoteString(strconv.FormatInt(signed, 10)), true, nil
+		}
+
+		return quoteString(strconv.FormatUint(unsigned, 10)), true, nil
+
+	case to.IsBool():
+		return strconv.FormatBool(unsigned != 0), true, nil
+	}
+
+	return "", false, nil
+}
+
+// Helper function to convert floating point data to the given type.
+// Returns false if the conversion isn't allowed, or an error if the data can't be converted.
+func convertFloat(data string, to TypeData) (string, bool, error) {
+	num, err := strconv.ParseFloat(data, 64)
+	if err != nil {
+		return "", true, err
+	}
+
+	switch {
+	case to.IsSignedInteger():
+		truncated := math.Trunc(num)
+		limit := math.Ldexp(1, to.bitSize-1)
+		if math.IsNaN(truncated) || truncated < -limit || truncated >= limit {
+			return "", true, strconv.ErrRange
+		}
+
+		return strconv.FormatInt(int64(truncated), 10), true, nil
+
+	case to.IsUnsignedInteger():
+		truncated := math.Trunc(num)
+		limit := math.Ldexp(1, to.bitSize)
+		if math.IsNaN(truncated) || truncated < 0 || truncated >= limit {
+			return "", true, strconv.ErrRange
+		}
+
+		return strconv.FormatUint(uint64(truncated), 10), true, nil
+
+	case to.IsFloatingPoint():
+		return formatFloat(num, to.bitSize)
+
+	case to.IsString():
+		return quoteString(strconv.FormatFloat(num, 'g', -1, 64)), true, nil
+
+	case to.IsBool():
+		return strconv.FormatBool(num != 0), true, nil
+	}
+
+	return "", false, nil
+}
+
+// Helper function to convert bool data to the given type.
+// Returns false if the conversion isn't allowed.
+func convertBool(data string, to TypeData) (string, bool) {
+	switch {
+	case to.IsSignedInteger(), to.IsUnsignedInteger(), to.IsFloatingPoint():
+		if data == "true" {
+			return "1", true
+		}
+
+		return "0", true
+
+	case to.IsString():
+		return quoteString(data), true
+	}
+
+	return "", false
+}
+
+// Helper function to convert string data to the given type.
+// Returns false if the conversion isn't allowed, or an error if the data can't be converted.
+func convertString(data string, to TypeData) (string, bool, error) {
+	s := data[1 : len(data)-1]
+
+	switch {
+	case to.IsSignedInteger():
+		num, err := strconv.ParseInt(s, 10, to.bitSize)
+		if err != nil {
+			return "", true, err
+		}
+
+		return strconv.FormatInt(num, 10), true, nil
+
+	case to.IsUnsignedInteger():
+		num, err := strconv.ParseUint(s, 10, to.bitSize)
+		if err != nil {
+			return "", true, err
+		}
+
+		return strconv.FormatUint(num, 10), true, nil
+
+	case to.IsFloatingPoint():
+		// Only accept numbers written like a literal, rather than everything Go can parse, such as "NaN" or "1e10"
+		if !isNumberLiteral(s) {
+			return "", true, strconv.ErrSyntax
+		}
+
+		return convertFloat(s, to)
+
+	case to.IsBool():
+		if s != "true" && s != "false" {
+			return "", true, strconv.ErrSyntax
+		}
+
+		return s, true, nil
+	}
+
+	return "", false, nil
+}
+
+// Helper function to format a floating point number as the data for a floating point type of the given size,
+// rounding it to the nearest number the type can represent.
+func formatFloat(num float64, bitSize int) (string, bool, error) {
+	num = roundFloat(num, bitSize)
+
+	if math.IsInf(num, 0) || math.IsNaN(num) {
+		return "", true, strconv.ErrRange
+	}
+
+	return strconv.FormatFloat(num, 'g', -1, bitSize), true, nil
+}
+
+// Helper function that keeps the low bits of a signed integer that fit in the given number of bits,
+// then extends the sign back out. Types without a size are treated as 64 bits wide.
+func wrapSigned(num int64, bitSize int) int64 {
+	if bitSize == 0 {
+		return num
+	}
+
+	shift := uint(64 - bitSize)
+	return num << shift >> shift
+}
+
+// Helper function that keeps the low bits of an unsigned integer that fit in the given number of bits.
+// Types without a size are treated as 64 bits wide.
+func wrapUnsigned(num uint64, bitSize int) uint64 {
+	if bitSize == 0 {
+		return num
+	}
+
+	shift := uint(64 - bitSize)
+	return num << shift >> shift
+}
+
+// Helper function to round a floating point number to the nearest number a floating point type of the given size can represent.
+func roundFloat(num float64, bitSize int) float64 {
+	if bitSize == 32 {
+		return float64(float32(num))
+	}
+
+	return num
+}
+
+// Helper function that returns true if the signed integer fits in the given number of bits.
+func fitsSigned(num int64, bitSize int) bool {
+	return wrapSigned(num, bitSize) == num
+}
+
+// Helper function that returns true if the unsigned integer fits in the given number of bits.
+func fitsUnsigned(num uint64, bitSize int) bool {
+	return wrapUnsigned(num, bitSize) == num
+}
+
+// Helper function that returns true if the floating point number is in the range of a floating point type of the given size,
+// so rounding it to that type doesn't overflow.
+func fitsFloat(num float64, bitSize int) bool {
+	return !math.IsInf(roundFloat(num, bitSize), 0) || math.IsInf(num, 0)
+}
+
+// Helper function that returns true if the string is written the same way as a number literal,
+// optionally negated.
+func isNumberLiteral(s string) bool {
+	if len(s) > 0 && s[0] == '-' {
+		s = s[1:]
+	}
+
+	digits := 0
+	dot := false
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] >= '0' && s[i] <= '9':
+			digits++
+		case s[i] == '.' && !dot && digits > 0 && i < len(s)-1:
+			dot = true
+		default:
+			return false
+		}
+	}
+
+	return digits > 0
+}
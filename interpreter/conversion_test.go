@@ -0,0 +1,102 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpreterConvertValue(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddEnumType(context, "Color", []string{"Red", "Green"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		value    Value
+		typeName string
+		expected Value
+		err      error
+	}{
+		// Integer to integer
+		{name: "same type", value: NewValue("int", "10"), typeName: "int", expected: NewValue("int", "10")},
+		{name: "widen", value: NewValue("int8", "-10"), typeName: "int64", expected: NewValue("int64", "-10")},
+		{name: "narrow in range", value: NewValue("int64", "100"), typeName: "int8", expected: NewValue("int8", "100")},
+		{name: "narrow wraps", value: NewValue("int", "300"), typeName: "int8", expected: NewValue("int8", "44")},
+		{name: "narrow wraps negative", value: NewValue("int", "200"), typeName: "int8", expected: NewValue("int8", "-56")},
+		{name: "signed to unsigned wraps", value: NewValue("int", "-1"), typeName: "uint16", expected: NewValue("uint16", "65535")},
+		{name: "unsigned to signed wraps", value: NewValue("uint64", "18446744073709551615"), typeName: "int64", expected: NewValue("int64", "-1")},
+		{name: "byte", value: NewValue("uint", "257"), typeName: "byte", expected: NewValue("byte", "1")},
+		{name: "untyped int", value: NewValue("untyped int", "127"), typeName: "int8", expected: NewValue("int8", "127")},
+		{name: "untyped int overflow", value: NewValue("untyped int", "128"), typeName: "int8", err: ConversionErr{Context: context, Data: "128", TypeName: "int8"}},
+		{name: "untyped int above int64", value: NewValue("untyped int", "18446744073709551615"), typeName: "uint64", expected: NewValue("uint64", "18446744073709551615")},
+		{name: "untyped int above int64 to signed", value: NewValue("untyped int", "9223372036854775808"), typeName: "int64", err: ConversionErr{Context: context, Data: "9223372036854775808", TypeName: "int64"}},
+		{name: "untyped int above int64 to float", value: NewValue("untyped int", "18446744073709551615"), typeName: "float64", expected: NewValue("float64", "1.8446744073709552e+19")},
+		{name: "untyped int negative to unsigned", value: NewValue("untyped int", "-1"), typeName: "uint", err: ConversionErr{Context: context, Data: "-1", TypeName: "uint"}},
+
+		// Integer to floating point
+		{name: "int to float", value: NewValue("int", "-3"), typeName: "float", expected: NewValue("float", "-3")},
+		{name: "int to float rounds", value: NewValue("int", "16777217"), typeName: "float32", expected: NewValue("float32", "1.6777216e+07")},
+		{name: "int64 to float64", value: NewValue("int64", "9007199254740993"), typeName: "float64", expected: NewValue("float64", "9.007199254740992e+15")},
+
+		// Floating point to integer
+		{name: "float truncates", value: NewValue("float", "2.9"), typeName: "int", expected: NewValue("int", "2")},
+		{name: "float truncates toward zero", value: NewValue("float64", "-2.9"), typeName: "int", expected: NewValue("int", "-2")},
+		{name: "float to unsigned", value: NewValue("float", "255.5"), typeName: "byte", expected: NewValue("byte", "255")},
+		{name: "float overflow", value: NewValue("float64", "128"), typeName: "int8", err: ConversionErr{Context: context, Data: "128", TypeName: "int8"}},
+		{name: "float min", value: NewValue("float64", "-128.7"), typeName: "int8", expected: NewValue("int8", "-128")},
+		{name: "negative float to unsigned", value: NewValue("float", "-1"), typeName: "uint", err: ConversionErr{Context: context, Data: "-1", TypeName: "uint"}},
+		{name: "untyped float", value: NewValue("untyped float", "1.5"), typeName: "int", expected: NewValue("int", "1")},
+
+		// Floating point to floating point
+		{name: "float64 to float32 rounds", value: NewValue("float64", "0.1"), typeName: "float32", expected: NewValue("float32", "0.1")},
+		{name: "float32 to float64", value: NewValue("float32", "0.5"), typeName: "float64", expected: NewValue("float64", "0.5")},
+		{name: "float64 overflows float32", value: NewValue("float64", "1e300"), typeName: "float", err: ConversionErr{Context: context, Data: "1e300", TypeName: "float"}},
+
+		// To string
+		{name: "int to string", value: NewValue("int", "-42"), typeName: "string", expected: NewValue("string", `"-42"`)},
+		{name: "uint64 to string", value: NewValue("uint64", "18446744073709551615"), typeName: "string", expected: NewValue("string", `"18446744073709551615"`)},
+		{name: "float to string", value: NewValue("float", "2.50"), typeName: "string", expected: NewValue("string", `"2.5"`)},
+		{name: "bool to string", value: NewValue("bool", "true"), typeName: "string", expected: NewValue("string", `"true"`)},
+
+		// From string
+		{name: "string to int", value: NewValue("string", `"-42"`), typeName: "int", expected: NewValue("int", "-42")},
+		{name: "string out of range", value: NewValue("string", `"300"`), typeName: "uint8", err: ConversionErr{Context: context, Data: `"300"`, TypeName: "uint8"}},
+		{name: "string not a number", value: NewValue("string", `"abc"`), typeName: "int", err: ConversionErr{Context: context, Data: `"abc"`, TypeName: "int"}},
+		{name: "string to float", value: NewValue("string", `"-1.25"`), typeName: "float64", expected: NewValue("float64", "-1.25")},
+		{name: "string to float not a literal", value: NewValue("string", `"NaN"`), typeName: "float", err: ConversionErr{Context: context, Data: `"NaN"`, TypeName: "float"}},
+		{name: "string to bool", value: NewValue("string", `"false"`), typeName: "bool", expected: NewValue("bool", "false")},
+		{name: "string to bool not a bool", value: NewValue("string", `"1"`), typeName: "bool", err: ConversionErr{Context: context, Data: `"1"`, TypeName: "bool"}},
+
+		// Between numbers and bool
+		{name: "true to int", value: NewValue("bool", "true"), typeName: "int", expected: NewValue("int", "1")},
+		{name: "false to uint8", value: NewValue("bool", "false"), typeName: "uint8", expected: NewValue("uint8", "0")},
+		{name: "true to float", value: NewValue("bool", "true"), typeName: "float64", expected: NewValue("float64", "1")},
+		{name: "int to bool", value: NewValue("int", "-3"), typeName: "bool", expected: NewValue("bool", "true")},
+		{name: "zero to bool", value: NewValue("uint64", "0"), typeName: "bool", expected: NewValue("bool", "false")},
+		{name: "untyped int to bool", value: NewValue("untyped int", "18446744073709551615"), typeName: "bool", expected: NewValue("bool", "true")},
+		{name: "float to bool", value: NewValue("float", "0.5"), typeName: "bool", expected: NewValue("bool", "true")},
+		{name: "negative zero to bool", value: NewValue("float64", "-0"), typeName: "bool", expected: NewValue("bool", "false")},
+
+		// Not allowed
+		{name: "enum to int", value: NewValue("Color", "Red"), typeName: "int", err: InvalidConversionErr{Context: context, FromTypeName: "Color", ToTypeName: "int"}},
+		{name: "string to enum", value: NewValue("string", `"Red"`), typeName: "Color", err: InvalidConversionErr{Context: context, FromTypeName: "string", ToTypeName: "Color"}},
+		{name: "unknown type", value: NewValue("int", "1"), typeName: "unknown", err: UnknownTypeErr{Context: context, TypeName: "unknown"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := interpreter.ConvertValue(context, test.value, test.typeName)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Equal(t, NewErrorValue(test.err), value)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
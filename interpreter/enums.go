@@ -0,0 +1,103 @@
+package interpreter
+
+import "fmt"
+
+// AddEnumType declares a new enum type with the given members in declaration order.
+// An enum value holds the name of one of its type's members, and the type's zero value is its first member.
+func (interpreter *SimInterpreter) AddEnumType(context ParseContext, typeName string, members []string) error {
+	if err := interpreter.checkTypeName(context, typeName); err != nil {
+		return err
+	}
+
+	memberNames := make(map[string]struct{})
+
+	for _, member := range members {
+		if _, ok := memberNames[member]; ok {
+			return MemberExistsErr{Context: context, TypeName: typeName, MemberName: member}
+		}
+
+		memberNames[member] = struct{}{}
+	}
+
+	interpreter.types[typeName] = TypeData{
+		zeroValue:       NewValue(typeName, members[0]),
+		typeInfo:        TypeInfoEnum,
+		members:         members,
+		implicitCastMap: map[string]struct{}{},
+	}
+
+	return nil
+}
+
+// GetEnumMember returns the value of the named member of an enum type, such as Color.Red.
+func (interpreter *SimInterpreter) GetEnumMember(context ParseContext, typeName string, memberName string) (Value, error) {
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if !typeData.IsEnum() {
+		err := InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+		return NewErrorValue(err), err
+	}
+
+	if _, ok := typeData.MemberIndex(memberName); !ok {
+		err := UnknownMemberErr{Context: context, TypeName: typeName, MemberName: memberName}
+		return NewErrorValue(err), err
+	}
+
+	return NewValue(typeName, memberName), nil
+}
+
+// CheckExhaustive returns an error if a branch over the values of an enum or union type doesn't handle all of its members or variants.
+// The given names are the members or variants that the branch handles, and the error lists the ones it is missing in declaration order.
+func (interpreter *SimInterpreter) CheckExhaustive(context ParseContext, typeName string, memberNames []string) error {
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return err
+	}
+
+	var members []string
+
+	switch {
+	case typeData.IsEnum():
+		members = typeData.members
+	case typeData.IsUnion():
+		for _, variant := range typeData.variants {
+			members = append(members, variant.name)
+		}
+	default:
+		return InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+	}
+
+	handled := make(map[string]struct{})
+	for _, memberName := range memberNames {
+		handled[memberName] = struct{}{}
+	}
+
+	var missing []string
+	for _, member := range members {
+		if _, ok := handled[member]; !ok {
+			missing = append(missing, member)
+		}
+	}
+
+	if len(missing) > 0 {
+		return MissingMembersErr{Context: context, TypeName: typeName, MemberNames: missing}
+	}
+
+	return nil
+}
+
+// Enum values are equal when they are the same member. Enums can't be used with any other operator.
+func (interpreter *SimInterpreter) handleEnumBinaryOperations(leftContext ParseContext, leftVal, rightVal Value, typeName string, operator string) (Value, error) {
+	switch operator {
+	case "==":
+		return NewValue("bool", fmt.Sprintf("%t", leftVal.data == rightVal.data)), nil
+	case "!=":
+		return NewValue("bool", fmt.Sprintf("%t", leftVal.data != rightVal.data)), nil
+	default:
+		err := InvalidOperationErr{Context: leftContext, TypeNames: []string{typeName, typeName}}
+		return NewErrorValue(err), err
+	}
+}
@@ -0,0 +1,154 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpreterAddEnumType(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	t.Run("type exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddEnumType(context, "int", []string{"A"})
+		assert.EqualError(t, err, TypeExistsErr{TypeName: "int"}.Error())
+	})
+
+	t.Run("function exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddEnumType(context, "len", []string{"A"})
+		assert.EqualError(t, err, FunctionExistsErr{FuncName: "len"}.Error())
+		assert.NotContains(t, interpreter.types, "len")
+	})
+
+	t.Run("member exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddEnumType(context, "Color", []string{"Red", "Green", "Red"})
+		assert.EqualError(t, err, MemberExistsErr{TypeName: "Color", MemberName: "Red"}.Error())
+		assert.NotContains(t, interpreter.types, "Color")
+	})
+
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddEnumType(context, "Color", []string{"Red", "Green", "Blue"})
+	assert.NoError(t, err)
+
+	typeData, err := interpreter.GetTypeData(context, "Color")
+	assert.NoError(t, err)
+	assert.True(t, typeData.IsEnum())
+	assert.Equal(t, []string{"Red", "Green", "Blue"}, typeData.Members())
+	assert.Equal(t, NewValue("Color", "Red"), typeData.zeroValue)
+
+	index, ok := typeData.MemberIndex("Blue")
+	assert.True(t, ok)
+	assert.Equal(t, 2, index)
+
+	_, ok = typeData.MemberIndex("Purple")
+	assert.False(t, ok)
+}
+
+func TestInterpreterGetEnumMember(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddEnumType(context, "Color", []string{"Red", "Green", "Blue"})
+	assert.NoError(t, err)
+
+	t.Run("unknown member", func(t *testing.T) {
+		value, err := interpreter.GetEnumMember(context, "Color", "Purple")
+		expectedErr := UnknownMemberErr{TypeName: "Color", MemberName: "Purple"}
+		assert.EqualError(t, err, expectedErr.Error())
+		assert.Equal(t, NewErrorValue(expectedErr), value)
+	})
+
+	t.Run("not an enum", func(t *testing.T) {
+		_, err := interpreter.GetEnumMember(context, "int", "Red")
+		assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"int"}}.Error())
+	})
+
+	value, err := interpreter.GetEnumMember(context, "Color", "Green")
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("Color", "Green"), value)
+}
+
+func TestInterpreterCheckExhaustive(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddEnumType(context, "Color", []string{"Red", "Green", "Blue"})
+	assert.NoError(t, err)
+
+	err = interpreter.CheckExhaustive(context, "Color", []string{"Blue", "Red", "Green"})
+	assert.NoError(t, err)
+
+	err = interpreter.CheckExhaustive(context, "Color", []string{"Green"})
+	assert.EqualError(t, err, MissingMembersErr{TypeName: "Color", MemberNames: []string{"Red", "Blue"}}.Error())
+
+	err = interpreter.CheckExhaustive(context, "bool", []string{"true"})
+	assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"bool"}}.Error())
+}
+
+func TestInterpreterResolveEnumBinaryOperations(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddEnumType(context, "Color", []string{"Red", "Green"})
+	assert.NoError(t, err)
+
+	red, green := NewValue("Color", "Red"), NewValue("Color", "Green")
+
+	tests := []struct {
+		left     Value
+		right    Value
+		operator string
+		expected Value
+		err      error
+	}{
+		{left: red, right: red, operator: "==", expected: NewValue("bool", "true")},
+		{left: red, right: green, operator: "==", expected: NewValue("bool", "false")},
+		{left: red, right: green, operator: "!=", expected: NewValue("bool", "true")},
+		{left: red, right: green, operator: "<", err: InvalidOperationErr{TypeNames: []string{"Color", "Color"}}},
+		{left: red, right: NewValue("int", "0"), operator: "==", err: InvalidOperationErr{TypeNames: []string{"Color", "int"}}},
+	}
+
+	for _, test := range tests {
+		value, err := interpreter.ResolveBinaryOperations(context, context, test.left, test.right, test.operator)
+		if test.err != nil {
+			assert.EqualError(t, err, test.err.Error())
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, value)
+	}
+}
+
+func TestInterpreterEnumValues(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddEnumType(context, "Color", []string{"Red", "Green"})
+	assert.NoError(t, err)
+
+	err = interpreter.AddVar(context, NewVariable("c", NewValue("Color", "")))
+	assert.NoError(t, err)
+	assert.Equal(t, NewVariable("c", NewValue("Color", "Red")), interpreter.GetAllVars()["c"])
+
+	err = interpreter.SetVarValue(context, "c", NewValue("Color", "Purple"))
+	assert.EqualError(t, err, MismatchedTypeAssignErr{Var: NewVariable("c", NewValue("Color", "Red"))}.Error())
+
+	text, err := interpreter.FormatValue(context, NewValue("Color", "Green"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Green", text)
+
+	value, err := interpreter.ConvertValue(context, NewValue("Color", "Green"), "string")
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("string", `"Green"`), value)
+
+	_, err = interpreter.ConvertValue(context, NewValue("Color", "Green"), "int")
+	assert.EqualError(t, err, InvalidConversionErr{FromTypeName: "Color", ToTypeName: "int"}.Error())
+}
@@ -1,6 +1,9 @@
 package interpreter
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // ExitGlobalScopeErr describes an attempt to pop off the global scope.
 type ExitGlobalScopeErr struct {
@@ -11,6 +14,27 @@ func (e ExitGlobalScopeErr) Error() string {
 	return fmt.Sprintf("%s: attempted to exit the global scope", e.Context.String())
 }
 
+// ExitGlobalFrameErr describes an attempt to pop off the global call frame.
+type ExitGlobalFrameErr struct {
+	Context ParseContext
+}
+
+func (e ExitGlobalFrameErr) Error() string {
+	return fmt.Sprintf("%s: attempted to exit the global call frame", e.Context.String())
+}
+
+// CallDepthErr is returned when a function is called while too many other calls are already in progress,
+// which usually means that a recursive function never stops calling itself.
+type CallDepthErr struct {
+	Context  ParseContext
+	FuncName string
+	Depth    int
+}
+
+func (e CallDepthErr) Error() string {
+	return fmt.Sprintf("%s: call to %s exceeds the maximum call depth of %d", e.Context.String(), e.FuncName, e.Depth)
+}
+
 // UnknownTypeErr is returned when a type is referenced that has not yet been declared.
 type UnknownTypeErr struct {
 	Context  ParseContext
@@ -60,7 +84,7 @@ type MismatchedTypeAssignErr struct {
 }
 
 func (e MismatchedTypeAssignErr) Error() string {
-	return fmt.Sprintf("%s: cannot assign %s to %s of type %s", e.Context.String(), e.Var.value.data, e.Var.name, e.Var.value.typeName)
+	return fmt.Sprintf("%s: cannot assign %s to %s of type %s", e.Context.String(), e.Var.value, e.Var.name, e.Var.value.typeName)
 }
 
 // DataTypeErr is returned when a value's data mismatches its expected type.
@@ -150,3 +174,458 @@ type DivideByZeroErr struct {
 func (e DivideByZeroErr) Error() string {
 	return fmt.Sprintf("%s: divide by zero", e.Context.String())
 }
+
+// UnknownFunctionErr is returned when a function is referenced that has not yet been declared.
+type UnknownFunctionErr struct {
+	Context  ParseContext
+	FuncName string
+}
+
+func (e UnknownFunctionErr) Error() string {
+	return fmt.Sprintf("%s: function %s is not declared", e.Context.String(), e.FuncName)
+}
+
+// FunctionExistsErr is returned when a function has already been declared but is trying to be declared again.
+type FunctionExistsErr struct {
+	Context  ParseContext
+	FuncName string
+}
+
+func (e FunctionExistsErr) Error() string {
+	return fmt.Sprintf("%s: function %s is already declared", e.Context.String(), e.FuncName)
+}
+
+// MissingReturnErr is returned when a function finishes without returning a value.
+type MissingReturnErr struct {
+	Context  ParseContext
+	FuncName string
+	TypeName string
+}
+
+func (e MissingReturnErr) Error() string {
+	return fmt.Sprintf("%s: function %s must return a value of type %s", e.Context.String(), e.FuncName, e.TypeName)
+}
+
+// MismatchedReturnTypeErr is returned when a function returns a value
+// whose type is mismatched with the function's return type.
+type MismatchedReturnTypeErr struct {
+	Context       ParseContext
+	FuncName      string
+	TypeName      string
+	ValueTypeName string
+}
+
+func (e MismatchedReturnTypeErr) Error() string {
+	return fmt.Sprintf("%s: cannot return %s from function %s with return type %s", e.Context.String(), e.ValueTypeName, e.FuncName, e.TypeName)
+}
+
+// MismatchedArgCountErr is returned when a function is called with the wrong number of arguments.
+type MismatchedArgCountErr struct {
+	Context  ParseContext
+	FuncName string
+	Expected int
+	Actual   int
+}
+
+func (e MismatchedArgCountErr) Error() string {
+	return fmt.Sprintf("%s: function %s expects %d arguments but was called with %d", e.Context.String(), e.FuncName, e.Expected, e.Actual)
+}
+
+// MismatchedArgTypeErr is returned when a function is called with an argument
+// whose type is mismatched with the type of its parameter.
+type MismatchedArgTypeErr struct {
+	Context       ParseContext
+	FuncName      string
+	ParamName     string
+	TypeName      string
+	ValueTypeName string
+}
+
+func (e MismatchedArgTypeErr) Error() string {
+	return fmt.Sprintf("%s: cannot pass %s as parameter %s of type %s to function %s", e.Context.String(), e.ValueTypeName, e.ParamName, e.TypeName, e.FuncName)
+}
+
+// MissingMainErr is returned when a program is run that does not declare a main function.
+type MissingMainErr struct{}
+
+func (e MissingMainErr) Error() string {
+	return "function main is not declared"
+}
+
+// InvalidMainErr is returned when a program's main function does not have the signature main() : int.
+type InvalidMainErr struct {
+	Function Function
+}
+
+func (e InvalidMainErr) Error() string {
+	paramTypeNames := make([]string, len(e.Function.params))
+	for i, param := range e.Function.params {
+		paramTypeNames[i] = param.typeName
+	}
+
+	return fmt.Sprintf("function main must be declared as main() : int, not main(%s) : %s", strings.Join(paramTypeNames, ", "), e.Function.returnTypeName)
+}
+
+// InvalidEscapeErr is returned when a string literal contains an unknown or malformed escape sequence.
+type InvalidEscapeErr struct {
+	Context  ParseContext
+	Sequence string
+}
+
+func (e InvalidEscapeErr) Error() string {
+	return fmt.Sprintf("%s: invalid escape sequence %s", e.Context.String(), e.Sequence)
+}
+
+// IndexOutOfRangeErr is returned when an index is outside of the bounds of the value being indexed.
+type IndexOutOfRangeErr struct {
+	Context ParseContext
+	Index   int
+	Length  int
+}
+
+func (e IndexOutOfRangeErr) Error() string {
+	return fmt.Sprintf("%s: index %d is out of range for length %d", e.Context.String(), e.Index, e.Length)
+}
+
+// InvalidConversionErr is returned when a value is explicitly converted to a type that it can't be converted to.
+type InvalidConversionErr struct {
+	Context      ParseContext
+	FromTypeName string
+	ToTypeName   string
+}
+
+func (e InvalidConversionErr) Error() string {
+	return fmt.Sprintf("%s: cannot convert from %s to %s", e.Context.String(), e.FromTypeName, e.ToTypeName)
+}
+
+// ConversionErr is returned when a value's type can be converted to another type,
+// but the value itself can't be represented by that type.
+type ConversionErr struct {
+	Context  ParseContext
+	Data     string
+	TypeName string
+}
+
+func (e ConversionErr) Error() string {
+	return fmt.Sprintf("%s: cannot convert %s to %s", e.Context.String(), e.Data, e.TypeName)
+}
+
+// TypeExistsErr is returned when a type has already been declared but is trying to be declared again.
+type TypeExistsErr struct {
+	Context  ParseContext
+	TypeName string
+}
+
+func (e TypeExistsErr) Error() string {
+	return fmt.Sprintf("%s: type %s is already declared", e.Context.String(), e.TypeName)
+}
+
+// FieldExistsErr is returned when a struct type declares the same field more than once.
+type FieldExistsErr struct {
+	Context   ParseContext
+	TypeName  string
+	FieldName string
+}
+
+func (e FieldExistsErr) Error() string {
+	return fmt.Sprintf("%s: field %s is already declared in type %s", e.Context.String(), e.FieldName, e.TypeName)
+}
+
+// UnknownFieldErr is returned when a field is referenced that the value's type doesn't have.
+type UnknownFieldErr struct {
+	Context   ParseContext
+	TypeName  string
+	FieldName string
+}
+
+func (e UnknownFieldErr) Error() string {
+	return fmt.Sprintf("%s: type %s has no field %s", e.Context.String(), e.TypeName, e.FieldName)
+}
+
+// MismatchedFieldTypeErr is returned when a struct field is given a value whose type is mismatched with the field's type.
+type MismatchedFieldTypeErr struct {
+	Context       ParseContext
+	TypeName      string
+	FieldName     string
+	FieldTypeName string
+	ValueTypeName string
+}
+
+func (e MismatchedFieldTypeErr) Error() string {
+	return fmt.Sprintf("%s: cannot assign %s to field %s of type %s in %s", e.Context.String(), e.ValueTypeName, e.FieldName, e.FieldTypeName, e.TypeName)
+}
+
+// InvalidAssignmentErr is returned when the target of an assignment is not a variable or a field.
+type InvalidAssignmentErr struct {
+	Context ParseContext
+	Target  string
+}
+
+func (e InvalidAssignmentErr) Error() string {
+	return fmt.Sprintf("%s: cannot assign to %s", e.Context.String(), e.Target)
+}
+
+// MismatchedElementTypeErr is returned when an array element is given a value whose type is mismatched with the array's element type.
+type MismatchedElementTypeErr struct {
+	Context         ParseContext
+	TypeName        string
+	ElementTypeName string
+	ValueTypeName   string
+}
+
+func (e MismatchedElementTypeErr) Error() string {
+	return fmt.Sprintf("%s: cannot use %s as an element of type %s in %s", e.Context.String(), e.ValueTypeName, e.ElementTypeName, e.TypeName)
+}
+
+// MismatchedArrayLengthErr is returned when an array literal has a different number of elements than its array type.
+type MismatchedArrayLengthErr struct {
+	Context  ParseContext
+	TypeName string
+	Expected int
+	Actual   int
+}
+
+func (e MismatchedArrayLengthErr) Error() string {
+	return fmt.Sprintf("%s: %s needs %d elements but was given %d", e.Context.String(), e.TypeName, e.Expected, e.Actual)
+}
+
+// UntypedArrayErr is returned when the type of an array literal can't be inferred because it has no elements.
+type UntypedArrayErr struct {
+	Context ParseContext
+}
+
+func (e UntypedArrayErr) Error() string {
+	return fmt.Sprintf("%s: cannot infer the type of an empty array literal", e.Context.String())
+}
+
+// MissingKeyErr is returned when a map is looked up with a key that isn't in the map.
+type MissingKeyErr struct {
+	Context  ParseContext
+	TypeName string
+	Key      string
+}
+
+func (e MissingKeyErr) Error() string {
+	return fmt.Sprintf("%s: key %s not found in %s", e.Context.String(), e.Key, e.TypeName)
+}
+
+// MismatchedKeyTypeErr is returned when a map is given a key whose type is mismatched with the map's key type.
+type MismatchedKeyTypeErr struct {
+	Context       ParseContext
+	TypeName      string
+	KeyTypeName   string
+	ValueTypeName string
+}
+
+func (e MismatchedKeyTypeErr) Error() string {
+	return fmt.Sprintf("%s: cannot use %s as a key of type %s in %s", e.Context.String(), e.ValueTypeName, e.KeyTypeName, e.TypeName)
+}
+
+// InvalidKeyTypeErr is returned when a map type is declared with a key type whose values can't be compared for equality.
+type InvalidKeyTypeErr struct {
+	Context     ParseContext
+	TypeName    string
+	KeyTypeName string
+}
+
+func (e InvalidKeyTypeErr) Error() string {
+	return fmt.Sprintf("%s: invalid key type %s in %s, keys must be comparable", e.Context.String(), e.KeyTypeName, e.TypeName)
+}
+
+// UntypedMapErr is returned when the type of a map literal can't be inferred because it has no entries.
+type UntypedMapErr struct {
+	Context ParseContext
+}
+
+func (e UntypedMapErr) Error() string {
+	return fmt.Sprintf("%s: cannot infer the type of an empty map literal", e.Context.String())
+}
+
+// MemberExistsErr is returned when an enum type declares the same member more than once.
+type MemberExistsErr struct {
+	Context    ParseContext
+	TypeName   string
+	MemberName string
+}
+
+func (e MemberExistsErr) Error() string {
+	return fmt.Sprintf("%s: member %s is already declared in type %s", e.Context.String(), e.MemberName, e.TypeName)
+}
+
+// UnknownMemberErr is returned when a member is referenced that the enum type doesn't have.
+type UnknownMemberErr struct {
+	Context    ParseContext
+	TypeName   string
+	MemberName string
+}
+
+func (e UnknownMemberErr) Error() string {
+	return fmt.Sprintf("%s: type %s has no member %s", e.Context.String(), e.TypeName, e.MemberName)
+}
+
+// MissingMembersErr is returned when a branch over the values of an enum or union type doesn't handle all of its members or variants.
+type MissingMembersErr struct {
+	Context     ParseContext
+	TypeName    string
+	MemberNames []string
+}
+
+func (e MissingMembersErr) Error() string {
+	return fmt.Sprintf("%s: missing cases for %s: %s", e.Context.String(), e.TypeName, strings.Join(e.MemberNames, ", "))
+}
+
+// VariantExistsErr is returned when a union variant is declared with a name that is already used by a variant.
+type VariantExistsErr struct {
+	Context     ParseContext
+	VariantName string
+}
+
+func (e VariantExistsErr) Error() string {
+	return fmt.Sprintf("%s: variant %s is already declared", e.Context.String(), e.VariantName)
+}
+
+// UnknownVariantErr is returned when a variant is referenced that the union type doesn't have.
+type UnknownVariantErr struct {
+	Context     ParseContext
+	TypeName    string
+	VariantName string
+}
+
+func (e UnknownVariantErr) Error() string {
+	return fmt.Sprintf("%s: type %s has no variant %s", e.Context.String(), e.TypeName, e.VariantName)
+}
+
+// RecursiveTypeErr is returned when every variant of a union type holds the union itself, so no value of it could ever be built.
+type RecursiveTypeErr struct {
+	Context  ParseContext
+	TypeName string
+}
+
+func (e RecursiveTypeErr) Error() string {
+	return fmt.Sprintf("%s: type %s needs a variant that doesn't hold itself", e.Context.String(), e.TypeName)
+}
+
+// MismatchedBindingCountErr is returned when a match case binds a different number of variables than its variant has fields.
+type MismatchedBindingCountErr struct {
+	Context  ParseContext
+	CaseName string
+	Expected int
+	Actual   int
+}
+
+func (e MismatchedBindingCountErr) Error() string {
+	return fmt.Sprintf("%s: case %s binds %d variables but expected %d", e.Context.String(), e.CaseName, e.Actual, e.Expected)
+}
+
+// DuplicateCaseErr is returned when a branch handles the same case more than once.
+type DuplicateCaseErr struct {
+	Context  ParseContext
+	CaseName string
+}
+
+func (e DuplicateCaseErr) Error() string {
+	return fmt.Sprintf("%s: duplicate case %s", e.Context.String(), e.CaseName)
+}
+
+// NilFunctionErr is returned when a function value is called before a function has been assigned to it.
+type NilFunctionErr struct {
+	Context ParseContext
+}
+
+func (e NilFunctionErr) Error() string {
+	return fmt.Sprintf("%s: cannot call a nil function", e.Context.String())
+}
+
+// InvalidFunctionValueErr is returned when a built-in function that accepts values of any type is used as a value,
+// since it has no function type.
+type InvalidFunctionValueErr struct {
+	Context  ParseContext
+	FuncName string
+}
+
+func (e InvalidFunctionValueErr) Error() string {
+	return fmt.Sprintf("%s: function %s cannot be used as a value", e.Context.String(), e.FuncName)
+}
+
+// ConstAssignErr is returned when a constant is assigned a value after it has been declared.
+type ConstAssignErr struct {
+	Context     ParseContext
+	VarName     string
+	DeclContext ParseContext
+}
+
+func (e ConstAssignErr) Error() string {
+	return fmt.Sprintf("%s: cannot assign to const %s declared at %s", e.Context.String(), e.VarName, e.DeclContext.String())
+}
+
+// NonConstantExprErr is returned when a constant is declared with a value that can't be worked out before the program runs.
+type NonConstantExprErr struct {
+	Context ParseContext
+	VarName string
+}
+
+func (e NonConstantExprErr) Error() string {
+	return fmt.Sprintf("%s: initializer of const %s is not a constant expression", e.Context.String(), e.VarName)
+}
+
+// UninferableTypeErr is returned when a variable is declared without a type and its initializer has no type for it to take on.
+type UninferableTypeErr struct {
+	Context ParseContext
+	VarName string
+	Literal string
+}
+
+func (e UninferableTypeErr) Error() string {
+	return fmt.Sprintf("%s: cannot infer the type of %s from an empty %s literal, declare it with a type instead", e.Context.String(), e.VarName, e.Literal)
+}
+
+// UnknownLabelErr is returned when a break or continue uses a label that doesn't belong to a loop enclosing it.
+type UnknownLabelErr struct {
+	Context ParseContext
+	Label   string
+}
+
+func (e UnknownLabelErr) Error() string {
+	return fmt.Sprintf("%s: label %s is not declared on an enclosing loop", e.Context.String(), e.Label)
+}
+
+// DuplicateLabelErr is returned when a loop uses the same label as a loop enclosing it.
+type DuplicateLabelErr struct {
+	Context ParseContext
+	Label   string
+}
+
+func (e DuplicateLabelErr) Error() string {
+	return fmt.Sprintf("%s: label %s is already declared on an enclosing loop", e.Context.String(), e.Label)
+}
+
+// InvalidStepErr is returned when a range loop is given a step that isn't greater than zero.
+type InvalidStepErr struct {
+	Context ParseContext
+	Step    string
+}
+
+func (e InvalidStepErr) Error() string {
+	return fmt.Sprintf("%s: loop step must be greater than zero, not %s", e.Context.String(), e.Step)
+}
+
+// NegativeShiftErr is returned when the bits of an integer are shifted by a negative count.
+type NegativeShiftErr struct {
+	Context ParseContext
+	Count   string
+}
+
+func (e NegativeShiftErr) Error() string {
+	return fmt.Sprintf("%s: cannot shift by negative count %s", e.Context.String(), e.Count)
+}
+
+// InvalidCompareFunctionErr is returned when the function that orders a type doesn't take two values of the type and return an int.
+type InvalidCompareFunctionErr struct {
+	Context  ParseContext
+	TypeName string
+	FuncName string
+}
+
+func (e InvalidCompareFunctionErr) Error() string {
+	return fmt.Sprintf("%s: type %s is ordered by %s, so it must be declared as %s(%s a, %s b) : int", e.Context.String(), e.TypeName, e.FuncName, e.FuncName, e.TypeName, e.TypeName)
+}
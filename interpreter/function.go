@@ -0,0 +1,76 @@
+package interpreter
+
+// Parameter is a named, typed input to a function.
+type Parameter struct {
+	name     string
+	typeName string
+}
+
+// NewParameter returns a new instance of a parameter.
+func NewParameter(name string, typeName string) Parameter {
+	return Parameter{
+		name:     name,
+		typeName: typeName,
+	}
+}
+
+// Name returns the name of the parameter.
+func (p Parameter) Name() string {
+	return p.name
+}
+
+// TypeName returns the name of the parameter's type.
+func (p Parameter) TypeName() string {
+	return p.typeName
+}
+
+// Function is a user-defined function with a typed signature.
+// The body is stored as-is so that it can be evaluated by whoever calls the function.
+// Functions created from function literals also hold the scope they were created in.
+type Function struct {
+	name           string
+	params         []Parameter
+	returnTypeName string
+	body           interface{}
+	scope          *scope
+}
+
+// NewFunction returns a new instance of a function.
+func NewFunction(name string, params []Parameter, returnTypeName string, body interface{}) Function {
+	return Function{
+		name:           name,
+		params:         params,
+		returnTypeName: returnTypeName,
+		body:           body,
+	}
+}
+
+// Name returns the name of the function.
+func (f Function) Name() string {
+	return f.name
+}
+
+// Params returns the parameters of the function in declaration order.
+func (f Function) Params() []Parameter {
+	return f.params
+}
+
+// ReturnTypeName returns the name of the type the function returns.
+func (f Function) ReturnTypeName() string {
+	return f.returnTypeName
+}
+
+// Body returns the body of the function.
+func (f Function) Body() interface{} {
+	return f.body
+}
+
+// TypeName returns the name of the function's type, such as fn(int,int):int.
+func (f Function) TypeName() string {
+	paramTypeNames := make([]string, len(f.params))
+	for i, param := range f.params {
+		paramTypeNames[i] = param.typeName
+	}
+
+	return FunctionTypeName(paramTypeNames, f.returnTypeName)
+}
@@ -3,18 +3,48 @@ package interpreter
 import (
 	"fmt"
 	"io"
+	"math"
+	"strconv"
+	"strings"
 )
 
+// scope stores the variables declared in a block, linked to the scope that the block is nested in.
+// Looking up a variable walks outward through the enclosing scopes,
+// so a variable in an inner scope shadows any outer variable with the same name.
 type scope struct {
-	varNames []string
+	vars   map[string]Variable
+	parent *scope
+}
+
+// Helper function to create a new empty scope nested in the given scope.
+func newScope(parent *scope) *scope {
+	return &scope{
+		vars:   make(map[string]Variable),
+		parent: parent,
+	}
+}
+
+// MaxCallDepth is the most function calls that can be in progress at once.
+// Calling a function any deeper returns a CallDepthErr instead of running out of stack.
+const MaxCallDepth = 10000
+
+// callFrame stores the scope of a caller while the function it called is being executed,
+// along with the outermost scope of the callee.
+type callFrame struct {
+	function    Function
+	callerScope *scope
+	scope       *scope
 }
 
 // SimInterpreter interprents Sim by simulating a runtime environment,
 // keeping track of declared types, variables, scopes, etc.
 type SimInterpreter struct {
-	types  map[string]TypeData
-	vars   map[string]Variable
-	scopes []*scope
+	types     map[string]TypeData
+	functions map[string]Function
+	variants  map[string]string
+	globals   *scope
+	currScope *scope
+	frames    []*callFrame
 
 	output io.ReadWriter
 }
@@ -25,6 +55,7 @@ func getBasicTypes() map[string]TypeData {
 	types["int"] = TypeData{
 		zeroValue: NewValue("int", "0"),
 		typeInfo:  TypeInfoSignedInteger,
+		bitSize:   32,
 		implicitCastMap: map[string]struct{}{
 			"int32": {},
 			"int64": {},
@@ -34,6 +65,7 @@ func getBasicTypes() map[string]TypeData {
 	types["int8"] = TypeData{
 		zeroValue: NewValue("int8", "0"),
 		typeInfo:  TypeInfoSignedInteger,
+		bitSize:   8,
 		implicitCastMap: map[string]struct{}{
 			"int":   {},
 			"int16": {},
@@ -45,6 +77,7 @@ func getBasicTypes() map[string]TypeData {
 	types["int16"] = TypeData{
 		zeroValue: NewValue("int16", "0"),
 		typeInfo:  TypeInfoSignedInteger,
+		bitSize:   16,
 		implicitCastMap: map[string]struct{}{
 			"int":   {},
 			"int32": {},
@@ -55,6 +88,7 @@ func getBasicTypes() map[string]TypeData {
 	types["int32"] = TypeData{
 		zeroValue: NewValue("int32", "0"),
 		typeInfo:  TypeInfoSignedInteger,
+		bitSize:   32,
 		implicitCastMap: map[string]struct{}{
 			"int":   {},
 			"int64": {},
@@ -64,12 +98,14 @@ func getBasicTypes() map[string]TypeData {
 	types["int64"] = TypeData{
 		zeroValue:       NewValue("int64", "0"),
 		typeInfo:        TypeInfoSignedInteger,
+		bitSize:         64,
 		implicitCastMap: map[string]struct{}{},
 	}
 
 	types["uint"] = TypeData{
 		zeroValue: NewValue("uint", "0"),
 		typeInfo:  TypeInfoUnsignedInteger,
+		bitSize:   32,
 		implicitCastMap: map[string]struct{}{
 			"uint32": {},
 			"uint64": {},
@@ -79,6 +115,7 @@ func getBasicTypes() map[string]TypeData {
 	types["byte"] = TypeData{
 		zeroValue: NewValue("byte", "0"),
 		typeInfo:  TypeInfoUnsignedInteger,
+		bitSize:   8,
 		implicitCastMap: map[string]struct{}{
 			"uint":   {},
 			"uint8":  {},
@@ -91,6 +128,7 @@ func getBasicTypes() map[string]TypeData {
 	types["uint8"] = TypeData{
 		zeroValue: NewValue("uint8", "0"),
 		typeInfo:  TypeInfoUnsignedInteger,
+		bitSize:   8,
 		implicitCastMap: map[string]struct{}{
 			"byte":   {},
 			"uint":   {},
@@ -103,6 +141,7 @@ func getBasicTypes() map[string]TypeData {
 	types["uint16"] = TypeData{
 		zeroValue: NewValue("uint16", "0"),
 		typeInfo:  TypeInfoUnsignedInteger,
+		bitSize:   16,
 		implicitCastMap: map[string]struct{}{
 			"uint":   {},
 			"uint32": {},
@@ -113,6 +152,7 @@ func getBasicTypes() map[string]TypeData {
 	types["uint32"] = TypeData{
 		zeroValue: NewValue("uint32", "0"),
 		typeInfo:  TypeInfoUnsignedInteger,
+		bitSize:   32,
 		implicitCastMap: map[string]struct{}{
 			"uint":   {},
 			"uint64": {},
@@ -122,12 +162,14 @@ func getBasicTypes() map[string]TypeData {
 	types["uint64"] = TypeData{
 		zeroValue:       NewValue("uint64", "0"),
 		typeInfo:        TypeInfoUnsignedInteger,
+		bitSize:         64,
 		implicitCastMap: map[string]struct{}{},
 	}
 
 	types["float"] = TypeData{
 		zeroValue: NewValue("float", "0.0"),
 		typeInfo:  TypeInfoFloatingPoint,
+		bitSize:   32,
 		implicitCastMap: map[string]struct{}{
 			"float32": {},
 			"float64": {},
@@ -137,6 +179,7 @@ func getBasicTypes() map[string]TypeData {
 	types["float32"] = TypeData{
 		zeroValue: NewValue("float32", "0.0"),
 		typeInfo:  TypeInfoFloatingPoint,
+		bitSize:   32,
 		implicitCastMap: map[string]struct{}{
 			"float":   {},
 			"float64": {},
@@ -146,6 +189,7 @@ func getBasicTypes() map[string]TypeData {
 	types["float64"] = TypeData{
 		zeroValue:       NewValue("float64", "0.0"),
 		typeInfo:        TypeInfoFloatingPoint,
+		bitSize:         64,
 		implicitCastMap: map[string]struct{}{},
 	}
 
@@ -167,12 +211,19 @@ func getBasicTypes() map[string]TypeData {
 // NewSimInterpreter creates a new SimInterpreter instance.
 func NewSimInterpreter(output io.ReadWriter) *SimInterpreter {
 
-	return &SimInterpreter{
-		types:  getBasicTypes(),
-		vars:   make(map[string]Variable),
-		scopes: []*scope{{}}, // Always have a global scope
-		output: output,
+	globals := newScope(nil) // Always have a global scope
+
+	interpreter := &SimInterpreter{
+		types:     getBasicTypes(),
+		variants:  make(map[string]string),
+		globals:   globals,
+		currScope: globals,
+		output:    output,
 	}
+
+	interpreter.functions = getBuiltinFunctions(interpreter)
+
+	return interpreter
 }
 
 // GetTypeData returns the type data for the provided type name,
@@ -182,48 +233,150 @@ func (interpreter *SimInterpreter) GetTypeData(context ParseContext, typeName st
 		return typeData, nil
 	}
 
+	// Function, map, array and list types are declared the first time they are used, as long as the types they are made of are declared.
+	// Function types are checked first, since the return type of a function type can be a map, array or list type.
+	if paramTypeNames, returnTypeName, ok := parseFunctionTypeName(typeName); ok {
+		return interpreter.addFunctionType(context, typeName, paramTypeNames, returnTypeName)
+	}
+
+	if keyTypeName, valueTypeName, ok := parseMapTypeName(typeName); ok {
+		return interpreter.addMapType(context, typeName, keyTypeName, valueTypeName)
+	}
+
+	if elementTypeName, length, ok := parseArrayTypeName(typeName); ok {
+		return interpreter.addArrayType(context, typeName, elementTypeName, length)
+	}
+
+	if elementTypeName, ok := parseListTypeName(typeName); ok {
+		return interpreter.addListType(context, typeName, elementTypeName)
+	}
+
 	return TypeData{}, UnknownTypeErr{Context: context, TypeName: typeName}
 }
 
-// PushScope adds a new scope to the scope stack.
-// Scopes keep track of when to remove variables.
+// AddFunction adds a new function to the function table.
+// The function's return type and parameter types must already be declared.
+func (interpreter *SimInterpreter) AddFunction(context ParseContext, function Function) error {
+	if _, ok := interpreter.functions[function.name]; ok {
+		return FunctionExistsErr{Context: context, FuncName: function.name}
+	}
+
+	// Calling a type constructs or converts to it, so a function with the same name could never be called
+	if _, ok := interpreter.types[function.name]; ok {
+		return TypeExistsErr{Context: context, TypeName: function.name}
+	}
+
+	if _, ok := interpreter.variants[function.name]; ok {
+		return VariantExistsErr{Context: context, VariantName: function.name}
+	}
+
+	if err := interpreter.checkSignature(context, function); err != nil {
+		return err
+	}
+
+	interpreter.functions[function.name] = function
+
+	return nil
+}
+
+// GetFunction returns the function given its name.
+func (interpreter *SimInterpreter) GetFunction(context ParseContext, funcName string) (Function, error) {
+	function, ok := interpreter.functions[funcName]
+	if !ok {
+		return Function{}, UnknownFunctionErr{Context: context, FuncName: funcName}
+	}
+
+	return function, nil
+}
+
+// PushFrame starts a new call frame for the given function.
+// The callee's scopes are nested directly in the global scope rather than the caller's scope,
+// so only global variables and the callee's own locals are visible inside the call.
+// Closures are the exception, since their scopes are nested in the scope that they captured.
+// Returns an error if there are already MaxCallDepth calls in progress.
+func (interpreter *SimInterpreter) PushFrame(context ParseContext, function Function) error {
+	if len(interpreter.frames) >= MaxCallDepth {
+		return CallDepthErr{Context: context, FuncName: function.name, Depth: MaxCallDepth}
+	}
+
+	parent := interpreter.globals
+	if function.scope != nil {
+		parent = function.scope
+	}
+
+	frame := &callFrame{
+		function:    function,
+		callerScope: interpreter.currScope,
+		scope:       newScope(parent),
+	}
+
+	interpreter.frames = append(interpreter.frames, frame)
+	interpreter.currScope = frame.scope
+
+	return nil
+}
+
+// PopFrame removes the most recently added call frame,
+// discarding the callee's variables and restoring the caller's.
+func (interpreter *SimInterpreter) PopFrame(context ParseContext) error {
+	if len(interpreter.frames) == 0 {
+		return ExitGlobalFrameErr{Context: context}
+	}
+
+	currFrame := interpreter.frames[len(interpreter.frames)-1]
+
+	interpreter.currScope = currFrame.callerScope
+	interpreter.frames = interpreter.frames[:len(interpreter.frames)-1]
+
+	return nil
+}
+
+// CurrentFunction returns the function that is currently being executed,
+// or false if execution is in the global frame.
+func (interpreter *SimInterpreter) CurrentFunction() (Function, bool) {
+	if len(interpreter.frames) == 0 {
+		return Function{}, false
+	}
+
+	return interpreter.frames[len(interpreter.frames)-1].function, true
+}
+
+// PushScope starts a new scope nested in the current scope.
+// Variables declared in the new scope can shadow variables of the enclosing scopes.
 func (interpreter *SimInterpreter) PushScope() {
-	interpreter.scopes = append(interpreter.scopes, &scope{})
+	interpreter.currScope = newScope(interpreter.currScope)
 }
 
-// PopScope removes the most recently added scope from the scope stack,
-// along with all of the variables declared in that scope.
+// PopScope ends the current scope, discarding all of the variables declared in it
+// and making any variables that they shadowed visible again.
+// The global scope and the outermost scope of a call frame can't be popped.
 func (interpreter *SimInterpreter) PopScope(context ParseContext) error {
-	if len(interpreter.scopes) <= 1 {
+	if interpreter.currScope == interpreter.globals {
 		return ExitGlobalScopeErr{Context: context}
 	}
 
-	currScope := interpreter.currentScope()
-
-	// Remove all variables that were declared in the current scope from the variable map
-	for i := 0; i < len(currScope.varNames); i++ {
-		delete(interpreter.vars, currScope.varNames[i])
+	if len(interpreter.frames) > 0 && interpreter.currScope == interpreter.frames[len(interpreter.frames)-1].scope {
+		return ExitGlobalScopeErr{Context: context}
 	}
 
-	interpreter.scopes = interpreter.scopes[:len(interpreter.scopes)-1]
+	interpreter.currScope = interpreter.currScope.parent
 
 	return nil
 }
 
-// AddVar adds a new variable to the variable map, owned by the current scope.
+// AddVar adds a new variable to the current scope.
+// A variable can shadow a variable of an enclosing scope, but not one declared in the same scope.
 func (interpreter *SimInterpreter) AddVar(context ParseContext, variable Variable) error {
 	if variable.value.err != nil {
 		return variable.value.err
 	}
 
-	// If the variable is still an untyped int or float, switch them to the concrete types.
-	if variable.value.typeName == "untyped int" {
-		variable.value.typeName = "int"
+	value, err := interpreter.defaultValue(context, variable.value)
+	if err != nil {
+		return err
 	}
 
-	if variable.value.typeName == "untyped float" {
-		variable.value.typeName = "float"
-	}
+	variable.value = value
 
 	typeData, ok := interpreter.types[variable.value.typeName]
 	if !ok {
@@ -237,44 +390,81 @@ func (interpreter *SimInterpreter) AddVar(context ParseContext, variable Variabl
 
 	context.TypeData = typeData
 
-	if _, ok := interpreter.vars[variable.name]; ok {
+	if _, ok := interpreter.currScope.vars[variable.name]; ok {
 		return VarExistsErr{Context: context, VarName: variable.name}
 	}
 
-	if variable.value.data == "" {
-		variable.value.data = interpreter.types[variable.value.typeName].zeroValue.data
+	if variable.value.data == "" && variable.value.items == nil {
+		variable.value = typeData.zeroValue
 	}
 
 	if ok := interpreter.validateValue(context, variable.value); !ok {
 		return MismatchedTypeAssignErr{Context: context, Var: variable}
 	}
 
-	currScope := interpreter.currentScope()
-
-	currScope.varNames = append(currScope.varNames, variable.name)
-	interpreter.vars[variable.name] = variable
+	interpreter.currScope.vars[variable.name] = variable
 
 	return nil
 }
 
-// GetVar returns the variable given its name.
+// InferValue returns the value with the type that a variable declared without a type takes on from it.
+// Untyped literals take on their default types, but empty array and map literals have no type to take on.
+func (interpreter *SimInterpreter) InferValue(context ParseContext, varName string, value Value) (Value, error) {
+	if value.err != nil {
+		return value, value.err
+	}
+
+	if (value.typeName == "untyped array" || value.typeName == "untyped map") && len(value.items) == 0 {
+		err := UninferableTypeErr{Context: context, VarName: varName, Literal: strings.TrimPrefix(value.typeName, "untyped ")}
+		return NewErrorValue(err), err
+	}
+
+	return interpreter.defaultValue(context, value)
+}
+
+// Helper function to switch an untyped value to the concrete type it defaults to.
+func (interpreter *SimInterpreter) defaultValue(context ParseContext, value Value) (Value, error) {
+	switch value.typeName {
+	case "untyped int":
+		value.typeName = "int"
+
+	case "untyped float":
+		value.typeName = "float"
+
+	case "untyped array":
+		return interpreter.typeUntypedArray(context, value)
+
+	case "untyped map":
+		return interpreter.typeUntypedMap(context, value)
+	}
+
+	return value, nil
+}
+
+// GetVar returns the innermost visible variable with the given name.
 func (interpreter *SimInterpreter) GetVar(context ParseContext, varName string) (Variable, error) {
-	variable, ok := interpreter.vars[varName]
+	owner, ok := interpreter.findScope(varName)
 	if !ok {
 		return Variable{}, UnknownVarErr{Context: context, VarName: varName}
 	}
 
-	return variable, nil
+	return owner.vars[varName], nil
 }
 
 // SetVarValue sets the value of a variable.
 // Setting a variable cannot change its underlying type.
 func (interpreter *SimInterpreter) SetVarValue(context ParseContext, varName string, value Value) error {
-	variable, ok := interpreter.vars[varName]
+	owner, ok := interpreter.findScope(varName)
 	if !ok {
 		return UnknownVarErr{Context: context, VarName: varName}
 	}
 
+	variable := owner.vars[varName]
+
+	if variable.constant {
+		return ConstAssignErr{Context: context, VarName: varName, DeclContext: variable.declContext}
+	}
+
 	// If the value is still an untyped int or float, switch them to the concrete types.
 	if value.typeName == "untyped int" {
 		value.typeName = "int"
@@ -284,6 +474,13 @@ func (interpreter *SimInterpreter) SetVarValue(context ParseContext, varName str
 		value.typeName = "float"
 	}
 
+	if value.typeName == "untyped array" || value.typeName == "untyped map" {
+		value, ok = interpreter.ImplicitlyCast(context, value, variable.value.typeName)
+		if !ok {
+			return MismatchedTypeAssignErr{Context: context, Var: variable}
+		}
+	}
+
 	_, ok = interpreter.types[value.typeName]
 	if !ok {
 		return InvalidTypeErr{Context: context, TypeName: value.typeName, VarName: varName}
@@ -309,22 +506,158 @@ func (interpreter *SimInterpreter) SetVarValue(context ParseContext, varName str
 		return MismatchedTypeAssignErr{Context: context, Var: variable}
 	}
 
-	interpreter.vars[varName] = NewVariable(variable.name, value)
+	owner.vars[varName] = NewVariable(variable.name, value)
 
 	return nil
 }
 
+// ImplicitlyCast returns the value as the given type if it can be used as that type without an explicit cast.
+// That is the case when the types already match, when the value is an untyped literal that fits in the type,
+// when the value is an array or map literal whose elements or entries can all be used in the type,
+// or when the value's type can be implicitly casted to the given type.
+func (interpreter *SimInterpreter) ImplicitlyCast(context ParseContext, value Value, typeName string) (Value, bool) {
+	valueTypeName, err := value.GetType()
+	if err != nil {
+		return value, false
+	}
+
+	if valueTypeName == typeName || typeName == AnyTypeName {
+		return value, true
+	}
+
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return value, false
+	}
+
+	if valueTypeName == "untyped int" || valueTypeName == "untyped float" {
+		context.TypeData = typeData
+
+		if GetTypeFromLiteral(context, value.data) == typeName {
+			// Floating point literals are rounded to the precision of the type they take on
+			if typeData.IsFloatingPoint() {
+				num, err := value.GetFloat(context)
+				if err == nil {
+					return newFloatValue(typeName, num, typeData.bitSize), true
+				}
+			}
+
+			return NewValue(typeName, value.data), true
+		}
+
+		return value, false
+	}
+
+	if valueTypeName == "untyped array" {
+		return interpreter.castUntypedArray(context, value, typeData)
+	}
+
+	if valueTypeName == "untyped map" {
+		return interpreter.castUntypedMap(context, value, typeData)
+	}
+
+	valueTypeData, ok := interpreter.types[valueTypeName]
+	if !ok || !valueTypeData.CanImplicitlyCast(typeData) {
+		return value, false
+	}
+
+	return NewValue(typeName, value.data), true
+}
+
 // PrintLine adds the given output to the end of the output stream followed by a newline.
 func (interpreter *SimInterpreter) PrintLine(output interface{}) {
 	fmt.Fprintln(interpreter.output, output)
 }
 
-// GetAllVars returns the map of all variables the interpreter currently knows about keyed by variable name.
+// FormatValue returns the text used to print a value. Struct values are formatted with their type name
+// and each of their fields, such as Point{x: 1, y: 2}, arrays and lists are formatted as a list of their elements,
+// such as [1, 2, 3], maps are formatted as their entries in insertion order, such as {"a": 1, "b": 2},
+// union values are formatted like structs with their variant in place of the type name, such as Circle{radius: 1.5},
+// or as just their variant if it has no fields, function values are formatted as the name of their function,
+// and strings keep their quotes.
+func (interpreter *SimInterpreter) FormatValue(context ParseContext, value Value) (string, error) {
+	typeName, err := value.GetType()
+	if err != nil {
+		return "", err
+	}
+
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if typeName != "untyped array" && typeName != "untyped map" && (err != nil || (!typeData.IsStruct() && !typeData.IsArray() && !typeData.IsList() && !typeData.IsMap() && !typeData.IsUnion())) {
+		return value.data, nil
+	}
+
+	// Union values are formatted like a struct of their variant's fields
+	if typeData.IsUnion() {
+		index, ok := typeData.VariantIndex(value.data)
+		if !ok {
+			return value.data, nil
+		}
+
+		if len(typeData.variants[index].fields) == 0 {
+			return value.data, nil
+		}
+
+		typeName = value.data
+		typeData.fields = typeData.variants[index].fields
+	}
+
+	if typeName == "untyped map" || typeData.IsMap() {
+		entries := make([]string, len(value.items)/2)
+		for i := range entries {
+			keyText, err := interpreter.FormatValue(context, value.items[2*i])
+			if err != nil {
+				return "", err
+			}
+
+			valueText, err := interpreter.FormatValue(context, value.items[2*i+1])
+			if err != nil {
+				return "", err
+			}
+
+			entries[i] = keyText + ": " + valueText
+		}
+
+		return "{" + strings.Join(entries, ", ") + "}", nil
+	}
+
+	if typeName == "untyped array" || typeData.IsArray() || typeData.IsList() {
+		elements := make([]string, len(value.items))
+		for i, element := range value.items {
+			elementText, err := interpreter.FormatValue(context, element)
+			if err != nil {
+				return "", err
+			}
+
+			elements[i] = elementText
+		}
+
+		return "[" + strings.Join(elements, ", ") + "]", nil
+	}
+
+	fields := make([]string, len(typeData.fields))
+	for i, field := range typeData.fields {
+		fieldText, err := interpreter.FormatValue(context, value.items[i])
+		if err != nil {
+			return "", err
+		}
+
+		fields[i] = field.name + ": " + fieldText
+	}
+
+	return typeName + "{" + strings.Join(fields, ", ") + "}", nil
+}
+
+// GetAllVars returns the map of all variables that are currently visible keyed by variable name.
+// Shadowed variables aren't visible, so only the innermost variable with each name is included.
 func (interpreter *SimInterpreter) GetAllVars() map[string]Variable {
 	varsCopy := make(map[string]Variable)
 
-	for k, v := range interpreter.vars {
-		varsCopy[k] = v
+	for s := interpreter.currScope; s != nil; s = s.parent {
+		for k, v := range s.vars {
+			if _, ok := varsCopy[k]; !ok {
+				varsCopy[k] = v
+			}
+		}
 	}
 
 	return varsCopy
@@ -338,20 +671,23 @@ func (interpreter *SimInterpreter) ResolveUnaryOperations(context ParseContext,
 		return NewErrorValue(err), err
 	}
 
-	typeData, err := interpreter.GetTypeData(context, typeName)
+	// Untyped literals are treated as the widest type of their kind, and stay untyped
+	typeData, err := interpreter.getConversionTypeData(context, typeName)
 	if err != nil {
 		return NewErrorValue(err), err
 	}
 
 	if typeData.IsSignedInteger() {
-		num, err := val.GetInt(context)
+		num, err := readSigned(context, val, typeName, typeData.bitSize)
 		if err != nil {
 			return NewErrorValue(err), err
 		}
 
 		switch operator {
 		case "-":
-			return NewValue(typeName, fmt.Sprintf("%d", -num)), nil
+			return newSignedValue(typeName, -num, typeData.bitSize), nil
+		case "~":
+			return newSignedValue(typeName, ^num, typeData.bitSize), nil
 		default:
 			err := UnknownOperatorErr{Context: context, Operator: operator}
 			return NewErrorValue(err), err
@@ -359,14 +695,17 @@ func (interpreter *SimInterpreter) ResolveUnaryOperations(context ParseContext,
 	}
 
 	if typeData.IsUnsignedInteger() {
-		num, err := val.GetUint(context)
+		num, err := readUnsigned(context, val, typeName, typeData.bitSize)
 		if err != nil {
 			return NewErrorValue(err), err
 		}
 
 		switch operator {
 		case "-":
-			return NewValue(typeName, fmt.Sprintf("%d", -num)), nil
+			return newUnsignedValue(typeName, -num, typeData.bitSize), nil
+		case "~":
+			// Only the bits that fit in the type are flipped
+			return newUnsignedValue(typeName, ^num, typeData.bitSize), nil
 		default:
 			err := UnknownOperatorErr{Context: context, Operator: operator}
 			return NewErrorValue(err), err
@@ -374,14 +713,14 @@ func (interpreter *SimInterpreter) ResolveUnaryOperations(context ParseContext,
 	}
 
 	if typeData.IsFloatingPoint() {
-		num, err := val.GetFloat(context)
+		num, err := readFloat(context, val, typeName, typeData.bitSize)
 		if err != nil {
 			return NewErrorValue(err), err
 		}
 
 		switch operator {
 		case "-":
-			return NewValue(typeName, fmt.Sprintf("%g", -num)), nil
+			return newFloatValue(typeName, -num, typeData.bitSize), nil
 		default:
 			err := UnknownOperatorErr{Context: context, Operator: operator}
 			return NewErrorValue(err), err
@@ -405,6 +744,11 @@ func (interpreter *SimInterpreter) ResolveBinaryOperations(leftContext ParseCont
 		return NewErrorValue(err), err
 	}
 
+	// The count of a shift can be any integer type, so the values of a shift don't need to have the same type
+	if operator == "<<" || operator == ">>" {
+		return interpreter.handleShiftOperations(leftContext, rightContext, leftVal, rightVal, leftTypeName, rightTypeName, operator)
+	}
+
 	if leftTypeName != rightTypeName {
 		return interpreter.handleMismatchedTypesBinaryOperations(leftContext, rightContext, leftVal, rightVal, leftTypeName, rightTypeName, operator)
 	}
@@ -417,6 +761,24 @@ func (interpreter *SimInterpreter) ResolveBinaryOperations(leftContext ParseCont
 		return interpreter.handleFloatingPointBinaryOperations(leftContext, rightContext, leftVal, rightVal, leftTypeName, operator)
 	}
 
+	if leftTypeName == "untyped array" {
+		typedLeftVal, err := interpreter.typeUntypedArray(leftContext, leftVal)
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		return interpreter.ResolveBinaryOperations(leftContext, rightContext, typedLeftVal, rightVal, operator)
+	}
+
+	if leftTypeName == "untyped map" {
+		typedLeftVal, err := interpreter.typeUntypedMap(leftContext, leftVal)
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		return interpreter.ResolveBinaryOperations(leftContext, rightContext, typedLeftVal, rightVal, operator)
+	}
+
 	leftTypeData, err := interpreter.GetTypeData(leftContext, leftTypeName)
 	if err != nil {
 		return NewErrorValue(err), err
@@ -442,42 +804,65 @@ func (interpreter *SimInterpreter) ResolveBinaryOperations(leftContext ParseCont
 		return interpreter.handleStringBinaryOperations(leftContext, rightContext, leftVal, rightVal, operator)
 	}
 
+	if leftTypeData.IsEnum() {
+		return interpreter.handleEnumBinaryOperations(leftContext, leftVal, rightVal, leftTypeName, operator)
+	}
+
+	if leftTypeData.IsUnion() {
+		return interpreter.handleUnionBinaryOperations(leftContext, rightContext, leftVal, rightVal, leftTypeName, operator)
+	}
+
+	if leftTypeData.IsStruct() || leftTypeData.IsArray() || leftTypeData.IsList() {
+		return interpreter.handleCompositeBinaryOperations(leftContext, rightContext, leftVal, rightVal, leftTypeName, operator)
+	}
+
 	err = InvalidOperationErr{Context: leftContext, TypeNames: []string{leftTypeName, rightTypeName}}
 	return NewErrorValue(err), err
 }
 
 func (interpreter *SimInterpreter) handleSignedIntegerBinaryOperations(leftContext, rightContext ParseContext, leftVal, rightVal Value, typeName string, operator string) (Value, error) {
-	left, err := leftVal.GetInt(leftContext)
+	typeData, err := interpreter.getConversionTypeData(leftContext, typeName)
 	if err != nil {
 		return NewErrorValue(err), err
 	}
 
-	right, err := rightVal.GetInt(rightContext)
+	left, err := readSigned(leftContext, leftVal, typeName, typeData.bitSize)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	right, err := readSigned(rightContext, rightVal, typeName, typeData.bitSize)
 	if err != nil {
 		return NewErrorValue(err), err
 	}
 
 	switch operator {
 	case "+":
-		return NewValue(typeName, fmt.Sprintf("%d", left+right)), nil
+		return newSignedValue(typeName, left+right, typeData.bitSize), nil
 	case "-":
-		return NewValue(typeName, fmt.Sprintf("%d", left-right)), nil
+		return newSignedValue(typeName, left-right, typeData.bitSize), nil
 	case "*":
-		return NewValue(typeName, fmt.Sprintf("%d", left*right)), nil
+		return newSignedValue(typeName, left*right, typeData.bitSize), nil
 	case "/":
 		if right == 0 {
 			err := DivideByZeroErr{Context: rightContext}
 			return NewErrorValue(err), err
 		}
 
-		return NewValue(typeName, fmt.Sprintf("%d", left/right)), nil
+		return newSignedValue(typeName, left/right, typeData.bitSize), nil
 	case "%":
 		if right == 0 {
 			err := DivideByZeroErr{Context: rightContext}
 			return NewErrorValue(err), err
 		}
 
-		return NewValue(typeName, fmt.Sprintf("%d", left%right)), nil
+		return newSignedValue(typeName, left%right, typeData.bitSize), nil
+	case "&":
+		return newSignedValue(typeName, left&right, typeData.bitSize), nil
+	case "|":
+		return newSignedValue(typeName, left|right, typeData.bitSize), nil
+	case "^":
+		return newSignedValue(typeName, left^right, typeData.bitSize), nil
 	case ">":
 		return NewValue("bool", fmt.Sprintf("%t", left > right)), nil
 	case "<":
@@ -497,37 +882,48 @@ func (interpreter *SimInterpreter) handleSignedIntegerBinaryOperations(leftConte
 }
 
 func (interpreter *SimInterpreter) handleUnsignedIntegerBinaryOperations(leftContext, rightContext ParseContext, leftVal, rightVal Value, typeName string, operator string) (Value, error) {
-	left, err := leftVal.GetUint(leftContext)
+	typeData, err := interpreter.getConversionTypeData(leftContext, typeName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	left, err := readUnsigned(leftContext, leftVal, typeName, typeData.bitSize)
 	if err != nil {
 		return NewErrorValue(err), err
 	}
 
-	right, err := rightVal.GetUint(rightContext)
+	right, err := readUnsigned(rightContext, rightVal, typeName, typeData.bitSize)
 	if err != nil {
 		return NewErrorValue(err), err
 	}
 
 	switch operator {
 	case "+":
-		return NewValue(typeName, fmt.Sprintf("%d", left+right)), nil
+		return newUnsignedValue(typeName, left+right, typeData.bitSize), nil
 	case "-":
-		return NewValue(typeName, fmt.Sprintf("%d", left-right)), nil
+		return newUnsignedValue(typeName, left-right, typeData.bitSize), nil
 	case "*":
-		return NewValue(typeName, fmt.Sprintf("%d", left*right)), nil
+		return newUnsignedValue(typeName, left*right, typeData.bitSize), nil
 	case "/":
 		if right == 0 {
 			err := DivideByZeroErr{Context: rightContext}
 			return NewErrorValue(err), err
 		}
 
-		return NewValue(typeName, fmt.Sprintf("%d", left/right)), nil
+		return newUnsignedValue(typeName, left/right, typeData.bitSize), nil
 	case "%":
 		if right == 0 {
 			err := DivideByZeroErr{Context: rightContext}
 			return NewErrorValue(err), err
 		}
 
-		return NewValue(typeName, fmt.Sprintf("%d", left%right)), nil
+		return newUnsignedValue(typeName, left%right, typeData.bitSize), nil
+	case "&":
+		return newUnsignedValue(typeName, left&right, typeData.bitSize), nil
+	case "|":
+		return newUnsignedValue(typeName, left|right, typeData.bitSize), nil
+	case "^":
+		return newUnsignedValue(typeName, left^right, typeData.bitSize), nil
 	case ">":
 		return NewValue("bool", fmt.Sprintf("%t", left > right)), nil
 	case "<":
@@ -547,30 +943,35 @@ func (interpreter *SimInterpreter) handleUnsignedIntegerBinaryOperations(leftCon
 }
 
 func (interpreter *SimInterpreter) handleFloatingPointBinaryOperations(leftContext, rightContext ParseContext, leftVal, rightVal Value, typeName string, operator string) (Value, error) {
-	left, err := leftVal.GetFloat(leftContext)
+	typeData, err := interpreter.getConversionTypeData(leftContext, typeName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	left, err := readFloat(leftContext, leftVal, typeName, typeData.bitSize)
 	if err != nil {
 		return NewErrorValue(err), err
 	}
 
-	right, err := rightVal.GetFloat(rightContext)
+	right, err := readFloat(rightContext, rightVal, typeName, typeData.bitSize)
 	if err != nil {
 		return NewErrorValue(err), err
 	}
 
 	switch operator {
 	case "+":
-		return NewValue(typeName, fmt.Sprintf("%g", left+right)), nil
+		return newFloatValue(typeName, left+right, typeData.bitSize), nil
 	case "-":
-		return NewValue(typeName, fmt.Sprintf("%g", left-right)), nil
+		return newFloatValue(typeName, left-right, typeData.bitSize), nil
 	case "*":
-		return NewValue(typeName, fmt.Sprintf("%g", left*right)), nil
+		return newFloatValue(typeName, left*right, typeData.bitSize), nil
 	case "/":
 		if right == 0 {
 			err := DivideByZeroErr{Context: rightContext}
 			return NewErrorValue(err), err
 		}
 
-		return NewValue(typeName, fmt.Sprintf("%g", left/right)), nil
+		return newFloatValue(typeName, left/right, typeData.bitSize), nil
 	case ">":
 		return NewValue("bool", fmt.Sprintf("%t", left > right)), nil
 	case "<":
@@ -589,6 +990,63 @@ func (interpreter *SimInterpreter) handleFloatingPointBinaryOperations(leftConte
 	}
 }
 
+// Helper function to read a signed integer operand, which must fit in the width of the type of the operation.
+func readSigned(context ParseContext, value Value, typeName string, bitSize int) (int64, error) {
+	num, err := value.GetInt(context)
+	if err != nil {
+		return 0, err
+	}
+
+	if !fitsSigned(num, bitSize) {
+		return 0, DataTypeErr{Context: context, TypeName: typeName}
+	}
+
+	return num, nil
+}
+
+// Helper function to read an unsigned integer operand, which must fit in the width of the type of the operation.
+func readUnsigned(context ParseContext, value Value, typeName string, bitSize int) (uint64, error) {
+	num, err := value.GetUint(context)
+	if err != nil {
+		return 0, err
+	}
+
+	if !fitsUnsigned(num, bitSize) {
+		return 0, DataTypeErr{Context: context, TypeName: typeName}
+	}
+
+	return num, nil
+}
+
+// Helper function to read a floating point operand as the nearest number the type of the operation can represent.
+func readFloat(context ParseContext, value Value, typeName string, bitSize int) (float64, error) {
+	num, err := value.GetFloat(context)
+	if err != nil {
+		return 0, err
+	}
+
+	if !fitsFloat(num, bitSize) {
+		return 0, DataTypeErr{Context: context, TypeName: typeName}
+	}
+
+	return roundFloat(num, bitSize), nil
+}
+
+// Helper function to create a signed integer value that wraps around at the width of its type.
+func newSignedValue(typeName string, num int64, bitSize int) Value {
+	return NewValue(typeName, strconv.FormatInt(wrapSigned(num, bitSize), 10))
+}
+
+// Helper function to create an unsigned integer value that wraps around at the width of its type.
+func newUnsignedValue(typeName string, num uint64, bitSize int) Value {
+	return NewValue(typeName, strconv.FormatUint(wrapUnsigned(num, bitSize), 10))
+}
+
+// Helper function to create a floating point value rounded to the precision of its type.
+func newFloatValue(typeName string, num float64, bitSize int) Value {
+	return NewValue(typeName, strconv.FormatFloat(roundFloat(num, bitSize), 'g', -1, bitSize))
+}
+
 func (interpreter *SimInterpreter) handleBooleanBinaryOperations(leftContext, rightContext ParseContext, leftVal, rightVal Value, typeName string, operator string) (Value, error) {
 	left, err := leftVal.GetBool(leftContext)
 	if err != nil {
@@ -623,6 +1081,8 @@ func (interpreter *SimInterpreter) handleStringBinaryOperations(leftContext, rig
 	}
 
 	switch operator {
+	case "+":
+		return NewValue("string", quoteString(left+right)), nil
 	case ">":
 		return NewValue("bool", fmt.Sprintf("%t", left > right)), nil
 	case "<":
@@ -641,10 +1101,134 @@ func (interpreter *SimInterpreter) handleStringBinaryOperations(leftContext, rig
 	}
 }
 
+// Struct, array and list values are equal when they hold the same number of fields or elements and all of them are equal.
+// Structs, arrays and lists can't be used with any other operator.
+func (interpreter *SimInterpreter) handleCompositeBinaryOperations(leftContext, rightContext ParseContext, leftVal, rightVal Value, typeName string, operator string) (Value, error) {
+	if operator != "==" && operator != "!=" {
+		err := InvalidOperationErr{Context: leftContext, TypeNames: []string{typeName, typeName}}
+		return NewErrorValue(err), err
+	}
+
+	equal := len(leftVal.items) == len(rightVal.items)
+
+	for i := 0; equal && i < len(leftVal.items); i++ {
+		result, err := interpreter.ResolveBinaryOperations(leftContext, rightContext, leftVal.items[i], rightVal.items[i], "==")
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		fieldEqual, err := result.GetBool(leftContext)
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		equal = fieldEqual
+	}
+
+	if operator == "!=" {
+		equal = !equal
+	}
+
+	return NewValue("bool", fmt.Sprintf("%t", equal)), nil
+}
+
+// Shifts the bits of an integer by a count of any integer type, giving a value of the integer's type.
+// Bits shifted past the width of the type are lost, so shifting by the width or more gives 0,
+// except for shifting a negative signed integer right, which gives -1. Shifting by a negative count is an error.
+func (interpreter *SimInterpreter) handleShiftOperations(leftContext, rightContext ParseContext, leftVal, rightVal Value, leftTypeName string, rightTypeName string, operator string) (Value, error) {
+	leftTypeData, leftErr := interpreter.getConversionTypeData(leftContext, leftTypeName)
+	rightTypeData, rightErr := interpreter.getConversionTypeData(rightContext, rightTypeName)
+
+	isInteger := func(typeData TypeData) bool {
+		return typeData.IsSignedInteger() || typeData.IsUnsignedInteger()
+	}
+
+	if leftErr != nil || rightErr != nil || !isInteger(leftTypeData) || !isInteger(rightTypeData) {
+		err := InvalidOperationErr{Context: leftContext, TypeNames: []string{leftTypeName, rightTypeName}}
+		return NewErrorValue(err), err
+	}
+
+	count, err := strconv.ParseInt(rightVal.data, 10, 64)
+	if rightTypeData.IsUnsignedInteger() {
+		var unsignedCount uint64
+		unsignedCount, err = strconv.ParseUint(rightVal.data, 10, 64)
+		count = int64(unsignedCount)
+
+		// Counts too large for a signed count shift every bit out anyway
+		if unsignedCount > math.MaxInt64 {
+			count = math.MaxInt64
+		}
+	}
+
+	if err != nil {
+		err := DataTypeErr{Context: rightContext, TypeName: rightTypeName}
+		return NewErrorValue(err), err
+	}
+
+	if count < 0 {
+		err := NegativeShiftErr{Context: rightContext, Count: rightVal.data}
+		return NewErrorValue(err), err
+	}
+
+	bitSize := int64(leftTypeData.bitSize)
+
+	if leftTypeData.IsSignedInteger() {
+		num, err := strconv.ParseInt(leftVal.data, 10, 64)
+		if err != nil {
+			err := DataTypeErr{Context: leftContext, TypeName: leftTypeName}
+			return NewErrorValue(err), err
+		}
+
+		switch {
+		case operator == ">>" && count >= bitSize:
+			num >>= 63
+		case operator == ">>":
+			num >>= uint(count)
+		case count >= bitSize:
+			num = 0
+		default:
+			num = wrapSigned(num<<uint(count), leftTypeData.bitSize)
+		}
+
+		return NewValue(leftTypeName, strconv.FormatInt(num, 10)), nil
+	}
+
+	num, err := strconv.ParseUint(leftVal.data, 10, 64)
+	if err != nil {
+		err := DataTypeErr{Context: leftContext, TypeName: leftTypeName}
+		return NewErrorValue(err), err
+	}
+
+	switch {
+	case count >= bitSize:
+		num = 0
+	case operator == ">>":
+		num >>= uint(count)
+	default:
+		num = wrapUnsigned(num<<uint(count), leftTypeData.bitSize)
+	}
+
+	return NewValue(leftTypeName, strconv.FormatUint(num, 10)), nil
+}
+
 func (interpreter *SimInterpreter) handleMismatchedTypesBinaryOperations(leftContext, rightContext ParseContext, leftVal, rightVal Value, leftTypeName string, rightTypeName string, operator string) (Value, error) {
+	// Array literals take on the type of the array they are used with
+	if leftTypeName == "untyped array" {
+		if typedLeftVal, ok := interpreter.ImplicitlyCast(leftContext, leftVal, rightTypeName); ok {
+			return interpreter.ResolveBinaryOperations(leftContext, rightContext, typedLeftVal, rightVal, operator)
+		}
+	}
+
+	if rightTypeName == "untyped array" {
+		if typedRightVal, ok := interpreter.ImplicitlyCast(rightContext, rightVal, leftTypeName); ok {
+			return interpreter.ResolveBinaryOperations(leftContext, rightContext, leftVal, typedRightVal, operator)
+		}
+	}
+
 	if leftTypeName == "untyped int" {
+		// Mixing untyped numbers gives an untyped float, which keeps 64 bits of precision until it takes on a type
 		if rightTypeName == "untyped float" {
-			rightTypeName = "float"
+			return interpreter.handleFloatingPointBinaryOperations(leftContext, rightContext, leftVal, rightVal, rightTypeName, operator)
 		}
 
 		rightTypeData, err := interpreter.GetTypeData(rightContext, rightTypeName)
@@ -669,8 +1253,9 @@ func (interpreter *SimInterpreter) handleMismatchedTypesBinaryOperations(leftCon
 	}
 
 	if rightTypeName == "untyped int" {
+		// Mixing untyped numbers gives an untyped float, which keeps 64 bits of precision until it takes on a type
 		if leftTypeName == "untyped float" {
-			leftTypeName = "float"
+			return interpreter.handleFloatingPointBinaryOperations(leftContext, rightContext, leftVal, rightVal, leftTypeName, operator)
 		}
 
 		leftTypeData, err := interpreter.GetTypeData(leftContext, leftTypeName)
@@ -723,10 +1308,87 @@ func (interpreter *SimInterpreter) handleMismatchedTypesBinaryOperations(leftCon
 }
 
 func (interpreter *SimInterpreter) validateValue(context ParseContext, value Value) bool {
-	return GetTypeFromLiteral(context, value.data) == value.typeName
+	// Function values are valid when they are nil or call a function
+	if context.TypeData.IsFunction() {
+		return value.typeName == context.TypeData.zeroValue.typeName && (value.function != nil || value.data == context.TypeData.zeroValue.data)
+	}
+
+	// Enum values are valid when they hold the name of one of the type's members
+	if context.TypeData.IsEnum() {
+		_, ok := context.TypeData.MemberIndex(value.data)
+		return ok && value.typeName == context.TypeData.zeroValue.typeName
+	}
+
+	if !context.TypeData.IsStruct() && !context.TypeData.IsArray() && !context.TypeData.IsList() && !context.TypeData.IsMap() && !context.TypeData.IsUnion() {
+		return GetTypeFromLiteral(context, value.data) == value.typeName
+	}
+
+	// Struct, array, list, map and union values are valid when each of their fields, elements, keys and values is valid for its type
+	itemTypeNames := context.TypeData.itemTypeNames(len(value.items))
+
+	// Union values must also hold one of the type's variants, whose fields give the types of their items
+	if context.TypeData.IsUnion() {
+		index, ok := context.TypeData.VariantIndex(value.data)
+		if !ok {
+			return false
+		}
+
+		itemTypeNames = nil
+		for _, field := range context.TypeData.variants[index].fields {
+			itemTypeNames = append(itemTypeNames, field.typeName)
+		}
+	}
+
+	if value.typeName != context.TypeData.zeroValue.typeName || len(value.items) != len(itemTypeNames) {
+		return false
+	}
+
+	for i, itemTypeName := range itemTypeNames {
+		itemTypeData, ok := interpreter.types[itemTypeName]
+		if !ok || value.items[i].typeName != itemTypeName {
+			return false
+		}
+
+		context.TypeData = itemTypeData
+		if !interpreter.validateValue(context, value.items[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Helper function to check that the return type and parameter types of a function are declared,
+// and that none of its parameters share a name.
+func (interpreter *SimInterpreter) checkSignature(context ParseContext, function Function) error {
+	if _, err := interpreter.GetTypeData(context, function.returnTypeName); err != nil {
+		return err
+	}
+
+	paramNames := make(map[string]struct{})
+
+	for _, param := range function.params {
+		if _, err := interpreter.GetTypeData(context, param.typeName); err != nil {
+			return err
+		}
+
+		if _, ok := paramNames[param.name]; ok {
+			return VarExistsErr{Context: context, VarName: param.name}
+		}
+
+		paramNames[param.name] = struct{}{}
+	}
+
+	return nil
 }
 
-// Helper function to return the current scope
-func (interpreter *SimInterpreter) currentScope() *scope {
-	return interpreter.scopes[len(interpreter.scopes)-1]
+// Helper function to find the innermost visible scope that declares the given variable name.
+func (interpreter *SimInterpreter) findScope(varName string) (*scope, bool) {
+	for s := interpreter.currScope; s != nil; s = s.parent {
+		if _, ok := s.vars[varName]; ok {
+			return s, true
+		}
+	}
+
+	return nil, false
 }
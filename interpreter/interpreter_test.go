@@ -3,6 +3,7 @@ package interpreter
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,14 +22,21 @@ func runTestInterpreterNoValue(t *testing.T, typeName string) {
 	a.value.data = expectedTypes[typeName].zeroValue.data
 
 	expectedVars := map[string]Variable{a.name: a}
-	expectedScopes := []*scope{{[]string{a.name}}}
+	expectedScopes := []map[string]Variable{{a.name: a}}
 
 	assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, expectedTypes, "")
 }
 
-func assertInterpreterValues(t *testing.T, interpreter *SimInterpreter, output string, expectedVars map[string]Variable, expectedScopes []*scope, expectedTypes map[string]TypeData, expectedOutput string) {
-	assert.Equal(t, expectedVars, interpreter.vars)
-	assert.Equal(t, expectedScopes, interpreter.scopes)
+// assertInterpreterValues checks the visible variables, the variables of each visible scope from the outermost to the current scope,
+// the declared types and the output of the interpreter.
+func assertInterpreterValues(t *testing.T, interpreter *SimInterpreter, output string, expectedVars map[string]Variable, expectedScopes []map[string]Variable, expectedTypes map[string]TypeData, expectedOutput string) {
+	var scopes []map[string]Variable
+	for s := interpreter.currScope; s != nil; s = s.parent {
+		scopes = append([]map[string]Variable{s.vars}, scopes...)
+	}
+
+	assert.Equal(t, expectedVars, interpreter.GetAllVars())
+	assert.Equal(t, expectedScopes, scopes)
 	assert.Equal(t, expectedTypes, interpreter.types)
 	assert.Equal(t, expectedOutput, output)
 }
@@ -36,7 +44,7 @@ func assertInterpreterValues(t *testing.T, interpreter *SimInterpreter, output s
 func TestNewSimInterpreter(t *testing.T) {
 	var buf bytes.Buffer
 	interpreter := NewSimInterpreter(&buf)
-	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 }
 
 func TestInterpreterGetTypeData(t *testing.T) {
@@ -49,7 +57,7 @@ func TestInterpreterGetTypeData(t *testing.T) {
 		assert.Empty(t, typeData)
 		assert.EqualError(t, err, UnknownTypeErr{TypeName: "unknown"}.Error())
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 
 	t.Run("success", func(t *testing.T) {
@@ -58,7 +66,206 @@ func TestInterpreterGetTypeData(t *testing.T) {
 		assert.Equal(t, NewTypeData("bool", "false", TypeInfoBool), typeData)
 		assert.NoError(t, err)
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
+	})
+}
+
+func TestInterpreterAddFunction(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	t.Run("unknown return type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddFunction(context, NewFunction("f", nil, "unknown", nil))
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "unknown"}.Error())
+		assert.NotContains(t, interpreter.functions, "f")
+	})
+
+	t.Run("unknown parameter type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddFunction(context, NewFunction("f", []Parameter{NewParameter("a", "unknown")}, "int", nil))
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "unknown"}.Error())
+		assert.NotContains(t, interpreter.functions, "f")
+	})
+
+	t.Run("duplicate parameter", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddFunction(context, NewFunction("f", []Parameter{NewParameter("a", "int"), NewParameter("a", "bool")}, "int", nil))
+		assert.EqualError(t, err, VarExistsErr{VarName: "a"}.Error())
+		assert.NotContains(t, interpreter.functions, "f")
+	})
+
+	t.Run("function exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+		f := NewFunction("f", nil, "int", nil)
+
+		err := interpreter.AddFunction(context, f)
+		assert.NoError(t, err)
+
+		err = interpreter.AddFunction(context, NewFunction("f", nil, "bool", nil))
+		assert.EqualError(t, err, FunctionExistsErr{FuncName: "f"}.Error())
+		assert.Equal(t, f, interpreter.functions["f"])
+	})
+
+	t.Run("builtin exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddFunction(context, NewFunction("len", []Parameter{NewParameter("s", "string")}, "int", nil))
+		assert.EqualError(t, err, FunctionExistsErr{FuncName: "len"}.Error())
+	})
+
+	t.Run("type exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddFunction(context, NewFunction("int", nil, "int", nil))
+		assert.EqualError(t, err, TypeExistsErr{TypeName: "int"}.Error())
+		assert.NotContains(t, interpreter.functions, "int")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+		f := NewFunction("f", []Parameter{NewParameter("a", "int"), NewParameter("b", "float")}, "bool", nil)
+
+		err := interpreter.AddFunction(context, f)
+		assert.NoError(t, err)
+		assert.Equal(t, f, interpreter.functions["f"])
+	})
+}
+
+func TestInterpreterGetFunction(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	t.Run("unknown function", func(t *testing.T) {
+		f, err := interpreter.GetFunction(context, "f")
+		assert.Empty(t, f)
+		assert.EqualError(t, err, UnknownFunctionErr{FuncName: "f"}.Error())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		expected := NewFunction("f", []Parameter{NewParameter("a", "int")}, "int", nil)
+
+		err := interpreter.AddFunction(context, expected)
+		assert.NoError(t, err)
+
+		f, err := interpreter.GetFunction(context, "f")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, f)
+	})
+}
+
+func TestInterpreterPushFrame(t *testing.T) {
+	var buf bytes.Buffer
+	interpreter := NewSimInterpreter(&buf)
+	context := NewParseContext(0, 0)
+
+	a := NewVariable("a", NewValue("int", "10"))
+	b := NewVariable("b", NewValue("int", "20"))
+
+	err := interpreter.AddVar(context, a)
+	assert.NoError(t, err)
+
+	// Variables declared in a nested scope of the caller are not visible to the callee
+	interpreter.PushScope()
+	err = interpreter.AddVar(context, b)
+	assert.NoError(t, err)
+
+	f := NewFunction("f", nil, "int", nil)
+	err = interpreter.PushFrame(context, f)
+	assert.NoError(t, err)
+
+	current, ok := interpreter.CurrentFunction()
+	assert.True(t, ok)
+	assert.Equal(t, f, current)
+
+	// The callee's scope is nested in the global scope rather than the caller's scope
+	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{a.name: a}, []map[string]Variable{{a.name: a}, {}}, getBasicTypes(), "")
+
+	v, err := interpreter.GetVar(context, a.name)
+	assert.NoError(t, err)
+	assert.Equal(t, a, v)
+
+	_, err = interpreter.GetVar(context, b.name)
+	assert.EqualError(t, err, UnknownVarErr{VarName: b.name}.Error())
+
+	// Globals can be assigned from within a call
+	err = interpreter.SetVarValue(context, a.name, NewValue("int", "30"))
+	assert.NoError(t, err)
+
+	// Locals of the callee may shadow globals
+	shadow := NewVariable("a", NewValue("bool", "true"))
+	err = interpreter.AddVar(context, shadow)
+	assert.NoError(t, err)
+
+	v, err = interpreter.GetVar(context, a.name)
+	assert.NoError(t, err)
+	assert.Equal(t, shadow, v)
+
+	global := NewVariable(a.name, NewValue("int", "30"))
+	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{a.name: shadow}, []map[string]Variable{{a.name: global}, {a.name: shadow}}, getBasicTypes(), "")
+
+	err = interpreter.PopFrame(context)
+	assert.NoError(t, err)
+
+	_, ok = interpreter.CurrentFunction()
+	assert.False(t, ok)
+
+	expectedVars := map[string]Variable{a.name: global, b.name: b}
+	expectedScopes := []map[string]Variable{{a.name: global}, {b.name: b}}
+
+	assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
+}
+
+func TestInterpreterCallDepth(t *testing.T) {
+	interpreter := NewSimInterpreter(nil)
+	context := NewParseContext(0, 0)
+
+	f := NewFunction("f", nil, "int", nil)
+	for i := 0; i < MaxCallDepth; i++ {
+		err := interpreter.PushFrame(context, f)
+		assert.NoError(t, err)
+	}
+
+	err := interpreter.PushFrame(context, f)
+	assert.EqualError(t, err, CallDepthErr{FuncName: "f", Depth: MaxCallDepth}.Error())
+
+	// Returning from a call makes room for another
+	err = interpreter.PopFrame(context)
+	assert.NoError(t, err)
+
+	err = interpreter.PushFrame(context, f)
+	assert.NoError(t, err)
+}
+
+func TestInterpreterPopFrame(t *testing.T) {
+	var buf bytes.Buffer
+	interpreter := NewSimInterpreter(&buf)
+	context := NewParseContext(0, 0)
+
+	t.Run("pop global frame", func(t *testing.T) {
+		err := interpreter.PopFrame(context)
+		assert.EqualError(t, err, ExitGlobalFrameErr{}.Error())
+
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		err := interpreter.PushFrame(context, NewFunction("f", nil, "int", nil))
+		assert.NoError(t, err)
+
+		err = interpreter.AddVar(context, NewVariable("a", NewValue("int", "10")))
+		assert.NoError(t, err)
+
+		// The outermost scope of a call can't be popped, since the caller's scope isn't part of the call
+		err = interpreter.PopScope(context)
+		assert.EqualError(t, err, ExitGlobalScopeErr{}.Error())
+
+		err = interpreter.PopFrame(context)
+		assert.NoError(t, err)
+
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 }
 
@@ -68,7 +275,7 @@ func TestInterpreterPushScope(t *testing.T) {
 
 	interpreter.PushScope()
 
-	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}, {}}, getBasicTypes(), "")
+	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}, {}}, getBasicTypes(), "")
 }
 
 func TestInterpreterPopScope(t *testing.T) {
@@ -80,7 +287,7 @@ func TestInterpreterPopScope(t *testing.T) {
 		err := interpreter.PopScope(context)
 		assert.EqualError(t, err, ExitGlobalScopeErr{}.Error())
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 
 	t.Run("success", func(t *testing.T) {
@@ -102,12 +309,48 @@ func TestInterpreterPopScope(t *testing.T) {
 		assert.NoError(t, err)
 
 		expectedVars := map[string]Variable{a.name: a}
-		expectedScopes := []*scope{{}, {[]string{a.name}}}
+		expectedScopes := []map[string]Variable{{}, {a.name: a}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
 	})
 }
 
+func TestInterpreterShadowing(t *testing.T) {
+	var buf bytes.Buffer
+	interpreter := NewSimInterpreter(&buf)
+	context := NewParseContext(0, 0)
+
+	outer := NewVariable("a", NewValue("int", "10"))
+	inner := NewVariable("a", NewValue("string", `"inner"`))
+
+	err := interpreter.AddVar(context, outer)
+	assert.NoError(t, err)
+
+	interpreter.PushScope()
+	err = interpreter.AddVar(context, inner)
+	assert.NoError(t, err)
+
+	v, err := interpreter.GetVar(context, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, inner, v)
+
+	// Assigning to a shadowed name changes the innermost variable
+	err = interpreter.SetVarValue(context, "a", NewValue("string", `"changed"`))
+	assert.NoError(t, err)
+
+	changed := NewVariable("a", NewValue("string", `"changed"`))
+	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{"a": changed}, []map[string]Variable{{"a": outer}, {"a": changed}}, getBasicTypes(), "")
+
+	err = interpreter.PopScope(context)
+	assert.NoError(t, err)
+
+	v, err = interpreter.GetVar(context, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, outer, v)
+
+	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{"a": outer}, []map[string]Variable{{"a": outer}}, getBasicTypes(), "")
+}
+
 func TestInterpreterAddVar(t *testing.T) {
 	context := NewParseContext(0, 0)
 
@@ -118,7 +361,7 @@ func TestInterpreterAddVar(t *testing.T) {
 		err := interpreter.AddVar(context, NewVariable("a", NewErrorValue(errors.New("test error"))))
 		assert.EqualError(t, err, "test error")
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 
 	t.Run("untyped int", func(t *testing.T) {
@@ -131,7 +374,7 @@ func TestInterpreterAddVar(t *testing.T) {
 		expectedVariable := NewVariable("a", NewValue("int", "10"))
 
 		expectedVars := map[string]Variable{expectedVariable.name: expectedVariable}
-		expectedScopes := []*scope{{[]string{"a"}}}
+		expectedScopes := []map[string]Variable{{"a": expectedVars["a"]}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
 	})
@@ -146,7 +389,7 @@ func TestInterpreterAddVar(t *testing.T) {
 		expectedVariable := NewVariable("a", NewValue("float", "10"))
 
 		expectedVars := map[string]Variable{expectedVariable.name: expectedVariable}
-		expectedScopes := []*scope{{[]string{"a"}}}
+		expectedScopes := []map[string]Variable{{"a": expectedVars["a"]}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
 	})
@@ -158,7 +401,7 @@ func TestInterpreterAddVar(t *testing.T) {
 		err := interpreter.AddVar(context, NewVariable("a", NewValue("unknown-type", "10")))
 		assert.EqualError(t, err, UnknownTypeErr{TypeName: "unknown-type"}.Error())
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 
 	t.Run("var exists", func(t *testing.T) {
@@ -174,7 +417,7 @@ func TestInterpreterAddVar(t *testing.T) {
 		assert.EqualError(t, err, VarExistsErr{VarName: a.name}.Error())
 
 		expectedVars := map[string]Variable{a.name: a}
-		expectedScopes := []*scope{{[]string{a.name}}}
+		expectedScopes := []map[string]Variable{{a.name: expectedVars[a.name]}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
 	})
@@ -188,7 +431,7 @@ func TestInterpreterAddVar(t *testing.T) {
 		err := interpreter.AddVar(context, a)
 		assert.EqualError(t, err, MismatchedTypeAssignErr{Var: a}.Error())
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 
 	t.Run("success", func(t *testing.T) {
@@ -201,7 +444,7 @@ func TestInterpreterAddVar(t *testing.T) {
 		assert.NoError(t, err)
 
 		expectedVars := map[string]Variable{a.name: a}
-		expectedScopes := []*scope{{[]string{"a"}}}
+		expectedScopes := []map[string]Variable{{"a": expectedVars["a"]}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
 	})
@@ -230,6 +473,36 @@ func TestInterpreterAddVar(t *testing.T) {
 	})
 }
 
+func TestInterpreterInferValue(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	tests := []struct {
+		value    Value
+		expected Value
+		err      error
+	}{
+		{value: NewValue("untyped int", "1"), expected: NewValue("int", "1")},
+		{value: NewValue("untyped float", "1.5"), expected: NewValue("float", "1.5")},
+		{value: NewValue("uint", "1"), expected: NewValue("uint", "1")},
+		{value: NewArrayValue("untyped array", []Value{NewValue("untyped int", "1")}), expected: NewArrayValue("int[1]", []Value{NewValue("int", "1")})},
+		{value: NewArrayValue("untyped array", []Value{}), err: UninferableTypeErr{VarName: "a", Literal: "array"}},
+		{value: NewMapValue("untyped map", []Value{}), err: UninferableTypeErr{VarName: "a", Literal: "map"}},
+		{value: NewErrorValue(UnknownVarErr{VarName: "b"}), err: UnknownVarErr{VarName: "b"}},
+	}
+
+	for _, test := range tests {
+		value, err := interpreter.InferValue(context, "a", test.value)
+		if test.err != nil {
+			assert.EqualError(t, err, test.err.Error())
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, value)
+	}
+}
+
 func TestInterpreterGetVar(t *testing.T) {
 	context := NewParseContext(0, 0)
 
@@ -241,7 +514,7 @@ func TestInterpreterGetVar(t *testing.T) {
 		assert.Empty(t, v)
 		assert.EqualError(t, err, UnknownVarErr{VarName: "a"}.Error())
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 
 	t.Run("success", func(t *testing.T) {
@@ -258,7 +531,7 @@ func TestInterpreterGetVar(t *testing.T) {
 		assert.Equal(t, a, v)
 
 		expectedVars := map[string]Variable{a.name: a}
-		expectedScope := []*scope{{[]string{"a"}}}
+		expectedScope := []map[string]Variable{{"a": expectedVars["a"]}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScope, getBasicTypes(), "")
 	})
@@ -274,7 +547,7 @@ func TestInterpreterSetVarValue(t *testing.T) {
 		err := interpreter.SetVarValue(context, "a", NewValue("int", "10"))
 		assert.EqualError(t, err, UnknownVarErr{VarName: "a"}.Error())
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 
 	t.Run("unknown type", func(t *testing.T) {
@@ -293,7 +566,7 @@ func TestInterpreterSetVarValue(t *testing.T) {
 		assert.EqualError(t, err, InvalidTypeErr{TypeName: a.value.typeName, VarName: a.name}.Error())
 
 		expectedVars := map[string]Variable{a.name: a}
-		expectedScopes := []*scope{{[]string{"a"}}}
+		expectedScopes := []map[string]Variable{{"a": expectedVars["a"]}}
 
 		expectedTypes := getBasicTypes()
 		delete(expectedTypes, a.value.typeName)
@@ -301,6 +574,25 @@ func TestInterpreterSetVarValue(t *testing.T) {
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, expectedTypes, "")
 	})
 
+	t.Run("constant", func(t *testing.T) {
+		var buf bytes.Buffer
+		interpreter := NewSimInterpreter(&buf)
+
+		declContext := NewParseContext(1, 0)
+		a := NewConstant("a", NewValue("int", "10"), declContext)
+
+		err := interpreter.AddVar(context, a)
+		assert.NoError(t, err)
+
+		err = interpreter.SetVarValue(NewParseContext(2, 0), a.name, NewValue("int", "20"))
+		assert.EqualError(t, err, ConstAssignErr{Context: NewParseContext(2, 0), VarName: a.name, DeclContext: declContext}.Error())
+
+		expectedVars := map[string]Variable{a.name: a}
+		expectedScopes := []map[string]Variable{{"a": expectedVars["a"]}}
+
+		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
+	})
+
 	t.Run("variable and value have mismatched types", func(t *testing.T) {
 		var buf bytes.Buffer
 		interpreter := NewSimInterpreter(&buf)
@@ -314,7 +606,7 @@ func TestInterpreterSetVarValue(t *testing.T) {
 		assert.EqualError(t, err, MismatchedTypeAssignErr{Var: a}.Error())
 
 		expectedVars := map[string]Variable{a.name: a}
-		expectedScopes := []*scope{{[]string{"a"}}}
+		expectedScopes := []map[string]Variable{{"a": expectedVars["a"]}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
 	})
@@ -332,7 +624,7 @@ func TestInterpreterSetVarValue(t *testing.T) {
 		assert.EqualError(t, err, MismatchedTypeAssignErr{Var: a}.Error())
 
 		expectedVars := map[string]Variable{a.name: a}
-		expectedScopes := []*scope{{[]string{"a"}}}
+		expectedScopes := []map[string]Variable{{"a": expectedVars["a"]}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
 	})
@@ -352,19 +644,51 @@ func TestInterpreterSetVarValue(t *testing.T) {
 		assert.NoError(t, err)
 
 		expectedVars := map[string]Variable{a.name: NewVariable(a.name, newAVal)}
-		expectedScopes := []*scope{{[]string{"a"}}}
+		expectedScopes := []map[string]Variable{{"a": expectedVars["a"]}}
 
 		assertInterpreterValues(t, interpreter, buf.String(), expectedVars, expectedScopes, getBasicTypes(), "")
 	})
 }
 
+func TestInterpreterImplicitlyCast(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	testCases := []struct {
+		name     string
+		value    Value
+		typeName string
+		expected Value
+		ok       bool
+	}{
+		{"error", NewErrorValue(errors.New("test error")), "int", NewErrorValue(errors.New("test error")), false},
+		{"same type", NewValue("int", "10"), "int", NewValue("int", "10"), true},
+		{"untyped int", NewValue("untyped int", "10"), "uint8", NewValue("uint8", "10"), true},
+		{"untyped int to float", NewValue("untyped int", "10"), "float", NewValue("float", "10"), true},
+		{"untyped float", NewValue("untyped float", "1.5"), "float64", NewValue("float64", "1.5"), true},
+		{"untyped float to int", NewValue("untyped float", "1.5"), "int", NewValue("untyped float", "1.5"), false},
+		{"implicit cast", NewValue("int8", "10"), "int", NewValue("int", "10"), true},
+		{"no implicit cast", NewValue("int", "10"), "int8", NewValue("int", "10"), false},
+		{"mismatched types", NewValue("bool", "true"), "int", NewValue("bool", "true"), false},
+		{"unknown type", NewValue("int", "10"), "unknown", NewValue("int", "10"), false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			value, ok := interpreter.ImplicitlyCast(context, testCase.value, testCase.typeName)
+			assert.Equal(t, testCase.expected, value)
+			assert.Equal(t, testCase.ok, ok)
+		})
+	}
+}
+
 func TestInterpreterPrintLine(t *testing.T) {
 	var buf bytes.Buffer
 	interpreter := NewSimInterpreter(&buf)
 
 	interpreter.PrintLine("test")
 
-	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "test\n")
+	assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "test\n")
 }
 
 func TestInterpreterGetAllVars(t *testing.T) {
@@ -383,7 +707,7 @@ func TestInterpreterGetAllVars(t *testing.T) {
 	vars := interpreter.GetAllVars()
 
 	expectedVars := map[string]Variable{a.name: a, b.name: b}
-	expectedScopes := []*scope{{[]string{"a", "b"}}}
+	expectedScopes := []map[string]Variable{{a.name: a, b.name: b}}
 
 	assert.Equal(t, expectedVars, vars)
 
@@ -439,7 +763,7 @@ func TestInterpreterResolveUnaryOperations(t *testing.T) {
 			}
 		}
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
 	})
 }
 
@@ -477,11 +801,11 @@ func TestInterpreterResolveBinaryOperations(t *testing.T) {
 			assert.NoError(t, err)
 
 			value, err = interpreter.ResolveBinaryOperations(context, context, NewValue("untyped int", "1"), NewValue("untyped float", "2"), "+")
-			assert.Equal(t, NewValue("float", "3"), value)
+			assert.Equal(t, NewValue("untyped float", "3"), value)
 			assert.NoError(t, err)
 
 			value, err = interpreter.ResolveBinaryOperations(context, context, NewValue("untyped float", "1"), NewValue("untyped int", "2"), "+")
-			assert.Equal(t, NewValue("float", "3"), value)
+			assert.Equal(t, NewValue("untyped float", "3"), value)
 			assert.NoError(t, err)
 		})
 
@@ -598,6 +922,248 @@ func TestInterpreterResolveBinaryOperations(t *testing.T) {
 			}
 		}
 
-		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []*scope{{}}, getBasicTypes(), "")
+		assertInterpreterValues(t, interpreter, buf.String(), map[string]Variable{}, []map[string]Variable{{}}, getBasicTypes(), "")
+	})
+}
+
+func TestInterpreterResolveBitwiseOperations(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	t.Run("binary", func(t *testing.T) {
+		tests := []struct {
+			left     Value
+			right    Value
+			operator string
+			expected Value
+			err      error
+		}{
+			{left: NewValue("int", "12"), right: NewValue("int", "10"), operator: "&", expected: NewValue("int", "8")},
+			{left: NewValue("int", "12"), right: NewValue("int", "10"), operator: "|", expected: NewValue("int", "14")},
+			{left: NewValue("int", "12"), right: NewValue("int", "10"), operator: "^", expected: NewValue("int", "6")},
+			{left: NewValue("int8", "-1"), right: NewValue("untyped int", "15"), operator: "&", expected: NewValue("int8", "15")},
+			{left: NewValue("byte", "240"), right: NewValue("byte", "15"), operator: "|", expected: NewValue("byte", "255")},
+			{left: NewValue("untyped int", "5"), right: NewValue("untyped int", "3"), operator: "^", expected: NewValue("untyped int", "6")},
+			{left: NewValue("int", "1"), right: NewValue("uint", "1"), operator: "&", err: InvalidOperationErr{TypeNames: []string{"int", "uint"}}},
+			{left: NewValue("float", "1"), right: NewValue("float", "1"), operator: "|", err: UnknownOperatorErr{Operator: "|"}},
+
+			// Shifts keep the bits that fit in the left value's type
+			{left: NewValue("int8", "1"), right: NewValue("untyped int", "7"), operator: "<<", expected: NewValue("int8", "-128")},
+			{left: NewValue("int8", "3"), right: NewValue("untyped int", "7"), operator: "<<", expected: NewValue("int8", "-128")},
+			{left: NewValue("int8", "1"), right: NewValue("untyped int", "8"), operator: "<<", expected: NewValue("int8", "0")},
+			{left: NewValue("int8", "-128"), right: NewValue("untyped int", "7"), operator: ">>", expected: NewValue("int8", "-1")},
+			{left: NewValue("int8", "-1"), right: NewValue("untyped int", "100"), operator: ">>", expected: NewValue("int8", "-1")},
+			{left: NewValue("int8", "64"), right: NewValue("untyped int", "8"), operator: ">>", expected: NewValue("int8", "0")},
+			{left: NewValue("int", "1"), right: NewValue("untyped int", "31"), operator: "<<", expected: NewValue("int", "-2147483648")},
+			{left: NewValue("uint", "1"), right: NewValue("untyped int", "31"), operator: "<<", expected: NewValue("uint", "2147483648")},
+			{left: NewValue("uint8", "255"), right: NewValue("untyped int", "1"), operator: "<<", expected: NewValue("uint8", "254")},
+			{left: NewValue("uint8", "255"), right: NewValue("untyped int", "8"), operator: ">>", expected: NewValue("uint8", "0")},
+			{left: NewValue("uint16", "1"), right: NewValue("uint64", "18446744073709551615"), operator: "<<", expected: NewValue("uint16", "0")},
+			{left: NewValue("untyped int", "1"), right: NewValue("untyped int", "40"), operator: "<<", expected: NewValue("untyped int", "1099511627776")},
+
+			// The count can be any integer type
+			{left: NewValue("int", "1"), right: NewValue("uint", "3"), operator: "<<", expected: NewValue("int", "8")},
+			{left: NewValue("uint", "8"), right: NewValue("int8", "2"), operator: ">>", expected: NewValue("uint", "2")},
+
+			{left: NewValue("int", "1"), right: NewValue("untyped int", "-1"), operator: "<<", err: NegativeShiftErr{Count: "-1"}},
+			{left: NewValue("float", "1"), right: NewValue("untyped int", "1"), operator: "<<", err: InvalidOperationErr{TypeNames: []string{"float", "untyped int"}}},
+			{left: NewValue("int", "1"), right: NewValue("bool", "true"), operator: ">>", err: InvalidOperationErr{TypeNames: []string{"int", "bool"}}},
+		}
+
+		for _, test := range tests {
+			value, err := interpreter.ResolveBinaryOperations(context, context, test.left, test.right, test.operator)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Equal(t, NewErrorValue(err), value)
+				continue
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		}
+	})
+
+	t.Run("not", func(t *testing.T) {
+		tests := []struct {
+			value    Value
+			expected Value
+			err      error
+		}{
+			{value: NewValue("int", "5"), expected: NewValue("int", "-6")},
+			{value: NewValue("int8", "0"), expected: NewValue("int8", "-1")},
+			{value: NewValue("untyped int", "0"), expected: NewValue("untyped int", "-1")},
+			{value: NewValue("byte", "5"), expected: NewValue("byte", "250")},
+			{value: NewValue("uint16", "0"), expected: NewValue("uint16", "65535")},
+			{value: NewValue("uint", "0"), expected: NewValue("uint", "4294967295")},
+			{value: NewValue("float", "1"), err: UnknownOperatorErr{Operator: "~"}},
+			{value: NewValue("bool", "true"), err: InvalidOperationErr{TypeNames: []string{"bool"}}},
+		}
+
+		for _, test := range tests {
+			value, err := interpreter.ResolveUnaryOperations(context, test.value, "~")
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				continue
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		}
+	})
+}
+
+func TestInterpreterNumericWidths(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	t.Run("arithmetic", func(t *testing.T) {
+		tests := []struct {
+			left     Value
+			right    Value
+			operator string
+			expected Value
+			err      error
+		}{
+			// Signed integers wrap around at their width
+			{left: NewValue("int8", "127"), right: NewValue("int8", "1"), operator: "+", expected: NewValue("int8", "-128")},
+			{left: NewValue("int8", "-128"), right: NewValue("int8", "-1"), operator: "/", expected: NewValue("int8", "-128")},
+			{left: NewValue("int8", "16"), right: NewValue("untyped int", "16"), operator: "*", expected: NewValue("int8", "0")},
+			{left: NewValue("int16", "32767"), right: NewValue("int16", "1"), operator: "+", expected: NewValue("int16", "-32768")},
+			{left: NewValue("int16", "-32768"), right: NewValue("int16", "1"), operator: "-", expected: NewValue("int16", "32767")},
+			{left: NewValue("int", "2147483647"), right: NewValue("int", "1"), operator: "+", expected: NewValue("int", "-2147483648")},
+			{left: NewValue("int32", "65536"), right: NewValue("int32", "65536"), operator: "*", expected: NewValue("int32", "0")},
+			{left: NewValue("int64", "4294967296"), right: NewValue("int64", "4294967296"), operator: "+", expected: NewValue("int64", "8589934592")},
+			{left: NewValue("int64", "9223372036854775807"), right: NewValue("int64", "1"), operator: "+", expected: NewValue("int64", "-9223372036854775808")},
+			{left: NewValue("int64", "3037000500"), right: NewValue("int64", "3037000500"), operator: ">", expected: NewValue("bool", "false")},
+
+			// Unsigned integers wrap around at their width
+			{left: NewValue("byte", "255"), right: NewValue("byte", "1"), operator: "+", expected: NewValue("byte", "0")},
+			{left: NewValue("uint8", "0"), right: NewValue("uint8", "1"), operator: "-", expected: NewValue("uint8", "255")},
+			{left: NewValue("uint16", "65535"), right: NewValue("uint16", "2"), operator: "+", expected: NewValue("uint16", "1")},
+			{left: NewValue("uint", "0"), right: NewValue("uint", "1"), operator: "-", expected: NewValue("uint", "4294967295")},
+			{left: NewValue("uint32", "65536"), right: NewValue("uint32", "65536"), operator: "*", expected: NewValue("uint32", "0")},
+			{left: NewValue("uint64", "4294967295"), right: NewValue("uint64", "1"), operator: "+", expected: NewValue("uint64", "4294967296")},
+			{left: NewValue("uint64", "18446744073709551615"), right: NewValue("uint64", "1"), operator: "+", expected: NewValue("uint64", "0")},
+			{left: NewValue("uint64", "18446744073709551615"), right: NewValue("uint64", "9223372036854775808"), operator: ">", expected: NewValue("bool", "true")},
+
+			// Floating point numbers are rounded to the precision of their type
+			{left: NewValue("float", "0.1"), right: NewValue("float", "0.2"), operator: "+", expected: NewValue("float", "0.3")},
+			{left: NewValue("float32", "16777216"), right: NewValue("float32", "1"), operator: "+", expected: NewValue("float32", "1.6777216e+07")},
+			{left: NewValue("float64", "0.1"), right: NewValue("float64", "0.2"), operator: "+", expected: NewValue("float64", "0.30000000000000004")},
+			{left: NewValue("float64", "16777216"), right: NewValue("float64", "1"), operator: "+", expected: NewValue("float64", "1.6777217e+07")},
+			{left: NewValue("float64", "1e300"), right: NewValue("untyped float", "10.0"), operator: "*", expected: NewValue("float64", "1e+301")},
+			{left: NewValue("float", "1"), right: NewValue("float", "3"), operator: "/", expected: NewValue("float", "0.33333334")},
+			{left: NewValue("float64", "1"), right: NewValue("float64", "3"), operator: "/", expected: NewValue("float64", "0.3333333333333333")},
+			{left: NewValue("untyped int", "1"), right: NewValue("untyped float", "0.123456789012"), operator: "+", expected: NewValue("untyped float", "1.123456789012")},
+
+			// Untyped literals must fit in the type they are used with
+			{left: NewValue("int8", "1"), right: NewValue("untyped int", "128"), operator: "+", err: DataTypeErr{TypeName: "int8"}},
+			{left: NewValue("untyped int", "256"), right: NewValue("byte", "1"), operator: "+", err: DataTypeErr{TypeName: "byte"}},
+			{left: NewValue("float", "1"), right: NewValue("untyped float", "1e39"), operator: "+", err: DataTypeErr{TypeName: "float"}},
+		}
+
+		for _, test := range tests {
+			value, err := interpreter.ResolveBinaryOperations(context, context, test.left, test.right, test.operator)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Equal(t, NewErrorValue(err), value)
+				continue
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value, "%v %s %v", test.left, test.operator, test.right)
+		}
+	})
+
+	t.Run("negate", func(t *testing.T) {
+		tests := []struct {
+			value    Value
+			expected Value
+		}{
+			{value: NewValue("int8", "-128"), expected: NewValue("int8", "-128")},
+			{value: NewValue("int16", "-32768"), expected: NewValue("int16", "-32768")},
+			{value: NewValue("int64", "-9223372036854775807"), expected: NewValue("int64", "9223372036854775807")},
+			{value: NewValue("byte", "1"), expected: NewValue("byte", "255")},
+			{value: NewValue("uint64", "1"), expected: NewValue("uint64", "18446744073709551615")},
+			{value: NewValue("float64", "1e300"), expected: NewValue("float64", "-1e+300")},
+			{value: NewValue("untyped int", "3000000000"), expected: NewValue("untyped int", "-3000000000")},
+		}
+
+		for _, test := range tests {
+			value, err := interpreter.ResolveUnaryOperations(context, test.value, "-")
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		}
+	})
+
+	t.Run("conversion", func(t *testing.T) {
+		tests := []struct {
+			value    Value
+			typeName string
+			expected Value
+			err      error
+		}{
+			// Untyped integer literals convert to any type they fit in, including past the range of int64
+			{value: NewValue("untyped int", "-128"), typeName: "int8", expected: NewValue("int8", "-128")},
+			{value: NewValue("untyped int", "32768"), typeName: "int16", err: ConversionErr{Data: "32768", TypeName: "int16"}},
+			{value: NewValue("untyped int", "9223372036854775807"), typeName: "int64", expected: NewValue("int64", "9223372036854775807")},
+			{value: NewValue("untyped int", "9223372036854775808"), typeName: "int64", err: ConversionErr{Data: "9223372036854775808", TypeName: "int64"}},
+			{value: NewValue("untyped int", "255"), typeName: "byte", expected: NewValue("byte", "255")},
+			{value: NewValue("untyped int", "4294967296"), typeName: "uint32", err: ConversionErr{Data: "4294967296", TypeName: "uint32"}},
+			{value: NewValue("untyped int", "9223372036854775808"), typeName: "uint64", expected: NewValue("uint64", "9223372036854775808")},
+			{value: NewValue("untyped int", "18446744073709551615"), typeName: "uint64", expected: NewValue("uint64", "18446744073709551615")},
+			{value: NewValue("untyped int", "18446744073709551615"), typeName: "float64", expected: NewValue("float64", "1.8446744073709552e+19")},
+
+			// Typed integers wrap around to the width of the type they are converted to
+			{value: NewValue("int64", "-1"), typeName: "uint64", expected: NewValue("uint64", "18446744073709551615")},
+			{value: NewValue("uint64", "18446744073709551615"), typeName: "int64", expected: NewValue("int64", "-1")},
+		}
+
+		for _, test := range tests {
+			value, err := interpreter.ConvertValue(context, test.value, test.typeName)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error(), "%v to %s", test.value, test.typeName)
+				continue
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value, "%v to %s", test.value, test.typeName)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			value Value
+			valid bool
+		}{
+			{value: NewValue("int8", "127"), valid: true},
+			{value: NewValue("int8", "128")},
+			{value: NewValue("int16", "-32769")},
+			{value: NewValue("int", "2147483648")},
+			{value: NewValue("int32", "-2147483648"), valid: true},
+			{value: NewValue("int64", "9223372036854775807"), valid: true},
+			{value: NewValue("byte", "255"), valid: true},
+			{value: NewValue("byte", "256")},
+			{value: NewValue("uint8", "-1")},
+			{value: NewValue("uint16", "65536")},
+			{value: NewValue("uint", "4294967296")},
+			{value: NewValue("uint32", "4294967295"), valid: true},
+			{value: NewValue("uint64", "18446744073709551615"), valid: true},
+			{value: NewValue("float", "3.4e38"), valid: true},
+			{value: NewValue("float32", "3.5e38")},
+			{value: NewValue("float64", "1.7e308"), valid: true},
+		}
+
+		interpreter.PushScope()
+		defer interpreter.PopScope(context)
+
+		for i, test := range tests {
+			err := interpreter.AddVar(context, NewVariable(fmt.Sprintf("v%d", i), test.value))
+			if test.valid {
+				assert.NoError(t, err, test.value)
+			} else {
+				assert.Error(t, err, test.value)
+			}
+		}
 	})
 }
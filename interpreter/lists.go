@@ -0,0 +1,182 @@
+package interpreter
+
+import "strings"
+
+// Lists have the same value semantics as every other Sim value. Assigning a list, passing it to a function
+// or slicing it makes a copy of its elements, so changing one list never changes another.
+// The list built-ins return a new list rather than changing the list they are given, such as xs = append(xs, 1).
+
+// ListTypeName returns the name of the list type with the given element type.
+// Lists of arrays put the list's brackets first, so a list of int[4]s is an int[][4].
+func ListTypeName(elementTypeName string) string {
+	if i := strings.Index(elementTypeName, "["); i >= 0 {
+		return elementTypeName[:i] + "[]" + elementTypeName[i:]
+	}
+
+	return elementTypeName + "[]"
+}
+
+// SliceValue returns a new list holding the elements of an array or list from the low index up to, but not including, the high index.
+// The low index must not be greater than the high index, and neither can be outside of the value's bounds.
+func (interpreter *SimInterpreter) SliceValue(lowContext ParseContext, highContext ParseContext, value Value, low int64, high int64) (Value, error) {
+	typeName, err := value.GetType()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	// Array literals don't have an element type yet, so slicing one gives a shorter array literal
+	var typeData TypeData
+	if typeName != "untyped array" {
+		typeData, err = interpreter.GetTypeData(lowContext, typeName)
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		if !typeData.IsArray() && !typeData.IsList() {
+			err := InvalidOperationErr{Context: lowContext, TypeNames: []string{typeName}}
+			return NewErrorValue(err), err
+		}
+	}
+
+	if high < 0 || int(high) > len(value.items) {
+		err := IndexOutOfRangeErr{Context: highContext, Index: int(high), Length: len(value.items)}
+		return NewErrorValue(err), err
+	}
+
+	if low < 0 || low > high {
+		err := IndexOutOfRangeErr{Context: lowContext, Index: int(low), Length: len(value.items)}
+		return NewErrorValue(err), err
+	}
+
+	elements := make([]Value, high-low)
+	copy(elements, value.items[low:high])
+
+	if typeData.IsEmpty() {
+		return NewArrayValue(typeName, elements), nil
+	}
+
+	listTypeData, err := interpreter.GetTypeData(lowContext, ListTypeName(typeData.elementTypeName))
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewListValue(listTypeData.GetTypeName(), elements), nil
+}
+
+// Helper function to declare a list type of the given element type.
+func (interpreter *SimInterpreter) addListType(context ParseContext, typeName string, elementTypeName string) (TypeData, error) {
+	if _, err := interpreter.GetTypeData(context, elementTypeName); err != nil {
+		return TypeData{}, err
+	}
+
+	typeData := TypeData{
+		zeroValue:       NewListValue(typeName, []Value{}),
+		typeInfo:        TypeInfoList,
+		elementTypeName: elementTypeName,
+		implicitCastMap: map[string]struct{}{},
+	}
+
+	interpreter.types[typeName] = typeData
+
+	return typeData, nil
+}
+
+// Helper function to get the type data of a list value, or an error if the value isn't a list.
+func (interpreter *SimInterpreter) getListTypeData(context ParseContext, value Value) (TypeData, error) {
+	typeName, err := value.GetType()
+	if err != nil {
+		return TypeData{}, err
+	}
+
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil || !typeData.IsList() {
+		return TypeData{}, InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+	}
+
+	return typeData, nil
+}
+
+// Returns a copy of a list with a value added to the end.
+func (interpreter *SimInterpreter) builtinAppend(context ParseContext, args []Value) (Value, error) {
+	typeData, err := interpreter.getListTypeData(context, args[0])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	element, err := interpreter.castElement(context, typeData, args[1])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	elements := make([]Value, len(args[0].items), len(args[0].items)+1)
+	copy(elements, args[0].items)
+
+	return NewListValue(typeData.GetTypeName(), append(elements, element)), nil
+}
+
+// Returns a copy of a list with a value inserted at the given index, moving the elements after it back by one.
+func (interpreter *SimInterpreter) builtinInsert(context ParseContext, args []Value) (Value, error) {
+	typeData, err := interpreter.getListTypeData(context, args[0])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	index, err := args[1].GetInt(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	list := args[0].items
+	if index < 0 || int(index) > len(list) {
+		err := IndexOutOfRangeErr{Context: context, Index: int(index), Length: len(list)}
+		return NewErrorValue(err), err
+	}
+
+	element, err := interpreter.castElement(context, typeData, args[2])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	elements := make([]Value, 0, len(list)+1)
+	elements = append(elements, list[:index]...)
+	elements = append(elements, element)
+	elements = append(elements, list[index:]...)
+
+	return NewListValue(typeData.GetTypeName(), elements), nil
+}
+
+// Returns a copy of a list without the element at the given index, moving the elements after it forward by one.
+func (interpreter *SimInterpreter) builtinRemove(context ParseContext, args []Value) (Value, error) {
+	typeData, err := interpreter.getListTypeData(context, args[0])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	index, err := args[1].GetInt(context)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	list := args[0].items
+	if index < 0 || int(index) >= len(list) {
+		err := IndexOutOfRangeErr{Context: context, Index: int(index), Length: len(list)}
+		return NewErrorValue(err), err
+	}
+
+	elements := make([]Value, 0, len(list)-1)
+	elements = append(elements, list[:index]...)
+	elements = append(elements, list[index+1:]...)
+
+	return NewListValue(typeData.GetTypeName(), elements), nil
+}
+
+// Helper function to split a list type name, such as int[][4], into its element type name, such as int[4].
+// Returns false if the type name isn't a list type name.
+func parseListTypeName(typeName string) (string, bool) {
+	open := strings.Index(typeName, "[")
+	if open <= 0 || !strings.HasPrefix(typeName[open:], "[]") {
+		return "", false
+	}
+
+	return typeName[:open] + typeName[open+2:], true
+}
@@ -0,0 +1,189 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTypeName(t *testing.T) {
+	assert.Equal(t, "int[]", ListTypeName("int"))
+	assert.Equal(t, "int[][4]", ListTypeName("int[4]"))
+
+	elementTypeName, ok := parseListTypeName("int[][4]")
+	assert.True(t, ok)
+	assert.Equal(t, "int[4]", elementTypeName)
+
+	elementTypeName, ok = parseListTypeName("int[][]")
+	assert.True(t, ok)
+	assert.Equal(t, "int[]", elementTypeName)
+
+	for _, typeName := range []string{"int", "[]", "int[3]", "int[3][]"} {
+		_, ok := parseListTypeName(typeName)
+		assert.False(t, ok, typeName)
+	}
+}
+
+func TestInterpreterGetListTypeData(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	t.Run("unknown element type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.GetTypeData(context, "vec[]")
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "vec"}.Error())
+		assert.NotContains(t, interpreter.types, "vec[]")
+	})
+
+	interpreter := NewSimInterpreter(nil)
+
+	typeData, err := interpreter.GetTypeData(context, "int[3][]")
+	assert.NoError(t, err)
+	assert.True(t, typeData.IsArray())
+	assert.Equal(t, "int[]", typeData.ElementTypeName())
+
+	typeData, err = interpreter.GetTypeData(context, "int[]")
+	assert.NoError(t, err)
+	assert.True(t, typeData.IsList())
+	assert.Equal(t, "int", typeData.ElementTypeName())
+	assert.Equal(t, NewListValue("int[]", []Value{}), typeData.zeroValue)
+}
+
+func TestInterpreterSliceValue(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "int[3]")
+	assert.NoError(t, err)
+
+	ints := func(typeName string, data ...string) Value {
+		elements := make([]Value, len(data))
+		for i := range data {
+			elements[i] = NewValue("int", data[i])
+		}
+
+		return Value{typeName: typeName, items: elements}
+	}
+
+	array := ints("int[3]", "1", "2", "3")
+
+	tests := []struct {
+		name     string
+		value    Value
+		low      int64
+		high     int64
+		expected Value
+		err      error
+	}{
+		{name: "whole array", value: array, low: 0, high: 3, expected: ints("int[]", "1", "2", "3")},
+		{name: "middle", value: array, low: 1, high: 2, expected: ints("int[]", "2")},
+		{name: "empty", value: array, low: 3, high: 3, expected: ints("int[]")},
+		{name: "list", value: ints("int[]", "4", "5"), low: 1, high: 2, expected: ints("int[]", "5")},
+		{name: "negative low", value: array, low: -1, high: 2, err: IndexOutOfRangeErr{Index: -1, Length: 3}},
+		{name: "low after high", value: array, low: 2, high: 1, err: IndexOutOfRangeErr{Index: 2, Length: 3}},
+		{name: "high past the end", value: array, low: 0, high: 4, err: IndexOutOfRangeErr{Index: 4, Length: 3}},
+		{name: "array literal", value: ints("untyped array", "1", "2", "3"), low: 1, high: 3, expected: ints("untyped array", "2", "3")},
+		{name: "not a sequence", value: NewValue("int", "1"), low: 0, high: 0, err: InvalidOperationErr{TypeNames: []string{"int"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := interpreter.SliceValue(context, context, test.value, test.low, test.high)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Equal(t, NewErrorValue(test.err), value)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+
+	t.Run("slices are copies", func(t *testing.T) {
+		slice, err := interpreter.SliceValue(context, context, array, 0, 2)
+		assert.NoError(t, err)
+
+		slice, err = interpreter.SetElement(context, slice, 0, NewValue("int", "10"))
+		assert.NoError(t, err)
+		assert.Equal(t, ints("int[]", "10", "2"), slice)
+		assert.Equal(t, ints("int[3]", "1", "2", "3"), array)
+	})
+}
+
+func TestListBuiltinFunctions(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "int64[]")
+	assert.NoError(t, err)
+
+	list := func(data ...string) Value {
+		elements := make([]Value, len(data))
+		for i := range data {
+			elements[i] = NewValue("int64", data[i])
+		}
+
+		return NewListValue("int64[]", elements)
+	}
+
+	tests := []struct {
+		name     string
+		funcName string
+		args     []Value
+		expected Value
+		err      error
+	}{
+		{name: "append", funcName: "append", args: []Value{list("1"), NewValue("int64", "2")}, expected: list("1", "2")},
+		{name: "append untyped", funcName: "append", args: []Value{list(), NewValue("untyped int", "3")}, expected: list("3")},
+		{name: "append mismatched type", funcName: "append", args: []Value{list(), NewValue("bool", "true")}, err: MismatchedElementTypeErr{Context: context, TypeName: "int64[]", ElementTypeName: "int64", ValueTypeName: "bool"}},
+		{name: "append to a non-list", funcName: "append", args: []Value{NewValue("int", "1"), NewValue("int", "2")}, err: InvalidOperationErr{Context: context, TypeNames: []string{"int"}}},
+		{name: "insert front", funcName: "insert", args: []Value{list("1", "2"), NewValue("int", "0"), NewValue("int64", "0")}, expected: list("0", "1", "2")},
+		{name: "insert end", funcName: "insert", args: []Value{list("1", "2"), NewValue("int", "2"), NewValue("int64", "3")}, expected: list("1", "2", "3")},
+		{name: "insert out of range", funcName: "insert", args: []Value{list("1", "2"), NewValue("int", "3"), NewValue("int64", "3")}, err: IndexOutOfRangeErr{Context: context, Index: 3, Length: 2}},
+		{name: "remove", funcName: "remove", args: []Value{list("1", "2", "3"), NewValue("int", "1")}, expected: list("1", "3")},
+		{name: "remove out of range", funcName: "remove", args: []Value{list("1", "2"), NewValue("int", "2")}, err: IndexOutOfRangeErr{Context: context, Index: 2, Length: 2}},
+		{name: "len", funcName: "len", args: []Value{list("1", "2")}, expected: NewValue("int", "2")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			function, err := interpreter.GetFunction(context, test.funcName)
+			assert.NoError(t, err)
+
+			builtin, ok := function.Body().(BuiltinFunc)
+			assert.True(t, ok)
+
+			value, err := builtin(context, test.args)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Equal(t, NewErrorValue(test.err), value)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+
+	t.Run("arguments are not changed", func(t *testing.T) {
+		original := list("1", "2")
+
+		function, err := interpreter.GetFunction(context, "remove")
+		assert.NoError(t, err)
+
+		_, err = function.Body().(BuiltinFunc)(context, []Value{original, NewValue("int", "0")})
+		assert.NoError(t, err)
+		assert.Equal(t, list("1", "2"), original)
+	})
+}
+
+func TestValueString(t *testing.T) {
+	list := NewListValue("int[]", []Value{NewValue("int", "1"), NewValue("int", "2")})
+	point := NewStructValue("Point", []Value{NewValue("float", "1.5"), NewValue("string", `"a"`)})
+
+	assert.Equal(t, "10", NewValue("int", "10").String())
+	assert.Equal(t, "[1, 2]", list.String())
+	assert.Equal(t, `Point{1.5, "a"}`, point.String())
+	assert.Equal(t, `[[1, 2], []]`, NewListValue("int[][]", []Value{list, NewListValue("int[]", []Value{})}).String())
+}
@@ -0,0 +1,428 @@
+package interpreter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Maps keep their entries in the order that their keys were first added, so printing a map or listing its keys
+// always gives the same result. Like lists, maps have value semantics, so the map built-ins return a new map
+// rather than changing the map they are given, such as m = delete(m, "a").
+
+// MapTypeName returns the name of the map type with the given key and value types.
+func MapTypeName(keyTypeName string, valueTypeName string) string {
+	return "map[" + keyTypeName + "]" + valueTypeName
+}
+
+// GetMapValue returns the value stored under the given key of a map value.
+// The key must be implicitly castable to the map's key type, and it must be in the map.
+func (interpreter *SimInterpreter) GetMapValue(context ParseContext, value Value, key Value) (Value, error) {
+	typeData, err := interpreter.getMapTypeData(context, value)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	index, err := interpreter.findKey(context, typeData, value, key)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if index < 0 {
+		err := MissingKeyErr{Context: context, TypeName: typeData.GetTypeName(), Key: key.String()}
+		return NewErrorValue(err), err
+	}
+
+	return value.items[index+1], nil
+}
+
+// SetMapValue returns a copy of a map value with the given key set to the given value.
+// Keys that aren't in the map yet are added after all of the other keys.
+func (interpreter *SimInterpreter) SetMapValue(context ParseContext, value Value, key Value, element Value) (Value, error) {
+	typeData, err := interpreter.getMapTypeData(context, value)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return interpreter.setEntry(context, typeData, value, key, element)
+}
+
+// Returns true if a map has a value stored under the given key.
+func (interpreter *SimInterpreter) builtinHas(context ParseContext, args []Value) (Value, error) {
+	typeData, err := interpreter.getMapTypeData(context, args[0])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	index, err := interpreter.findKey(context, typeData, args[0], args[1])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if index < 0 {
+		return NewValue("bool", "false"), nil
+	}
+
+	return NewValue("bool", "true"), nil
+}
+
+// Returns a copy of a map without the given key and its value.
+func (interpreter *SimInterpreter) builtinDelete(context ParseContext, args []Value) (Value, error) {
+	typeData, err := interpreter.getMapTypeData(context, args[0])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	index, err := interpreter.findKey(context, typeData, args[0], args[1])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if index < 0 {
+		err := MissingKeyErr{Context: context, TypeName: typeData.GetTypeName(), Key: args[1].String()}
+		return NewErrorValue(err), err
+	}
+
+	entries := make([]Value, 0, len(args[0].items)-2)
+	entries = append(entries, args[0].items[:index]...)
+	entries = append(entries, args[0].items[index+2:]...)
+
+	return NewMapValue(typeData.GetTypeName(), entries), nil
+}
+
+// Returns the keys of a map as a list, in the order that they were added.
+func (interpreter *SimInterpreter) builtinKeys(context ParseContext, args []Value) (Value, error) {
+	typeData, err := interpreter.getMapTypeData(context, args[0])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	listTypeData, err := interpreter.GetTypeData(context, ListTypeName(typeData.keyTypeName))
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	keys, _, err := args[0].GetEntries()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewListValue(listTypeData.GetTypeName(), keys), nil
+}
+
+// Returns the values of a map as a list, in the order that their keys were added.
+func (interpreter *SimInterpreter) builtinValues(context ParseContext, args []Value) (Value, error) {
+	typeData, err := interpreter.getMapTypeData(context, args[0])
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	listTypeData, err := interpreter.GetTypeData(context, ListTypeName(typeData.elementTypeName))
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	_, values, err := args[0].GetEntries()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return NewListValue(listTypeData.GetTypeName(), values), nil
+}
+
+// Helper function to declare a map type of the given key and value types.
+// The key type must be comparable, so it can't be a map or hold a map.
+func (interpreter *SimInterpreter) addMapType(context ParseContext, typeName string, keyTypeName string, valueTypeName string) (TypeData, error) {
+	keyTypeData, err := interpreter.GetTypeData(context, keyTypeName)
+	if err != nil {
+		return TypeData{}, err
+	}
+
+	if !interpreter.isComparable(keyTypeData) {
+		return TypeData{}, InvalidKeyTypeErr{Context: context, TypeName: typeName, KeyTypeName: keyTypeName}
+	}
+
+	if _, err := interpreter.GetTypeData(context, valueTypeName); err != nil {
+		return TypeData{}, err
+	}
+
+	typeData := TypeData{
+		zeroValue:       NewMapValue(typeName, []Value{}),
+		typeInfo:        TypeInfoMap,
+		keyTypeName:     keyTypeName,
+		elementTypeName: valueTypeName,
+		implicitCastMap: map[string]struct{}{},
+	}
+
+	interpreter.types[typeName] = typeData
+
+	return typeData, nil
+}
+
+// Helper function to get the type data of a map value, or an error if the value isn't a map.
+func (interpreter *SimInterpreter) getMapTypeData(context ParseContext, value Value) (TypeData, error) {
+	typeName, err := value.GetType()
+	if err != nil {
+		return TypeData{}, err
+	}
+
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil || !typeData.IsMap() {
+		return TypeData{}, InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+	}
+
+	return typeData, nil
+}
+
+// Helper function to return true if values of the type can be compared with ==, which maps and functions can't.
+func (interpreter *SimInterpreter) isComparable(typeData TypeData) bool {
+	return interpreter.isComparableType(typeData, map[string]struct{}{})
+}
+
+// Helper function to check whether a type is comparable, skipping the union types that are already being checked
+// since a union that holds itself is comparable as long as the rest of its fields are.
+func (interpreter *SimInterpreter) isComparableType(typeData TypeData, unions map[string]struct{}) bool {
+	if typeData.IsMap() || typeData.IsFunction() {
+		return false
+	}
+
+	if typeData.IsArray() || typeData.IsList() {
+		elementTypeData, ok := interpreter.types[typeData.elementTypeName]
+		return ok && interpreter.isComparableType(elementTypeData, unions)
+	}
+
+	fields := typeData.fields
+
+	if typeData.IsUnion() {
+		if _, ok := unions[typeData.GetTypeName()]; ok {
+			return true
+		}
+
+		unions[typeData.GetTypeName()] = struct{}{}
+
+		for _, variant := range typeData.variants {
+			fields = append(fields, variant.fields...)
+		}
+	}
+
+	for _, field := range fields {
+		fieldTypeData, ok := interpreter.types[field.typeName]
+		if !ok || !interpreter.isComparableType(fieldTypeData, unions) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Helper function to build the index of the given map entries, which gives the position of each key in the entries.
+// An index is never changed once it is built, so maps whose keys are in the same positions can share it.
+func newMapIndex(entries []Value) map[string]int {
+	keys := make(map[string]int, len(entries)/2)
+	for i := 0; i+1 < len(entries); i += 2 {
+		keys[mapKey(entries[i])] = i
+	}
+
+	return keys
+}
+
+// Helper function to find the position of a key in the entries of a map value, or -1 if the key isn't in the map.
+// The key is cast to the map's key type and looked up in the map's index of its keys.
+func (interpreter *SimInterpreter) findKey(context ParseContext, typeData TypeData, value Value, key Value) (int, error) {
+	key, err := interpreter.castKey(context, typeData, key)
+	if err != nil {
+		return 0, err
+	}
+
+	index, ok := value.keys[mapKey(key)]
+	if !ok {
+		return -1, nil
+	}
+
+	return index, nil
+}
+
+// Helper function to return a copy of a map value with the given key set to the given value.
+// The entries are copied so the original map doesn't change, but the key is found through the map's index rather than by comparing it to every key.
+func (interpreter *SimInterpreter) setEntry(context ParseContext, typeData TypeData, value Value, key Value, element Value) (Value, error) {
+	key, err := interpreter.castKey(context, typeData, key)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	element, err = interpreter.castElement(context, typeData, element)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	entries := make([]Value, len(value.items), len(value.items)+2)
+	copy(entries, value.items)
+
+	// Changing the value of a key doesn't move any keys, so the index can be shared
+	text := mapKey(key)
+	if index, ok := value.keys[text]; ok {
+		entries[index+1] = element
+		return Value{typeName: typeData.GetTypeName(), items: entries, keys: value.keys}, nil
+	}
+
+	keys := make(map[string]int, len(value.keys)+1)
+	for k, index := range value.keys {
+		keys[k] = index
+	}
+
+	keys[text] = len(entries)
+
+	return Value{typeName: typeData.GetTypeName(), items: append(entries, key, element), keys: keys}, nil
+}
+
+// Helper function to build a map of the given type from the entries of a map literal.
+// Later entries replace earlier entries with the same key.
+func (interpreter *SimInterpreter) constructMap(context ParseContext, typeData TypeData, literalEntries []Value) (Value, error) {
+	entries := make([]Value, 0, len(literalEntries))
+	keys := make(map[string]int, len(literalEntries)/2)
+
+	for i := 0; i+1 < len(literalEntries); i += 2 {
+		key, err := interpreter.castKey(context, typeData, literalEntries[i])
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		element, err := interpreter.castElement(context, typeData, literalEntries[i+1])
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		text := mapKey(key)
+		if index, ok := keys[text]; ok {
+			entries[index+1] = element
+			continue
+		}
+
+		keys[text] = len(entries)
+		entries = append(entries, key, element)
+	}
+
+	return Value{typeName: typeData.GetTypeName(), items: entries, keys: keys}, nil
+}
+
+// Helper function to cast a key to the key type of a map.
+func (interpreter *SimInterpreter) castKey(context ParseContext, typeData TypeData, key Value) (Value, error) {
+	keyTypeName, err := key.GetType()
+	if err != nil {
+		return key, err
+	}
+
+	castedKey, ok := interpreter.ImplicitlyCast(context, key, typeData.keyTypeName)
+	if !ok {
+		return key, MismatchedKeyTypeErr{Context: context, TypeName: typeData.GetTypeName(), KeyTypeName: typeData.keyTypeName, ValueTypeName: keyTypeName}
+	}
+
+	return castedKey, nil
+}
+
+// Helper function to get the text that a key is indexed by in a map.
+// Keys that are equal with == have the same text, and keys that aren't have different text.
+func mapKey(key Value) string {
+	var builder strings.Builder
+	writeMapKey(&builder, key)
+
+	return builder.String()
+}
+
+// Helper function to write the text of a key, followed by the text of each of its fields or elements.
+// Each part is prefixed with its length so that the parts can't run together.
+func writeMapKey(builder *strings.Builder, key Value) {
+	// Integers are written without leading zeros and floating point zeros without a sign, since they are still equal
+	data := key.data
+	if num, err := strconv.ParseInt(data, 10, 64); err == nil {
+		data = strconv.FormatInt(num, 10)
+	} else if num, err := strconv.ParseFloat(data, 64); err == nil && num == 0 {
+		data = "0"
+	}
+
+	builder.WriteString(strconv.Itoa(len(data)))
+	builder.WriteByte(':')
+	builder.WriteString(data)
+	builder.WriteByte('[')
+
+	for _, item := range key.items {
+		writeMapKey(builder, item)
+	}
+
+	builder.WriteByte(']')
+}
+
+// Helper function to cast a map literal to the given map type.
+// Returns false if any of the literal's keys or values don't fit the type.
+func (interpreter *SimInterpreter) castUntypedMap(context ParseContext, value Value, typeData TypeData) (Value, bool) {
+	if !typeData.IsMap() {
+		return value, false
+	}
+
+	result, err := interpreter.constructMap(context, typeData, value.items)
+	if err != nil {
+		return value, false
+	}
+
+	return result, true
+}
+
+// Helper function to give a map literal a concrete map type when there is no type for it to take on.
+// The key and value types are chosen the same way as the element type of an array literal.
+func (interpreter *SimInterpreter) typeUntypedMap(context ParseContext, value Value) (Value, error) {
+	if len(value.items) == 0 {
+		err := UntypedMapErr{Context: context}
+		return NewErrorValue(err), err
+	}
+
+	keys, values, err := value.GetEntries()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	keyTypeName, err := interpreter.defaultTypeName(context, keys)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	valueTypeName, err := interpreter.defaultTypeName(context, values)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	typeData, err := interpreter.GetTypeData(context, MapTypeName(keyTypeName, valueTypeName))
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return interpreter.constructMap(context, typeData, value.items)
+}
+
+// Helper function to split a map type name, such as map[string]int[3], into its key and value type names, such as string and int[3].
+// Returns false if the type name isn't a map type name.
+func parseMapTypeName(typeName string) (string, string, bool) {
+	if !strings.HasPrefix(typeName, "map[") {
+		return "", "", false
+	}
+
+	// The key type can have brackets of its own, so find the bracket that closes the one after map
+	depth := 0
+	for i := len("map"); i < len(typeName); i++ {
+		switch typeName[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		}
+
+		if depth == 0 {
+			keyTypeName, valueTypeName := typeName[len("map["):i], typeName[i+1:]
+			if keyTypeName == "" || valueTypeName == "" {
+				return "", "", false
+			}
+
+			return keyTypeName, valueTypeName, true
+		}
+	}
+
+	return "", "", false
+}
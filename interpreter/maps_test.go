@@ -0,0 +1,344 @@
+package interpreter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapTypeName(t *testing.T) {
+	assert.Equal(t, "map[string]int", MapTypeName("string", "int"))
+	assert.Equal(t, "map[int[2]]map[string]int", MapTypeName("int[2]", MapTypeName("string", "int")))
+
+	keyTypeName, valueTypeName, ok := parseMapTypeName("map[int[2]]map[string]int[3]")
+	assert.True(t, ok)
+	assert.Equal(t, "int[2]", keyTypeName)
+	assert.Equal(t, "map[string]int[3]", valueTypeName)
+
+	for _, typeName := range []string{"int", "map", "map[]int", "map[string]", "map[string", "mapping[string]int"} {
+		_, _, ok := parseMapTypeName(typeName)
+		assert.False(t, ok, typeName)
+	}
+}
+
+func TestInterpreterGetMapTypeData(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	t.Run("unknown key type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.GetTypeData(context, "map[vec]int")
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "vec"}.Error())
+		assert.NotContains(t, interpreter.types, "map[vec]int")
+	})
+
+	t.Run("unknown value type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.GetTypeData(context, "map[int]vec")
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "vec"}.Error())
+	})
+
+	t.Run("map keys", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		_, err := interpreter.GetTypeData(context, "map[map[string]int]int")
+		assert.EqualError(t, err, InvalidKeyTypeErr{TypeName: "map[map[string]int]int", KeyTypeName: "map[string]int"}.Error())
+
+		err = interpreter.AddStructType(context, "Counts", []Field{NewField("counts", "map[string]int")})
+		assert.NoError(t, err)
+
+		_, err = interpreter.GetTypeData(context, "map[Counts[]]int")
+		assert.EqualError(t, err, InvalidKeyTypeErr{TypeName: "map[Counts[]]int", KeyTypeName: "Counts[]"}.Error())
+	})
+
+	interpreter := NewSimInterpreter(nil)
+
+	typeData, err := interpreter.GetTypeData(context, "map[string]int[2]")
+	assert.NoError(t, err)
+	assert.True(t, typeData.IsMap())
+	assert.Equal(t, "string", typeData.KeyTypeName())
+	assert.Equal(t, "int[2]", typeData.ElementTypeName())
+	assert.Equal(t, NewMapValue("map[string]int[2]", []Value{}), typeData.zeroValue)
+}
+
+func TestInterpreterGetAndSetMapValue(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "map[string]float")
+	assert.NoError(t, err)
+
+	str := func(s string) Value {
+		return NewValue("string", quoteString(s))
+	}
+
+	m := NewMapValue("map[string]float", []Value{str("a"), NewValue("float", "1.5"), str("b"), NewValue("float", "2")})
+
+	t.Run("missing key", func(t *testing.T) {
+		value, err := interpreter.GetMapValue(context, m, str("c"))
+		expectedErr := MissingKeyErr{TypeName: "map[string]float", Key: `"c"`}
+		assert.EqualError(t, err, expectedErr.Error())
+		assert.Equal(t, NewErrorValue(expectedErr), value)
+	})
+
+	t.Run("mismatched key type", func(t *testing.T) {
+		_, err := interpreter.GetMapValue(context, m, NewValue("int", "1"))
+		assert.EqualError(t, err, MismatchedKeyTypeErr{TypeName: "map[string]float", KeyTypeName: "string", ValueTypeName: "int"}.Error())
+
+		_, err = interpreter.SetMapValue(context, m, NewValue("int", "1"), NewValue("float", "1"))
+		assert.EqualError(t, err, MismatchedKeyTypeErr{TypeName: "map[string]float", KeyTypeName: "string", ValueTypeName: "int"}.Error())
+	})
+
+	t.Run("mismatched value type", func(t *testing.T) {
+		_, err := interpreter.SetMapValue(context, m, str("a"), NewValue("bool", "true"))
+		assert.EqualError(t, err, MismatchedElementTypeErr{TypeName: "map[string]float", ElementTypeName: "float", ValueTypeName: "bool"}.Error())
+	})
+
+	t.Run("not a map", func(t *testing.T) {
+		_, err := interpreter.GetMapValue(context, NewValue("int", "1"), str("a"))
+		assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"int"}}.Error())
+	})
+
+	value, err := interpreter.GetMapValue(context, m, str("b"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("float", "2"), value)
+
+	result, err := interpreter.SetMapValue(context, m, str("a"), NewValue("untyped int", "3"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMapValue("map[string]float", []Value{str("a"), NewValue("float", "3"), str("b"), NewValue("float", "2")}), result)
+
+	// New keys are added after the existing keys
+	result, err = interpreter.SetMapValue(context, result, str("0"), NewValue("float", "4"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMapValue("map[string]float", []Value{str("a"), NewValue("float", "3"), str("b"), NewValue("float", "2"), str("0"), NewValue("float", "4")}), result)
+
+	// Setting a value returns a new map, leaving the original untouched
+	assert.Equal(t, NewMapValue("map[string]float", []Value{str("a"), NewValue("float", "1.5"), str("b"), NewValue("float", "2")}), m)
+}
+
+func TestInterpreterMapKeyIndex(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	t.Run("equal floats", func(t *testing.T) {
+		_, err := interpreter.GetTypeData(context, "map[float]int")
+		assert.NoError(t, err)
+
+		m, err := interpreter.SetMapValue(context, NewMapValue("map[float]int", []Value{}), NewValue("float", "0"), NewValue("int", "1"))
+		assert.NoError(t, err)
+
+		// -0 == 0, so it is the same key
+		m, err = interpreter.SetMapValue(context, m, NewValue("float", "-0"), NewValue("int", "2"))
+		assert.NoError(t, err)
+		assert.Equal(t, NewMapValue("map[float]int", []Value{NewValue("float", "0"), NewValue("int", "2")}), m)
+	})
+
+	t.Run("struct keys", func(t *testing.T) {
+		err := interpreter.AddStructType(context, "Point", []Field{NewField("x", "int"), NewField("y", "int")})
+		assert.NoError(t, err)
+
+		_, err = interpreter.GetTypeData(context, "map[Point]string")
+		assert.NoError(t, err)
+
+		point := func(x string, y string) Value {
+			return NewStructValue("Point", []Value{NewValue("int", x), NewValue("int", y)})
+		}
+
+		m := NewMapValue("map[Point]string", []Value{point("1", "23"), NewValue("string", `"a"`), point("12", "3"), NewValue("string", `"b"`)})
+
+		value, err := interpreter.GetMapValue(context, m, point("12", "3"))
+		assert.NoError(t, err)
+		assert.Equal(t, NewValue("string", `"b"`), value)
+
+		_, err = interpreter.GetMapValue(context, m, point("123", ""))
+		assert.EqualError(t, err, MissingKeyErr{TypeName: "map[Point]string", Key: "Point{123, }"}.Error())
+	})
+
+	t.Run("copies", func(t *testing.T) {
+		_, err := interpreter.GetTypeData(context, "map[string]int")
+		assert.NoError(t, err)
+
+		str := func(s string) Value {
+			return NewValue("string", quoteString(s))
+		}
+
+		m := NewMapValue("map[string]int", []Value{str("a"), NewValue("int", "1")})
+		for _, key := range []string{"b", "c"} {
+			m, err = interpreter.SetMapValue(context, m, str(key), NewValue("int", "1"))
+			assert.NoError(t, err)
+		}
+
+		// Both maps are copies of m, so neither may see the key added to the other
+		m1, err := interpreter.SetMapValue(context, m, str("x"), NewValue("int", "10"))
+		assert.NoError(t, err)
+
+		m2, err := interpreter.SetMapValue(context, m, str("y"), NewValue("int", "20"))
+		assert.NoError(t, err)
+
+		value, err := interpreter.GetMapValue(context, m1, str("x"))
+		assert.NoError(t, err)
+		assert.Equal(t, NewValue("int", "10"), value)
+
+		_, err = interpreter.GetMapValue(context, m1, str("y"))
+		assert.EqualError(t, err, MissingKeyErr{TypeName: "map[string]int", Key: `"y"`}.Error())
+
+		_, err = interpreter.GetMapValue(context, m2, str("x"))
+		assert.EqualError(t, err, MissingKeyErr{TypeName: "map[string]int", Key: `"x"`}.Error())
+
+		entries := []Value{str("a"), NewValue("int", "1"), str("b"), NewValue("int", "1"), str("c"), NewValue("int", "1")}
+		assert.Equal(t, NewMapValue("map[string]int", entries), m)
+		assert.Equal(t, NewMapValue("map[string]int", append(entries[:6:6], str("x"), NewValue("int", "10"))), m1)
+		assert.Equal(t, NewMapValue("map[string]int", append(entries[:6:6], str("y"), NewValue("int", "20"))), m2)
+	})
+
+	t.Run("many keys", func(t *testing.T) {
+		_, err := interpreter.GetTypeData(context, "map[int]int")
+		assert.NoError(t, err)
+
+		entries := make([]Value, 0, 20000)
+		for i := 0; i < 10000; i++ {
+			entries = append(entries, NewValue("untyped int", fmt.Sprint(i)), NewValue("untyped int", fmt.Sprint(i*2)))
+		}
+
+		m, ok := interpreter.ImplicitlyCast(context, NewMapValue("untyped map", entries), "map[int]int")
+		assert.True(t, ok)
+
+		value, err := interpreter.GetMapValue(context, m, NewValue("untyped int", "9999"))
+		assert.NoError(t, err)
+		assert.Equal(t, NewValue("int", "19998"), value)
+		assert.Equal(t, NewValue("int", "0"), m.items[0])
+		assert.Len(t, m.items, 20000)
+	})
+}
+
+func TestMapBuiltinFunctions(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "map[int]bool")
+	assert.NoError(t, err)
+
+	m := NewMapValue("map[int]bool", []Value{NewValue("int", "2"), NewValue("bool", "true"), NewValue("int", "1"), NewValue("bool", "false")})
+
+	tests := []struct {
+		name     string
+		funcName string
+		args     []Value
+		expected Value
+		err      error
+	}{
+		{name: "has", funcName: "has", args: []Value{m, NewValue("untyped int", "1")}, expected: NewValue("bool", "true")},
+		{name: "has missing key", funcName: "has", args: []Value{m, NewValue("int", "3")}, expected: NewValue("bool", "false")},
+		{name: "has mismatched key type", funcName: "has", args: []Value{m, NewValue("bool", "true")}, err: MismatchedKeyTypeErr{Context: context, TypeName: "map[int]bool", KeyTypeName: "int", ValueTypeName: "bool"}},
+		{name: "delete", funcName: "delete", args: []Value{m, NewValue("int", "2")}, expected: NewMapValue("map[int]bool", []Value{NewValue("int", "1"), NewValue("bool", "false")})},
+		{name: "delete missing key", funcName: "delete", args: []Value{m, NewValue("int", "3")}, err: MissingKeyErr{Context: context, TypeName: "map[int]bool", Key: "3"}},
+		{name: "delete from a non-map", funcName: "delete", args: []Value{NewValue("int", "1"), NewValue("int", "1")}, err: InvalidOperationErr{Context: context, TypeNames: []string{"int"}}},
+		{name: "keys", funcName: "keys", args: []Value{m}, expected: NewListValue("int[]", []Value{NewValue("int", "2"), NewValue("int", "1")})},
+		{name: "values", funcName: "values", args: []Value{m}, expected: NewListValue("bool[]", []Value{NewValue("bool", "true"), NewValue("bool", "false")})},
+		{name: "len", funcName: "len", args: []Value{m}, expected: NewValue("int", "2")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			function, err := interpreter.GetFunction(context, test.funcName)
+			assert.NoError(t, err)
+
+			value, err := function.Body().(BuiltinFunc)(context, test.args)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Equal(t, NewErrorValue(test.err), value)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestInterpreterUntypedMaps(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	literal := NewMapValue("untyped map", []Value{
+		NewValue("untyped int", "1"), NewValue("untyped int", "2"),
+		NewValue("untyped int", "3"), NewValue("untyped float", "4.5"),
+	})
+
+	t.Run("cast", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		value, ok := interpreter.ImplicitlyCast(context, literal, "map[int64]float64")
+		assert.True(t, ok)
+		assert.Equal(t, NewMapValue("map[int64]float64", []Value{NewValue("int64", "1"), NewValue("float64", "2"), NewValue("int64", "3"), NewValue("float64", "4.5")}), value)
+
+		_, ok = interpreter.ImplicitlyCast(context, literal, "map[int]int")
+		assert.False(t, ok)
+
+		_, ok = interpreter.ImplicitlyCast(context, literal, "int[4]")
+		assert.False(t, ok)
+	})
+
+	t.Run("duplicate keys", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		duplicates := NewMapValue("untyped map", []Value{NewValue("untyped int", "1"), NewValue("bool", "true"), NewValue("untyped int", "1"), NewValue("bool", "false")})
+
+		value, ok := interpreter.ImplicitlyCast(context, duplicates, "map[int]bool")
+		assert.True(t, ok)
+		assert.Equal(t, NewMapValue("map[int]bool", []Value{NewValue("int", "1"), NewValue("bool", "false")}), value)
+	})
+
+	t.Run("empty literal", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddVar(context, NewVariable("m", NewMapValue("untyped map", []Value{})))
+		assert.EqualError(t, err, UntypedMapErr{}.Error())
+	})
+
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddVar(context, NewVariable("m", literal))
+	assert.NoError(t, err)
+
+	expected := NewMapValue("map[int]float", []Value{NewValue("int", "1"), NewValue("float", "2"), NewValue("int", "3"), NewValue("float", "4.5")})
+	assert.Equal(t, NewVariable("m", expected), interpreter.GetAllVars()["m"])
+
+	err = interpreter.SetVarValue(context, "m", NewMapValue("untyped map", []Value{}))
+	assert.NoError(t, err)
+	assert.Equal(t, NewVariable("m", NewMapValue("map[int]float", []Value{})), interpreter.GetAllVars()["m"])
+}
+
+func TestInterpreterResolveMapBinaryOperations(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "map[int]int")
+	assert.NoError(t, err)
+
+	m := NewMapValue("map[int]int", []Value{NewValue("int", "1"), NewValue("int", "2")})
+
+	_, err = interpreter.ResolveBinaryOperations(context, context, m, m, "==")
+	assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"map[int]int", "map[int]int"}}.Error())
+}
+
+func TestInterpreterFormatMap(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	_, err := interpreter.GetTypeData(context, "map[string]int[]")
+	assert.NoError(t, err)
+
+	list := NewListValue("int[]", []Value{NewValue("int", "1"), NewValue("int", "2")})
+	m := NewMapValue("map[string]int[]", []Value{NewValue("string", `"b"`), list, NewValue("string", `"a"`), NewListValue("int[]", []Value{})})
+
+	text, err := interpreter.FormatValue(context, m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"b": [1, 2], "a": []}`, text)
+	assert.Equal(t, `{"b": [1, 2], "a": []}`, m.String())
+
+	text, err = interpreter.FormatValue(context, NewMapValue("untyped map", []Value{}))
+	assert.NoError(t, err)
+	assert.Equal(t, "{}", text)
+}
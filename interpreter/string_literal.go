@@ -0,0 +1,103 @@
+package interpreter
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseStringLiteral converts a string literal as it is written in a Sim program into string data.
+// Double quoted strings may use the escape sequences \n, \t, \", \\, and \u{...},
+// and may span multiple lines when triple quoted. Raw strings are surrounded by backticks,
+// may span multiple lines, and are taken as-is. Carriage returns are removed from the line
+// breaks of multi-line strings so that the data doesn't depend on the platform.
+func ParseStringLiteral(context ParseContext, literal string) (string, error) {
+	var contents string
+
+	switch {
+	case len(literal) >= 2 && strings.HasPrefix(literal, "`") && strings.HasSuffix(literal, "`"):
+		return quoteString(strings.ReplaceAll(literal[1:len(literal)-1], "\r", "")), nil
+
+	case len(literal) >= 6 && strings.HasPrefix(literal, `"""`) && strings.HasSuffix(literal, `"""`):
+		contents = strings.ReplaceAll(literal[3:len(literal)-3], "\r\n", "\n")
+
+	case len(literal) >= 2 && strings.HasPrefix(literal, `"`) && strings.HasSuffix(literal, `"`):
+		contents = literal[1 : len(literal)-1]
+
+	default:
+		return "", DataTypeErr{Context: context, TypeName: "string"}
+	}
+
+	var builder strings.Builder
+
+	for i := 0; i < len(contents); i++ {
+		if contents[i] != '\\' {
+			builder.WriteByte(contents[i])
+			continue
+		}
+
+		if i+1 >= len(contents) {
+			return "", InvalidEscapeErr{Context: context, Sequence: contents[i:]}
+		}
+
+		switch contents[i+1] {
+		case 'n':
+			builder.WriteByte('\n')
+			i++
+		case 't':
+			builder.WriteByte('\t')
+			i++
+		case '"':
+			builder.WriteByte('"')
+			i++
+		case '\\':
+			builder.WriteByte('\\')
+			i++
+		case 'u':
+			r, length, ok := parseUnicodeEscape(contents[i:])
+			if !ok {
+				return "", InvalidEscapeErr{Context: context, Sequence: contents[i : i+length]}
+			}
+
+			builder.WriteRune(r)
+			i += length - 1
+		default:
+			_, size := utf8.DecodeRuneInString(contents[i+1:])
+			return "", InvalidEscapeErr{Context: context, Sequence: contents[i : i+1+size]}
+		}
+	}
+
+	return quoteString(builder.String()), nil
+}
+
+// Helper function to parse a \u{...} escape sequence at the start of the given string.
+// Returns the rune along with the length of the escape sequence. If the escape sequence is invalid,
+// the length is how much of the escape sequence was read before it became invalid.
+func parseUnicodeEscape(s string) (rune, int, bool) {
+	if len(s) < 3 || s[2] != '{' {
+		return 0, 2, false
+	}
+
+	end := strings.IndexByte(s, '}')
+	if end < 0 {
+		return 0, len(s), false
+	}
+
+	// Code points are at most 6 hex digits
+	hex := s[3:end]
+	if len(hex) == 0 || len(hex) > 6 {
+		return 0, end + 1, false
+	}
+
+	code, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || !utf8.ValidRune(rune(code)) {
+		return 0, end + 1, false
+	}
+
+	return rune(code), end + 1, true
+}
+
+// Helper function to wrap string contents in the double quotes that string data is stored with.
+func quoteString(contents string) string {
+	return `"` + contents + `"`
+}
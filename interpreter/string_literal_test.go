@@ -0,0 +1,50 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStringLiteral(t *testing.T) {
+	context := NewParseContext(1, 4)
+
+	tests := []struct {
+		name     string
+		literal  string
+		expected string
+		err      error
+	}{
+		{name: "empty", literal: `""`, expected: `""`},
+		{name: "plain", literal: `"hello"`, expected: `"hello"`},
+		{name: "escapes", literal: `"a\nb\tc\"d\\e"`, expected: "\"a\nb\tc\"d\\e\""},
+		{name: "unicode escape", literal: `"\u{48}\u{e9}\u{1F600}"`, expected: "\"Hé😀\""},
+		{name: "utf-8", literal: `"héllo 世界"`, expected: `"héllo 世界"`},
+		{name: "multi-line", literal: "\"\"\"one\r\n\ttwo\\n\"\"\"", expected: "\"one\n\ttwo\n\""},
+		{name: "raw", literal: "`C:\\path\\n\r\n\"x\"`", expected: "\"C:\\path\\n\n\"x\"\""},
+		{name: "unknown escape", literal: `"a\qb"`, err: InvalidEscapeErr{Context: context, Sequence: `\q`}},
+		{name: "unknown unicode escape", literal: `"\é"`, err: InvalidEscapeErr{Context: context, Sequence: `\é`}},
+		{name: "trailing backslash", literal: "\"\"\"a\\\"\"\"", err: InvalidEscapeErr{Context: context, Sequence: `\`}},
+		{name: "unicode escape without braces", literal: `"\u0048"`, err: InvalidEscapeErr{Context: context, Sequence: `\u`}},
+		{name: "unterminated unicode escape", literal: `"\u{48"`, err: InvalidEscapeErr{Context: context, Sequence: `\u{48`}},
+		{name: "empty unicode escape", literal: `"\u{}"`, err: InvalidEscapeErr{Context: context, Sequence: `\u{}`}},
+		{name: "invalid hex", literal: `"\u{4G}"`, err: InvalidEscapeErr{Context: context, Sequence: `\u{4G}`}},
+		{name: "too many digits", literal: `"\u{0000048}"`, err: InvalidEscapeErr{Context: context, Sequence: `\u{0000048}`}},
+		{name: "invalid code point", literal: `"\u{D800}"`, err: InvalidEscapeErr{Context: context, Sequence: `\u{D800}`}},
+		{name: "not a string", literal: `10`, err: DataTypeErr{Context: context, TypeName: "string"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := ParseStringLiteral(context, test.literal)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, data)
+			assert.Equal(t, "string", GetTypeFromLiteral(context, data))
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package interpreter
+
+// AddStructType declares a new struct type with the given fields.
+// The field types must already be declared, so a struct can't contain itself.
+// The type's zero value holds the zero value of each of its fields.
+func (interpreter *SimInterpreter) AddStructType(context ParseContext, typeName string, fields []Field) error {
+	if err := interpreter.checkTypeName(context, typeName); err != nil {
+		return err
+	}
+
+	fieldNames := make(map[string]struct{})
+	zeroFields := make([]Value, len(fields))
+
+	for i, field := range fields {
+		fieldTypeData, err := interpreter.GetTypeData(context, field.typeName)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := fieldNames[field.name]; ok {
+			return FieldExistsErr{Context: context, TypeName: typeName, FieldName: field.name}
+		}
+
+		fieldNames[field.name] = struct{}{}
+		zeroFields[i] = fieldTypeData.zeroValue
+	}
+
+	interpreter.types[typeName] = TypeData{
+		zeroValue:       NewStructValue(typeName, zeroFields),
+		typeInfo:        TypeInfoStruct,
+		fields:          fields,
+		implicitCastMap: map[string]struct{}{},
+	}
+
+	return nil
+}
+
+// ConstructStruct returns a new value of the given struct type holding the given field values in declaration order.
+// Constructing a struct without any values returns its zero value.
+// Each value's parse context is used to report a mismatched field type.
+func (interpreter *SimInterpreter) ConstructStruct(context ParseContext, typeName string, values []Value, valueContexts []ParseContext) (Value, error) {
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	if len(values) == 0 {
+		return typeData.zeroValue, nil
+	}
+
+	if len(values) != len(typeData.fields) {
+		err := MismatchedArgCountErr{Context: context, FuncName: typeName, Expected: len(typeData.fields), Actual: len(values)}
+		return NewErrorValue(err), err
+	}
+
+	fields := make([]Value, len(values))
+	for i, field := range typeData.fields {
+		value, err := interpreter.castField(valueContexts[i], typeName, field, values[i])
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		fields[i] = value
+	}
+
+	return NewStructValue(typeName, fields), nil
+}
+
+// GetField returns the value of the named field of a struct value.
+func (interpreter *SimInterpreter) GetField(context ParseContext, value Value, fieldName string) (Value, error) {
+	_, index, err := interpreter.findField(context, value, fieldName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	return value.items[index], nil
+}
+
+// SetField returns a copy of a struct value with the named field set to the given value.
+// The value must be implicitly castable to the field's type.
+func (interpreter *SimInterpreter) SetField(context ParseContext, value Value, fieldName string, fieldValue Value) (Value, error) {
+	typeData, index, err := interpreter.findField(context, value, fieldName)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	fieldValue, err = interpreter.castField(context, value.typeName, typeData.fields[index], fieldValue)
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	fields, err := value.GetFields()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	fields[index] = fieldValue
+
+	return NewStructValue(value.typeName, fields), nil
+}
+
+// SetCompareFunction declares that values of a struct or union type are ordered by the named function,
+// as written with "ordered by" at the end of the type's declaration. The function is looked up when values are compared,
+// so it can be declared after the type that it orders.
+func (interpreter *SimInterpreter) SetCompareFunction(context ParseContext, typeName string, funcName string) error {
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return err
+	}
+
+	if !typeData.IsStruct() && !typeData.IsUnion() {
+		return InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+	}
+
+	typeData.compareName = funcName
+	interpreter.types[typeName] = typeData
+
+	return nil
+}
+
+// GetCompareFunction returns the function that orders values of the given type, or false if the type isn't ordered.
+// The function takes two values of the type and returns an int that is negative, zero or positive
+// when the first value is less than, equal to or greater than the second.
+// Returns an error if the function isn't declared or has a different signature.
+func (interpreter *SimInterpreter) GetCompareFunction(context ParseContext, typeName string) (Function, bool, error) {
+	typeData, ok := interpreter.types[typeName]
+	if !ok || typeData.compareName == "" {
+		return Function{}, false, nil
+	}
+
+	function, err := interpreter.GetFunction(context, typeData.compareName)
+	if err != nil {
+		return Function{}, false, err
+	}
+
+	if function.returnTypeName != "int" || len(function.params) != 2 || function.params[0].typeName != typeName || function.params[1].typeName != typeName {
+		return Function{}, false, InvalidCompareFunctionErr{Context: context, TypeName: typeName, FuncName: typeData.compareName}
+	}
+
+	return function, true, nil
+}
+
+// Helper function to find the type data of a struct value and the position of the named field in it.
+func (interpreter *SimInterpreter) findField(context ParseContext, value Value, fieldName string) (TypeData, int, error) {
+	typeName, err := value.GetType()
+	if err != nil {
+		return TypeData{}, 0, err
+	}
+
+	typeData, ok := interpreter.types[typeName]
+	if !ok || !typeData.IsStruct() {
+		return TypeData{}, 0, UnknownFieldErr{Context: context, TypeName: typeName, FieldName: fieldName}
+	}
+
+	index, ok := typeData.FieldIndex(fieldName)
+	if !ok {
+		return TypeData{}, 0, UnknownFieldErr{Context: context, TypeName: typeName, FieldName: fieldName}
+	}
+
+	return typeData, index, nil
+}
+
+// Helper function to cast a value to the type of a struct field.
+func (interpreter *SimInterpreter) castField(context ParseContext, typeName string, field Field, value Value) (Value, error) {
+	valueTypeName, err := value.GetType()
+	if err != nil {
+		return NewErrorValue(err), err
+	}
+
+	result, ok := interpreter.ImplicitlyCast(context, value, field.typeName)
+	if !ok {
+		err := MismatchedFieldTypeErr{Context: context, TypeName: typeName, FieldName: field.name, FieldTypeName: field.typeName, ValueTypeName: valueTypeName}
+		return NewErrorValue(err), err
+	}
+
+	return result, nil
+}
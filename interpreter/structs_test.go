@@ -0,0 +1,259 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpreterAddStructType(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	t.Run("type exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddStructType(context, "int", []Field{NewField("x", "int")})
+		assert.EqualError(t, err, TypeExistsErr{TypeName: "int"}.Error())
+		assert.Equal(t, getBasicTypes()["int"], interpreter.types["int"])
+	})
+
+	t.Run("function exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddStructType(context, "len", []Field{NewField("x", "int")})
+		assert.EqualError(t, err, FunctionExistsErr{FuncName: "len"}.Error())
+		assert.NotContains(t, interpreter.types, "len")
+	})
+
+	t.Run("unknown field type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddStructType(context, "Node", []Field{NewField("next", "Node")})
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "Node"}.Error())
+		assert.NotContains(t, interpreter.types, "Node")
+	})
+
+	t.Run("field exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddStructType(context, "Point", []Field{NewField("x", "int"), NewField("x", "float")})
+		assert.EqualError(t, err, FieldExistsErr{TypeName: "Point", FieldName: "x"}.Error())
+		assert.NotContains(t, interpreter.types, "Point")
+	})
+
+	interpreter := NewSimInterpreter(nil)
+	fields := []Field{NewField("x", "int"), NewField("label", "string")}
+
+	err := interpreter.AddStructType(context, "Point", fields)
+	assert.NoError(t, err)
+
+	typeData, err := interpreter.GetTypeData(context, "Point")
+	assert.NoError(t, err)
+	assert.True(t, typeData.IsStruct())
+	assert.Equal(t, fields, typeData.Fields())
+	assert.Equal(t, NewStructValue("Point", []Value{NewValue("int", "0"), NewValue("string", `""`)}), typeData.zeroValue)
+
+	index, ok := typeData.FieldIndex("label")
+	assert.True(t, ok)
+	assert.Equal(t, 1, index)
+
+	_, ok = typeData.FieldIndex("y")
+	assert.False(t, ok)
+}
+
+func TestInterpreterConstructStruct(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddStructType(context, "Point", []Field{NewField("x", "int64"), NewField("y", "int64")})
+	assert.NoError(t, err)
+
+	t.Run("mismatched count", func(t *testing.T) {
+		value, err := interpreter.ConstructStruct(context, "Point", []Value{NewValue("int64", "1")}, []ParseContext{context})
+		expectedErr := MismatchedArgCountErr{FuncName: "Point", Expected: 2, Actual: 1}
+		assert.EqualError(t, err, expectedErr.Error())
+		assert.Equal(t, NewErrorValue(expectedErr), value)
+	})
+
+	t.Run("mismatched field type", func(t *testing.T) {
+		_, err := interpreter.ConstructStruct(context, "Point", []Value{NewValue("int64", "1"), NewValue("bool", "true")}, []ParseContext{context, context})
+		assert.EqualError(t, err, MismatchedFieldTypeErr{TypeName: "Point", FieldName: "y", FieldTypeName: "int64", ValueTypeName: "bool"}.Error())
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		value, err := interpreter.ConstructStruct(context, "Point", nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, NewStructValue("Point", []Value{NewValue("int64", "0"), NewValue("int64", "0")}), value)
+	})
+
+	value, err := interpreter.ConstructStruct(context, "Point", []Value{NewValue("int", "1"), NewValue("untyped int", "2")}, []ParseContext{context, context})
+	assert.NoError(t, err)
+	assert.Equal(t, NewStructValue("Point", []Value{NewValue("int64", "1"), NewValue("int64", "2")}), value)
+}
+
+func TestInterpreterGetAndSetField(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddStructType(context, "Point", []Field{NewField("x", "float"), NewField("y", "float")})
+	assert.NoError(t, err)
+
+	point := NewStructValue("Point", []Value{NewValue("float", "1"), NewValue("float", "2")})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := interpreter.GetField(context, point, "z")
+		assert.EqualError(t, err, UnknownFieldErr{TypeName: "Point", FieldName: "z"}.Error())
+
+		_, err = interpreter.SetField(context, point, "z", NewValue("float", "3"))
+		assert.EqualError(t, err, UnknownFieldErr{TypeName: "Point", FieldName: "z"}.Error())
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := interpreter.GetField(context, NewValue("int", "1"), "x")
+		assert.EqualError(t, err, UnknownFieldErr{TypeName: "int", FieldName: "x"}.Error())
+	})
+
+	t.Run("mismatched field type", func(t *testing.T) {
+		_, err := interpreter.SetField(context, point, "x", NewValue("string", `"3"`))
+		assert.EqualError(t, err, MismatchedFieldTypeErr{TypeName: "Point", FieldName: "x", FieldTypeName: "float", ValueTypeName: "string"}.Error())
+	})
+
+	value, err := interpreter.GetField(context, point, "y")
+	assert.NoError(t, err)
+	assert.Equal(t, NewValue("float", "2"), value)
+
+	result, err := interpreter.SetField(context, point, "y", NewValue("float", "3"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewStructValue("Point", []Value{NewValue("float", "1"), NewValue("float", "3")}), result)
+
+	// Setting a field returns a new value, leaving the original untouched
+	assert.Equal(t, NewStructValue("Point", []Value{NewValue("float", "1"), NewValue("float", "2")}), point)
+}
+
+func TestInterpreterFormatValue(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddStructType(context, "Point", []Field{NewField("x", "int"), NewField("y", "int")})
+	assert.NoError(t, err)
+
+	err = interpreter.AddStructType(context, "Label", []Field{NewField("text", "string"), NewField("position", "Point")})
+	assert.NoError(t, err)
+
+	text, err := interpreter.FormatValue(context, NewValue("int", "10"))
+	assert.NoError(t, err)
+	assert.Equal(t, "10", text)
+
+	point := NewStructValue("Point", []Value{NewValue("int", "1"), NewValue("int", "2")})
+	text, err = interpreter.FormatValue(context, NewStructValue("Label", []Value{NewValue("string", `"here"`), point}))
+	assert.NoError(t, err)
+	assert.Equal(t, `Label{text: "here", position: Point{x: 1, y: 2}}`, text)
+}
+
+func TestInterpreterResolveStructBinaryOperations(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddStructType(context, "Point", []Field{NewField("x", "float"), NewField("y", "float")})
+	assert.NoError(t, err)
+
+	err = interpreter.AddStructType(context, "Label", []Field{NewField("text", "string"), NewField("position", "Point")})
+	assert.NoError(t, err)
+
+	point := func(x, y string) Value {
+		return NewStructValue("Point", []Value{NewValue("float", x), NewValue("float", y)})
+	}
+
+	label := func(text string, position Value) Value {
+		return NewStructValue("Label", []Value{NewValue("string", quoteString(text)), position})
+	}
+
+	tests := []struct {
+		name     string
+		left     Value
+		right    Value
+		operator string
+		expected Value
+		err      error
+	}{
+		{name: "equal", left: point("1", "2"), right: point("1.0", "2"), operator: "==", expected: NewValue("bool", "true")},
+		{name: "not equal", left: point("1", "2"), right: point("1", "3"), operator: "==", expected: NewValue("bool", "false")},
+		{name: "!= equal", left: point("1", "2"), right: point("1", "2"), operator: "!=", expected: NewValue("bool", "false")},
+		{name: "!= not equal", left: point("1", "2"), right: point("2", "2"), operator: "!=", expected: NewValue("bool", "true")},
+		{name: "nested equal", left: label("a", point("1", "2")), right: label("a", point("1", "2")), operator: "==", expected: NewValue("bool", "true")},
+		{name: "nested not equal", left: label("a", point("1", "2")), right: label("a", point("1", "0")), operator: "==", expected: NewValue("bool", "false")},
+		{name: "ordering", left: point("1", "2"), right: point("1", "2"), operator: "<", err: InvalidOperationErr{TypeNames: []string{"Point", "Point"}}},
+		{name: "arithmetic", left: point("1", "2"), right: point("1", "2"), operator: "+", err: InvalidOperationErr{TypeNames: []string{"Point", "Point"}}},
+		{name: "mismatched types", left: point("1", "2"), right: label("a", point("1", "2")), operator: "==", err: InvalidOperationErr{TypeNames: []string{"Point", "Label"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := interpreter.ResolveBinaryOperations(context, context, test.left, test.right, test.operator)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				assert.Equal(t, NewErrorValue(err), value)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestInterpreterGetCompareFunction(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddStructType(context, "Point", []Field{NewField("x", "int"), NewField("y", "int")})
+	assert.NoError(t, err)
+
+	err = interpreter.AddStructType(context, "Size", []Field{NewField("width", "int"), NewField("height", "int")})
+	assert.NoError(t, err)
+
+	// A function that happens to be named after the type doesn't order it
+	compare := NewFunction("comparePoint", []Parameter{NewParameter("a", "Point"), NewParameter("b", "Point")}, "int", nil)
+	err = interpreter.AddFunction(context, compare)
+	assert.NoError(t, err)
+
+	_, ok, err := interpreter.GetCompareFunction(context, "Point")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	t.Run("not a struct or union", func(t *testing.T) {
+		err := interpreter.SetCompareFunction(context, "int", "comparePoint")
+		assert.EqualError(t, err, InvalidOperationErr{TypeNames: []string{"int"}}.Error())
+	})
+
+	t.Run("unknown function", func(t *testing.T) {
+		err := interpreter.AddStructType(context, "Line", []Field{NewField("length", "int")})
+		assert.NoError(t, err)
+
+		err = interpreter.SetCompareFunction(context, "Line", "compareLine")
+		assert.NoError(t, err)
+
+		_, _, err = interpreter.GetCompareFunction(context, "Line")
+		assert.EqualError(t, err, UnknownFunctionErr{FuncName: "compareLine"}.Error())
+	})
+
+	t.Run("wrong signature", func(t *testing.T) {
+		// Comparison functions must take two values of the type and return an int
+		err := interpreter.AddFunction(context, NewFunction("compareSize", []Parameter{NewParameter("a", "Size"), NewParameter("b", "Size")}, "bool", nil))
+		assert.NoError(t, err)
+
+		err = interpreter.SetCompareFunction(context, "Size", "compareSize")
+		assert.NoError(t, err)
+
+		_, _, err = interpreter.GetCompareFunction(context, "Size")
+		assert.EqualError(t, err, InvalidCompareFunctionErr{TypeName: "Size", FuncName: "compareSize"}.Error())
+	})
+
+	err = interpreter.SetCompareFunction(context, "Point", "comparePoint")
+	assert.NoError(t, err)
+
+	function, ok, err := interpreter.GetCompareFunction(context, "Point")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, compare, function)
+}
@@ -21,12 +21,67 @@ const (
 
 	// TypeInfoString says that a type is a string.
 	TypeInfoString TypeInfo = 5
+
+	// TypeInfoStruct says that a type is a user-defined struct.
+	TypeInfoStruct TypeInfo = 6
+
+	// TypeInfoArray says that a type is a fixed-size array.
+	TypeInfoArray TypeInfo = 7
+
+	// TypeInfoList says that a type is a growable list.
+	TypeInfoList TypeInfo = 8
+
+	// TypeInfoMap says that a type is a map from keys to values.
+	TypeInfoMap TypeInfo = 9
+
+	// TypeInfoEnum says that a type is a user-defined enumeration of named members.
+	TypeInfoEnum TypeInfo = 10
+
+	// TypeInfoUnion says that a type is a user-defined tagged union of variants.
+	TypeInfoUnion TypeInfo = 11
+
+	// TypeInfoFunction says that a type is a function signature.
+	TypeInfoFunction TypeInfo = 12
 )
 
+// Field is a named, typed member of a struct type.
+type Field struct {
+	name     string
+	typeName string
+}
+
+// NewField returns a new instance of a field.
+func NewField(name string, typeName string) Field {
+	return Field{
+		name:     name,
+		typeName: typeName,
+	}
+}
+
+// Name returns the name of the field.
+func (f Field) Name() string {
+	return f.name
+}
+
+// TypeName returns the name of the field's type.
+func (f Field) TypeName() string {
+	return f.typeName
+}
+
 // TypeData stores common type data, such as the types zero value and casting information.
 type TypeData struct {
 	zeroValue       Value
 	typeInfo        TypeInfo
+	bitSize         int
+	fields          []Field
+	members         []string
+	variants        []Variant
+	keyTypeName     string
+	elementTypeName string
+	length          int
+	paramTypeNames  []string
+	returnTypeName  string
+	compareName     string
 	implicitCastMap map[string]struct{}
 }
 
@@ -44,9 +99,90 @@ func (t TypeData) GetTypeName() string {
 	return t.zeroValue.typeName
 }
 
+// BitSize returns the number of bits that a numeric type is stored in, or 0 if the type isn't numeric.
+func (t TypeData) BitSize() int {
+	return t.bitSize
+}
+
+// Fields returns the fields of a struct type in declaration order.
+func (t TypeData) Fields() []Field {
+	return t.fields
+}
+
+// FieldIndex returns the position of the field with the given name in a struct type,
+// or false if the type has no such field.
+func (t TypeData) FieldIndex(fieldName string) (int, bool) {
+	for i, field := range t.fields {
+		if field.name == fieldName {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Members returns the names of the members of an enum type in declaration order.
+func (t TypeData) Members() []string {
+	return t.members
+}
+
+// MemberIndex returns the position of the member with the given name in an enum type,
+// or false if the type has no such member.
+func (t TypeData) MemberIndex(memberName string) (int, bool) {
+	for i, member := range t.members {
+		if member == memberName {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// Variants returns the variants of a union type in declaration order.
+func (t TypeData) Variants() []Variant {
+	return t.variants
+}
+
+// VariantIndex returns the position of the variant with the given name in a union type,
+// or false if the type has no such variant.
+func (t TypeData) VariantIndex(variantName string) (int, bool) {
+	for i, variant := range t.variants {
+		if variant.name == variantName {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// KeyTypeName returns the name of the type of a map's keys.
+func (t TypeData) KeyTypeName() string {
+	return t.keyTypeName
+}
+
+// ElementTypeName returns the name of the type of an array's or list's elements, or of a map's values.
+func (t TypeData) ElementTypeName() string {
+	return t.elementTypeName
+}
+
+// Length returns the number of elements in an array type.
+func (t TypeData) Length() int {
+	return t.length
+}
+
+// ParamTypeNames returns the names of the parameter types of a function type in declaration order.
+func (t TypeData) ParamTypeNames() []string {
+	return t.paramTypeNames
+}
+
+// ReturnTypeName returns the name of the type that a function type returns.
+func (t TypeData) ReturnTypeName() string {
+	return t.returnTypeName
+}
+
 // IsEmpty checks if the TypeData is empty, representing no type data.
 func (t TypeData) IsEmpty() bool {
-	return t.zeroValue == NewValue("", "") && t.typeInfo == TypeInfoNone
+	return t.zeroValue.IsEmpty() && t.typeInfo == TypeInfoNone
 }
 
 // AddImplicitCast adds an implicit cast from this type to the given type.
@@ -97,3 +233,76 @@ func (t TypeData) IsBool() bool {
 func (t TypeData) IsString() bool {
 	return t.typeInfo == TypeInfoString
 }
+
+// IsStruct returns true if the type is a struct.
+func (t TypeData) IsStruct() bool {
+	return t.typeInfo == TypeInfoStruct
+}
+
+// IsArray returns true if the type is an array.
+func (t TypeData) IsArray() bool {
+	return t.typeInfo == TypeInfoArray
+}
+
+// IsList returns true if the type is a list.
+func (t TypeData) IsList() bool {
+	return t.typeInfo == TypeInfoList
+}
+
+// IsMap returns true if the type is a map.
+func (t TypeData) IsMap() bool {
+	return t.typeInfo == TypeInfoMap
+}
+
+// IsEnum returns true if the type is an enum.
+func (t TypeData) IsEnum() bool {
+	return t.typeInfo == TypeInfoEnum
+}
+
+// IsUnion returns true if the type is a union.
+func (t TypeData) IsUnion() bool {
+	return t.typeInfo == TypeInfoUnion
+}
+
+// IsFunction returns true if the type is a function type.
+func (t TypeData) IsFunction() bool {
+	return t.typeInfo == TypeInfoFunction
+}
+
+// Helper function to return the type names of the values held by a struct, array, list or map, in order.
+// Lists and maps can hold any number of values, so the number of values they hold must be given.
+// Maps hold each of their keys followed by its value.
+func (t TypeData) itemTypeNames(listLength int) []string {
+	if t.IsMap() {
+		typeNames := make([]string, listLength-listLength%2)
+		for i := range typeNames {
+			typeNames[i] = t.keyTypeName
+			if i%2 == 1 {
+				typeNames[i] = t.elementTypeName
+			}
+		}
+
+		return typeNames
+	}
+
+	if t.IsArray() || t.IsList() {
+		length := t.length
+		if t.IsList() {
+			length = listLength
+		}
+
+		typeNames := make([]string, length)
+		for i := range typeNames {
+			typeNames[i] = t.elementTypeName
+		}
+
+		return typeNames
+	}
+
+	typeNames := make([]string, len(t.fields))
+	for i, field := range t.fields {
+		typeNames[i] = field.typeName
+	}
+
+	return typeNames
+}
@@ -17,6 +17,19 @@ func TestTypeDataGetTypeName(t *testing.T) {
 	assert.Equal(t, "int", typeName)
 }
 
+func TestTypeDataBitSize(t *testing.T) {
+	types := getBasicTypes()
+
+	assert.Equal(t, 8, types["int8"].BitSize())
+	assert.Equal(t, 32, types["int"].BitSize())
+	assert.Equal(t, 8, types["byte"].BitSize())
+	assert.Equal(t, 64, types["uint64"].BitSize())
+	assert.Equal(t, 32, types["float"].BitSize())
+	assert.Equal(t, 64, types["float64"].BitSize())
+	assert.Equal(t, 0, types["bool"].BitSize())
+	assert.Equal(t, 0, NewTypeData("custom", "", TypeInfoNone).BitSize())
+}
+
 func TestTypeDataIsEmpy(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		typeData := NewTypeData("", "", TypeInfoNone)
@@ -0,0 +1,217 @@
+package interpreter
+
+import "fmt"
+
+// Variant is one of the named alternatives of a union type, holding its own fields.
+type Variant struct {
+	name   string
+	fields []Field
+}
+
+// NewVariant returns a new instance of a union variant.
+func NewVariant(name string, fields []Field) Variant {
+	return Variant{
+		name:   name,
+		fields: fields,
+	}
+}
+
+// Name returns the name of the variant.
+func (v Variant) Name() string {
+	return v.name
+}
+
+// Fields returns the fields of the variant in declaration order.
+func (v Variant) Fields() []Field {
+	return v.fields
+}
+
+// AddUnionType declares a new union type made up of the given variants.
+// A union value holds the name of one of its type's variants along with that variant's field values.
+// Variants are constructed by calling them by name, so variant names can't be used by any other type, function or variant.
+// Variant fields can be of the union type itself, so the type's zero value is its first variant that doesn't hold itself.
+func (interpreter *SimInterpreter) AddUnionType(context ParseContext, typeName string, variants []Variant) error {
+	if err := interpreter.checkTypeName(context, typeName); err != nil {
+		return err
+	}
+
+	variantNames := make(map[string]struct{})
+
+	for _, variant := range variants {
+		if err := interpreter.checkTypeName(context, variant.name); err != nil {
+			return err
+		}
+
+		if _, ok := variantNames[variant.name]; ok || variant.name == typeName {
+			return VariantExistsErr{Context: context, VariantName: variant.name}
+		}
+
+		variantNames[variant.name] = struct{}{}
+	}
+
+	// The type is declared before its fields are checked so that variants can hold the union itself
+	interpreter.types[typeName] = TypeData{
+		typeInfo:        TypeInfoUnion,
+		variants:        variants,
+		implicitCastMap: map[string]struct{}{},
+	}
+
+	zeroValue, err := interpreter.unionZeroValue(context, typeName, variants)
+	if err != nil {
+		delete(interpreter.types, typeName)
+		return err
+	}
+
+	typeData := interpreter.types[typeName]
+	typeData.zeroValue = zeroValue
+	interpreter.types[typeName] = typeData
+
+	for _, variant := range variants {
+		interpreter.variants[variant.name] = typeName
+	}
+
+	return nil
+}
+
+// GetVariant returns the type data of the union type that the named variant belongs to,
+// along with the variant itself, or false if there is no variant with that name.
+func (interpreter *SimInterpreter) GetVariant(variantName string) (TypeData, Variant, bool) {
+	typeName, ok := interpreter.variants[variantName]
+	if !ok {
+		return TypeData{}, Variant{}, false
+	}
+
+	typeData := interpreter.types[typeName]
+
+	index, _ := typeData.VariantIndex(variantName)
+	return typeData, typeData.variants[index], true
+}
+
+// ConstructVariant returns a new value of the union type that the named variant belongs to,
+// holding the given field values in declaration order. Each value's parse context is used to report a mismatched field type.
+func (interpreter *SimInterpreter) ConstructVariant(context ParseContext, variantName string, values []Value, valueContexts []ParseContext) (Value, error) {
+	typeData, variant, ok := interpreter.GetVariant(variantName)
+	if !ok {
+		err := UnknownFunctionErr{Context: context, FuncName: variantName}
+		return NewErrorValue(err), err
+	}
+
+	if len(values) != len(variant.fields) {
+		err := MismatchedArgCountErr{Context: context, FuncName: variantName, Expected: len(variant.fields), Actual: len(values)}
+		return NewErrorValue(err), err
+	}
+
+	// Variants without fields don't hold any values, so that they print as just their name
+	var fields []Value
+	for i, field := range variant.fields {
+		value, err := interpreter.castField(valueContexts[i], variantName, field, values[i])
+		if err != nil {
+			return NewErrorValue(err), err
+		}
+
+		fields = append(fields, value)
+	}
+
+	return NewUnionValue(typeData.GetTypeName(), variantName, fields), nil
+}
+
+// CheckPattern returns an error if a match case can never match values of the given enum or union type.
+// The case must name one of the type's enum members or union variants,
+// and it must bind exactly as many variables as the variant has fields. Enum members don't have any fields.
+func (interpreter *SimInterpreter) CheckPattern(context ParseContext, typeName string, caseName string, bindingCount int) error {
+	typeData, err := interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return err
+	}
+
+	fieldCount := 0
+
+	switch {
+	case typeData.IsEnum():
+		if _, ok := typeData.MemberIndex(caseName); !ok {
+			return UnknownMemberErr{Context: context, TypeName: typeName, MemberName: caseName}
+		}
+
+	case typeData.IsUnion():
+		index, ok := typeData.VariantIndex(caseName)
+		if !ok {
+			return UnknownVariantErr{Context: context, TypeName: typeName, VariantName: caseName}
+		}
+
+		fieldCount = len(typeData.variants[index].fields)
+
+	default:
+		return InvalidOperationErr{Context: context, TypeNames: []string{typeName}}
+	}
+
+	if bindingCount != fieldCount {
+		return MismatchedBindingCountErr{Context: context, CaseName: caseName, Expected: fieldCount, Actual: bindingCount}
+	}
+
+	return nil
+}
+
+// Union values are equal when they are the same variant and all of their field values are equal.
+// Unions can't be used with any other operator.
+func (interpreter *SimInterpreter) handleUnionBinaryOperations(leftContext, rightContext ParseContext, leftVal, rightVal Value, typeName string, operator string) (Value, error) {
+	if leftVal.data != rightVal.data && (operator == "==" || operator == "!=") {
+		return NewValue("bool", fmt.Sprintf("%t", operator == "!=")), nil
+	}
+
+	return interpreter.handleCompositeBinaryOperations(leftContext, rightContext, leftVal, rightVal, typeName, operator)
+}
+
+// Helper function to check the fields of each variant of a union type and return the type's zero value,
+// which is its first variant that doesn't hold the union itself with each of its fields set to their zero value.
+func (interpreter *SimInterpreter) unionZeroValue(context ParseContext, typeName string, variants []Variant) (Value, error) {
+	var zeroValue Value
+
+	for _, variant := range variants {
+		fieldNames := make(map[string]struct{})
+		recursive := false
+
+		var fields []Value
+		for _, field := range variant.fields {
+			fieldTypeData, err := interpreter.GetTypeData(context, field.typeName)
+			if err != nil {
+				return Value{}, err
+			}
+
+			if _, ok := fieldNames[field.name]; ok {
+				return Value{}, FieldExistsErr{Context: context, TypeName: variant.name, FieldName: field.name}
+			}
+
+			fieldNames[field.name] = struct{}{}
+			recursive = recursive || field.typeName == typeName
+			fields = append(fields, fieldTypeData.zeroValue)
+		}
+
+		if zeroValue.IsEmpty() && !recursive {
+			zeroValue = NewUnionValue(typeName, variant.name, fields)
+		}
+	}
+
+	if zeroValue.IsEmpty() {
+		return Value{}, RecursiveTypeErr{Context: context, TypeName: typeName}
+	}
+
+	return zeroValue, nil
+}
+
+// Helper function to check that a new type or variant name isn't already used by a type, function or variant.
+func (interpreter *SimInterpreter) checkTypeName(context ParseContext, name string) error {
+	if _, ok := interpreter.types[name]; ok {
+		return TypeExistsErr{Context: context, TypeName: name}
+	}
+
+	// Calling a type or variant constructs it, so a function with the same name could never be called
+	if _, ok := interpreter.functions[name]; ok {
+		return FunctionExistsErr{Context: context, FuncName: name}
+	}
+
+	if _, ok := interpreter.variants[name]; ok {
+		return VariantExistsErr{Context: context, VariantName: name}
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterpreterAddUnionType(t *testing.T) {
+	context := NewParseContext(0, 0)
+
+	shape := []Variant{
+		NewVariant("Circle", []Field{NewField("r", "float")}),
+		NewVariant("Rect", []Field{NewField("w", "float"), NewField("h", "float")}),
+		NewVariant("Empty", nil),
+	}
+
+	t.Run("type exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddUnionType(context, "int", shape)
+		assert.EqualError(t, err, TypeExistsErr{TypeName: "int"}.Error())
+	})
+
+	t.Run("variant exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddUnionType(context, "Shape", append(shape, NewVariant("Circle", nil)))
+		assert.EqualError(t, err, VariantExistsErr{VariantName: "Circle"}.Error())
+		assert.NotContains(t, interpreter.types, "Shape")
+
+		err = interpreter.AddUnionType(context, "Shape", shape)
+		assert.NoError(t, err)
+
+		err = interpreter.AddUnionType(context, "Solid", []Variant{NewVariant("Cube", nil), NewVariant("Circle", nil)})
+		assert.EqualError(t, err, VariantExistsErr{VariantName: "Circle"}.Error())
+
+		err = interpreter.AddStructType(context, "Rect", nil)
+		assert.EqualError(t, err, VariantExistsErr{VariantName: "Rect"}.Error())
+
+		err = interpreter.AddFunction(context, NewFunction("Empty", nil, "int", nil))
+		assert.EqualError(t, err, VariantExistsErr{VariantName: "Empty"}.Error())
+	})
+
+	t.Run("field exists", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddUnionType(context, "Shape", []Variant{NewVariant("Rect", []Field{NewField("w", "float"), NewField("w", "int")})})
+		assert.EqualError(t, err, FieldExistsErr{TypeName: "Rect", FieldName: "w"}.Error())
+		assert.NotContains(t, interpreter.types, "Shape")
+	})
+
+	t.Run("unknown field type", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddUnionType(context, "Shape", []Variant{NewVariant("Circle", []Field{NewField("r", "real")})})
+		assert.EqualError(t, err, UnknownTypeErr{TypeName: "real"}.Error())
+		assert.NotContains(t, interpreter.variants, "Circle")
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		interpreter := NewSimInterpreter(nil)
+
+		err := interpreter.AddUnionType(context, "Tree", []Variant{NewVariant("Node", []Field{NewField("left", "Tree"), NewField("right", "Tree")})})
+		assert.EqualError(t, err, RecursiveTypeErr{TypeName: "Tree"}.Error())
+
+		tree := []Variant{
+			NewVariant("Node", []Field{NewField("left", "Tree"), NewField("value", "int"), NewField("right", "Tree")}),
+			NewVariant("Leaf", nil),
+		}
+
+		err = interpreter.AddUnionType(context, "Tree", tree)
+		assert.NoError(t, err)
+
+		typeData, err := interpreter.GetTypeData(context, "Tree")
+		assert.NoError(t, err)
+		assert.Equal(t, NewUnionValue("Tree", "Leaf", nil), typeData.zeroValue)
+
+		// Trees hold themselves, but they can still be used as map keys
+		_, err = interpreter.GetTypeData(context, "map[Tree]int")
+		assert.NoError(t, err)
+	})
+
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddUnionType(context, "Shape", shape)
+	assert.NoError(t, err)
+
+	typeData, err := interpreter.GetTypeData(context, "Shape")
+	assert.NoError(t, err)
+	assert.True(t, typeData.IsUnion())
+	assert.Equal(t, shape, typeData.Variants())
+	assert.Equal(t, NewUnionValue("Shape", "Circle", []Value{NewValue("float", "0.0")}), typeData.zeroValue)
+
+	index, ok := typeData.VariantIndex("Empty")
+	assert.True(t, ok)
+	assert.Equal(t, 2, index)
+
+	_, ok = typeData.VariantIndex("Square")
+	assert.False(t, ok)
+
+	unionTypeData, variant, ok := interpreter.GetVariant("Rect")
+	assert.True(t, ok)
+	assert.Equal(t, typeData, unionTypeData)
+	assert.Equal(t, shape[1], variant)
+
+	_, _, ok = interpreter.GetVariant("Square")
+	assert.False(t, ok)
+}
+
+func TestInterpreterConstructVariant(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddUnionType(context, "Shape", []Variant{
+		NewVariant("Rect", []Field{NewField("w", "float"), NewField("h", "float")}),
+		NewVariant("Empty", nil),
+	})
+	assert.NoError(t, err)
+
+	contexts := []ParseContext{context, context}
+
+	t.Run("mismatched arg count", func(t *testing.T) {
+		value, err := interpreter.ConstructVariant(context, "Rect", []Value{NewValue("float", "1")}, contexts[:1])
+		expectedErr := MismatchedArgCountErr{FuncName: "Rect", Expected: 2, Actual: 1}
+		assert.EqualError(t, err, expectedErr.Error())
+		assert.Equal(t, NewErrorValue(expectedErr), value)
+	})
+
+	t.Run("mismatched field type", func(t *testing.T) {
+		_, err := interpreter.ConstructVariant(context, "Rect", []Value{NewValue("float", "1"), NewValue("bool", "true")}, contexts)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown variant", func(t *testing.T) {
+		_, err := interpreter.ConstructVariant(context, "Square", nil, nil)
+		assert.EqualError(t, err, UnknownFunctionErr{FuncName: "Square"}.Error())
+	})
+
+	value, err := interpreter.ConstructVariant(context, "Rect", []Value{NewValue("untyped int", "2"), NewValue("float", "1.5")}, contexts)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUnionValue("Shape", "Rect", []Value{NewValue("float", "2"), NewValue("float", "1.5")}), value)
+	assert.Equal(t, "Rect{2, 1.5}", value.String())
+
+	text, err := interpreter.FormatValue(context, value)
+	assert.NoError(t, err)
+	assert.Equal(t, "Rect{w: 2, h: 1.5}", text)
+
+	value, err = interpreter.ConstructVariant(context, "Empty", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, NewUnionValue("Shape", "Empty", nil), value)
+	assert.Equal(t, "Empty", value.String())
+
+	text, err = interpreter.FormatValue(context, value)
+	assert.NoError(t, err)
+	assert.Equal(t, "Empty", text)
+}
+
+func TestInterpreterCheckPattern(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddUnionType(context, "Shape", []Variant{
+		NewVariant("Circle", []Field{NewField("r", "float")}),
+		NewVariant("Empty", nil),
+	})
+	assert.NoError(t, err)
+
+	err = interpreter.AddEnumType(context, "Color", []string{"Red", "Green"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		typeName     string
+		caseName     string
+		bindingCount int
+		err          error
+	}{
+		{name: "variant", typeName: "Shape", caseName: "Circle", bindingCount: 1},
+		{name: "fieldless variant", typeName: "Shape", caseName: "Empty", bindingCount: 0},
+		{name: "member", typeName: "Color", caseName: "Green", bindingCount: 0},
+		{name: "unknown variant", typeName: "Shape", caseName: "Square", err: UnknownVariantErr{TypeName: "Shape", VariantName: "Square"}},
+		{name: "unknown member", typeName: "Color", caseName: "Blue", err: UnknownMemberErr{TypeName: "Color", MemberName: "Blue"}},
+		{name: "too few bindings", typeName: "Shape", caseName: "Circle", bindingCount: 0, err: MismatchedBindingCountErr{CaseName: "Circle", Expected: 1, Actual: 0}},
+		{name: "member bindings", typeName: "Color", caseName: "Red", bindingCount: 1, err: MismatchedBindingCountErr{CaseName: "Red", Expected: 0, Actual: 1}},
+		{name: "not a union", typeName: "int", caseName: "Circle", err: InvalidOperationErr{TypeNames: []string{"int"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := interpreter.CheckPattern(context, test.typeName, test.caseName, test.bindingCount)
+			if test.err != nil {
+				assert.EqualError(t, err, test.err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	err = interpreter.CheckExhaustive(context, "Shape", []string{"Empty"})
+	assert.EqualError(t, err, MissingMembersErr{TypeName: "Shape", MemberNames: []string{"Circle"}}.Error())
+
+	err = interpreter.CheckExhaustive(context, "Shape", []string{"Empty", "Circle"})
+	assert.NoError(t, err)
+}
+
+func TestInterpreterResolveUnionBinaryOperations(t *testing.T) {
+	context := NewParseContext(0, 0)
+	interpreter := NewSimInterpreter(nil)
+
+	err := interpreter.AddUnionType(context, "Shape", []Variant{
+		NewVariant("Circle", []Field{NewField("r", "float")}),
+		NewVariant("Empty", nil),
+	})
+	assert.NoError(t, err)
+
+	small := NewUnionValue("Shape", "Circle", []Value{NewValue("float", "1")})
+	large := NewUnionValue("Shape", "Circle", []Value{NewValue("float", "2")})
+	empty := NewUnionValue("Shape", "Empty", nil)
+
+	tests := []struct {
+		left     Value
+		right    Value
+		operator string
+		expected Value
+		err      error
+	}{
+		{left: small, right: small, operator: "==", expected: NewValue("bool", "true")},
+		{left: small, right: large, operator: "==", expected: NewValue("bool", "false")},
+		{left: small, right: empty, operator: "==", expected: NewValue("bool", "false")},
+		{left: small, right: empty, operator: "!=", expected: NewValue("bool", "true")},
+		{left: empty, right: empty, operator: "==", expected: NewValue("bool", "true")},
+		{left: small, right: large, operator: "<", err: InvalidOperationErr{TypeNames: []string{"Shape", "Shape"}}},
+	}
+
+	for _, test := range tests {
+		value, err := interpreter.ResolveBinaryOperations(context, context, test.left, test.right, test.operator)
+		if test.err != nil {
+			assert.EqualError(t, err, test.err.Error())
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, value)
+	}
+}
@@ -1,11 +1,17 @@
 package interpreter
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 // Value represents any Sim value. It is useful for translating Sim types to Go types.
 type Value struct {
 	typeName string
 	data     string
+	items    []Value
+	keys     map[string]int
+	function *Function
 	err      error
 }
 
@@ -17,6 +23,59 @@ func NewValue(typeName string, data string) Value {
 	}
 }
 
+// NewStructValue returns a new Value of a struct type holding the given field values in declaration order.
+func NewStructValue(typeName string, fields []Value) Value {
+	return Value{
+		typeName: typeName,
+		items:    fields,
+	}
+}
+
+// NewArrayValue returns a new Value of an array type holding the given elements.
+func NewArrayValue(typeName string, elements []Value) Value {
+	return Value{
+		typeName: typeName,
+		items:    elements,
+	}
+}
+
+// NewListValue returns a new Value of a list type holding the given elements.
+func NewListValue(typeName string, elements []Value) Value {
+	return Value{
+		typeName: typeName,
+		items:    elements,
+	}
+}
+
+// NewMapValue returns a new Value of a map type holding the given entries in insertion order.
+// The entries are stored as each key followed by its value, and each key is indexed so it can be found without comparing it to every key.
+func NewMapValue(typeName string, entries []Value) Value {
+	return Value{
+		typeName: typeName,
+		items:    entries,
+		keys:     newMapIndex(entries),
+	}
+}
+
+// NewUnionValue returns a new Value of a union type holding the named variant and its field values in declaration order.
+func NewUnionValue(typeName string, variantName string, fields []Value) Value {
+	return Value{
+		typeName: typeName,
+		data:     variantName,
+		items:    fields,
+	}
+}
+
+// NewFunctionValue returns a new Value of a function type that calls the given function.
+// The value's data is the name of the function.
+func NewFunctionValue(typeName string, function Function) Value {
+	return Value{
+		typeName: typeName,
+		data:     function.name,
+		function: &function,
+	}
+}
+
 // NewErrorValue returns a new Value type wrapping the given error.
 func NewErrorValue(err error) Value {
 	return Value{
@@ -34,6 +93,12 @@ func GetTypeFromLiteral(context ParseContext, literal string) string {
 			return "untyped int"
 		}
 
+		// Integer literals above the range of int64 can still be used as a uint64
+		_, err = NewValue("uint", literal).GetUint(context)
+		if err == nil {
+			return "untyped int"
+		}
+
 		_, err = NewValue("float", literal).GetFloat(context)
 		if err == nil {
 			return "untyped float"
@@ -44,16 +109,17 @@ func GetTypeFromLiteral(context ParseContext, literal string) string {
 	// so use the provided context to check if the associated type
 	// is the correct integer type, and if so, return that type.
 	// Otherwise, return an "untyped int", which the caller can deal with.
+	// The literal must also fit in the width of that type.
 	if context.TypeData.IsUnsignedInteger() || context.TypeData.IsSignedInteger() {
-		_, err := NewValue("uint", literal).GetUint(context)
-		if err == nil {
+		num, err := NewValue("uint", literal).GetUint(context)
+		if err == nil && fitsUnsigned(num, context.TypeData.bitSize) {
 			if context.TypeData.IsUnsignedInteger() {
 				return context.TypeData.zeroValue.typeName
 			}
 		}
 
-		_, err = NewValue("int", literal).GetInt(context)
-		if err == nil {
+		signed, err := NewValue("int", literal).GetInt(context)
+		if err == nil && fitsSigned(signed, context.TypeData.bitSize) {
 			if context.TypeData.IsSignedInteger() {
 				return context.TypeData.zeroValue.typeName
 			}
@@ -65,8 +131,8 @@ func GetTypeFromLiteral(context ParseContext, literal string) string {
 	// is the correct floating point type, and if so, return that type.
 	// Otherwise, return an "untyped float", which the caller can deal with.
 	if context.TypeData.IsFloatingPoint() {
-		_, err := NewValue("float", literal).GetFloat(context)
-		if err == nil {
+		num, err := NewValue("float", literal).GetFloat(context)
+		if err == nil && fitsFloat(num, context.TypeData.bitSize) {
 			if context.TypeData.IsFloatingPoint() {
 				return context.TypeData.zeroValue.typeName
 			}
@@ -96,6 +162,97 @@ func (v Value) GetType() (string, error) {
 	return v.typeName, nil
 }
 
+// IsEmpty checks if the value is empty, representing no value at all.
+func (v Value) IsEmpty() bool {
+	return v.typeName == "" && v.data == "" && v.items == nil && v.err == nil
+}
+
+// GetFields returns a copy of the field values of a struct value in declaration order,
+// or the error if the value is storing an error.
+func (v Value) GetFields() ([]Value, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+
+	fields := make([]Value, len(v.items))
+	copy(fields, v.items)
+
+	return fields, nil
+}
+
+// GetElements returns a copy of the elements of an array or list value,
+// or the error if the value is storing an error.
+func (v Value) GetElements() ([]Value, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+
+	elements := make([]Value, len(v.items))
+	copy(elements, v.items)
+
+	return elements, nil
+}
+
+// GetEntries returns copies of the keys and values of a map value in insertion order,
+// or the error if the value is storing an error.
+func (v Value) GetEntries() ([]Value, []Value, error) {
+	if v.err != nil {
+		return nil, nil, v.err
+	}
+
+	keys := make([]Value, len(v.items)/2)
+	values := make([]Value, len(v.items)/2)
+
+	for i := range keys {
+		keys[i] = v.items[2*i]
+		values[i] = v.items[2*i+1]
+	}
+
+	return keys, values, nil
+}
+
+// String returns the value's data as text. The values held by arrays and lists are written out as a list,
+// such as [1, 2, 3], the values held by structs are written out in declaration order, such as Point{1, 2},
+// the entries of maps are written out in insertion order, such as {"a": 1, "b": 2},
+// and union values are written out as their variant followed by its fields, such as Circle{1.5}.
+func (v Value) String() string {
+	if v.err != nil {
+		return v.err.Error()
+	}
+
+	if v.items == nil {
+		return v.data
+	}
+
+	items := make([]string, len(v.items))
+	for i, item := range v.items {
+		items[i] = item.String()
+	}
+
+	// Union values hold the name of their variant along with its fields
+	if v.data != "" {
+		return v.data + "{" + strings.Join(items, ", ") + "}"
+	}
+
+	if _, _, isMap := parseMapTypeName(v.typeName); isMap || v.typeName == "untyped map" {
+		entries := make([]string, len(v.items)/2)
+		for i := range entries {
+			entries[i] = items[2*i] + ": " + items[2*i+1]
+		}
+
+		return "{" + strings.Join(entries, ", ") + "}"
+	}
+
+	_, _, isArray := parseArrayTypeName(v.typeName)
+	_, isList := parseListTypeName(v.typeName)
+
+	if isArray || isList || v.typeName == "untyped array" {
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+
+	return v.typeName + "{" + strings.Join(items, ", ") + "}"
+}
+
 // GetRawData returns the value's raw data, or the error
 // if the value is storing an error.
 func (v Value) GetRawData() (string, error) {
@@ -106,7 +263,7 @@ func (v Value) GetRawData() (string, error) {
 	return v.data, nil
 }
 
-// GetString returns the value as a Go string,
+// GetString returns the value as a Go string without the surrounding quotes,
 // or returns an error if the data is not a string type.
 func (v Value) GetString(context ParseContext) (string, error) {
 	if v.err != nil {
@@ -123,39 +280,41 @@ func (v Value) GetString(context ParseContext) (string, error) {
 		return "", v.err
 	}
 
-	return v.data, nil
+	return v.data[1 : len(v.data)-1], nil
 }
 
-// GetInt returns the value as a Go int32,
+// GetInt returns the value as a Go int64,
 // or returns an error if the data is not an integer type.
-func (v Value) GetInt(context ParseContext) (int32, error) {
+// Narrower integer types always hold data that fits in their width.
+func (v Value) GetInt(context ParseContext) (int64, error) {
 	if v.err != nil {
 		return 0, v.err
 	}
 
-	num, err := strconv.ParseInt(v.data, 10, 32)
+	num, err := strconv.ParseInt(v.data, 10, 64)
 	if err != nil {
 		v.err = DataTypeErr{Context: context, TypeName: v.typeName}
 		return 0, v.err
 	}
 
-	return int32(num), nil
+	return num, nil
 }
 
-// GetUint returns the value as a Go uint32,
-// or returns an error if the data is not an integer type.
-func (v Value) GetUint(context ParseContext) (uint32, error) {
+// GetUint returns the value as a Go uint64,
+// or returns an error if the data is not an unsigned integer type.
+// Narrower integer types always hold data that fits in their width.
+func (v Value) GetUint(context ParseContext) (uint64, error) {
 	if v.err != nil {
 		return 0, v.err
 	}
 
-	num, err := strconv.ParseUint(v.data, 10, 32)
+	num, err := strconv.ParseUint(v.data, 10, 64)
 	if err != nil {
 		v.err = DataTypeErr{Context: context, TypeName: v.typeName}
 		return 0, v.err
 	}
 
-	return uint32(num), nil
+	return num, nil
 }
 
 // GetByte returns the value as a Go byte,
@@ -165,7 +324,7 @@ func (v Value) GetByte(context ParseContext) (byte, error) {
 		return 0, v.err
 	}
 
-	num, err := strconv.ParseInt(v.data, 10, 8)
+	num, err := strconv.ParseUint(v.data, 10, 8)
 	if err != nil {
 		v.err = DataTypeErr{Context: context, TypeName: v.typeName}
 		return 0, v.err
@@ -174,20 +333,21 @@ func (v Value) GetByte(context ParseContext) (byte, error) {
 	return byte(num), nil
 }
 
-// GetFloat returns the value as a Go float32,
+// GetFloat returns the value as a Go float64,
 // or returns an error if the data is not a floating point type.
-func (v Value) GetFloat(context ParseContext) (float32, error) {
+// 32-bit floating point types always hold data that a float32 can represent.
+func (v Value) GetFloat(context ParseContext) (float64, error) {
 	if v.err != nil {
 		return 0, v.err
 	}
 
-	num, err := strconv.ParseFloat(v.data, 32)
+	num, err := strconv.ParseFloat(v.data, 64)
 	if err != nil {
 		v.err = DataTypeErr{Context: context, TypeName: v.typeName}
 		return 0, v.err
 	}
 
-	return float32(num), nil
+	return num, nil
 }
 
 // GetBool returns the value as a Go bool,
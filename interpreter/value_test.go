@@ -54,6 +54,8 @@ func runTestValueGetSuccess(t *testing.T, expectedTypeName string, expectedData
 		assert.NoError(t, err)
 		if expectedTypeName == "float" {
 			assert.Equal(t, expectedData, fmt.Sprintf("%.1f", val))
+		} else if expectedTypeName == "string" {
+			assert.Equal(t, expectedData[1:len(expectedData)-1], fmt.Sprintf("%v", val))
 		} else {
 			assert.Equal(t, expectedData, fmt.Sprintf("%v", val))
 		}
@@ -127,6 +129,46 @@ func TestValueGetTypeFromLiteral(t *testing.T) {
 		}
 	}
 
+	t.Run("width", func(t *testing.T) {
+		tests := []struct {
+			typeName string
+			literal  string
+			fits     bool
+		}{
+			{typeName: "int8", literal: "-128", fits: true},
+			{typeName: "int8", literal: "127", fits: true},
+			{typeName: "int8", literal: "128"},
+			{typeName: "int16", literal: "-32768", fits: true},
+			{typeName: "int16", literal: "32768"},
+			{typeName: "int", literal: "2147483647", fits: true},
+			{typeName: "int32", literal: "-2147483649"},
+			{typeName: "int64", literal: "-9223372036854775808", fits: true},
+			{typeName: "int64", literal: "9223372036854775808"},
+			{typeName: "byte", literal: "255", fits: true},
+			{typeName: "byte", literal: "256"},
+			{typeName: "uint8", literal: "-1"},
+			{typeName: "uint16", literal: "65535", fits: true},
+			{typeName: "uint", literal: "4294967296"},
+			{typeName: "uint32", literal: "4294967295", fits: true},
+			{typeName: "uint64", literal: "18446744073709551615", fits: true},
+			{typeName: "float", literal: "1e38", fits: true},
+			{typeName: "float32", literal: "1e39"},
+			{typeName: "float64", literal: "1e300", fits: true},
+		}
+
+		for _, test := range tests {
+			context := NewParseContext(0, 0)
+			context.TypeData = getBasicTypes()[test.typeName]
+
+			result := GetTypeFromLiteral(context, test.literal)
+			assert.Equal(t, test.fits, result == test.typeName, "%s %s", test.typeName, test.literal)
+		}
+
+		// Untyped integer literals hold up to 64 bits
+		assert.Equal(t, "untyped int", GetTypeFromLiteral(NewParseContext(0, 0), "3000000000"))
+		assert.Equal(t, "untyped int", GetTypeFromLiteral(NewParseContext(0, 0), "18446744073709551615"))
+	})
+
 	t.Run("bool", func(t *testing.T) {
 		context := NewParseContext(0, 0)
 		typeName := GetTypeFromLiteral(context, "true")
@@ -199,13 +241,19 @@ func TestValueGet(t *testing.T) {
 		{testType: TestTypeError, typeName: "int", data: "10.0", err: DataTypeErr{TypeName: "int"}, funcValue: reflect.ValueOf(Value.GetInt)},
 		// Int success
 		{testType: TestTypeSuccess, typeName: "int", data: "10", err: nil, funcValue: reflect.ValueOf(Value.GetInt)},
+		// Int wider than 32 bits
+		{testType: TestTypeSuccess, typeName: "int64", data: "-9223372036854775808", err: nil, funcValue: reflect.ValueOf(Value.GetInt)},
 
 		// Byte with error data
 		{testType: TestTypeValueError, typeName: "byte", err: InvalidValueErr{Data: invalidData}, funcValue: reflect.ValueOf(Value.GetByte)},
 		// Byte with mismatched type
 		{testType: TestTypeError, typeName: "byte", data: "256", err: DataTypeErr{TypeName: "byte"}, funcValue: reflect.ValueOf(Value.GetByte)},
+		// Byte with negative data
+		{testType: TestTypeError, typeName: "byte", data: "-1", err: DataTypeErr{TypeName: "byte"}, funcValue: reflect.ValueOf(Value.GetByte)},
 		// Byte success
 		{testType: TestTypeSuccess, typeName: "byte", data: "10", err: nil, funcValue: reflect.ValueOf(Value.GetByte)},
+		// Byte above the signed 8-bit range
+		{testType: TestTypeSuccess, typeName: "byte", data: "255", err: nil, funcValue: reflect.ValueOf(Value.GetByte)},
 
 		// Uint with error data
 		{testType: TestTypeValueError, typeName: "uint", err: InvalidValueErr{Data: invalidData}, funcValue: reflect.ValueOf(Value.GetUint)},
@@ -213,6 +261,8 @@ func TestValueGet(t *testing.T) {
 		{testType: TestTypeError, typeName: "uint", data: "-10", err: DataTypeErr{TypeName: "uint"}, funcValue: reflect.ValueOf(Value.GetUint)},
 		// Uint success
 		{testType: TestTypeSuccess, typeName: "uint", data: "10", err: nil, funcValue: reflect.ValueOf(Value.GetUint)},
+		// Uint wider than 32 bits
+		{testType: TestTypeSuccess, typeName: "uint64", data: "18446744073709551615", err: nil, funcValue: reflect.ValueOf(Value.GetUint)},
 
 		// Float with error data
 		{testType: TestTypeValueError, typeName: "float", err: InvalidValueErr{Data: invalidData}, funcValue: reflect.ValueOf(Value.GetFloat)},
@@ -220,6 +270,8 @@ func TestValueGet(t *testing.T) {
 		{testType: TestTypeError, typeName: "float", data: "false", err: DataTypeErr{TypeName: "float"}, funcValue: reflect.ValueOf(Value.GetFloat)},
 		// Float success
 		{testType: TestTypeSuccess, typeName: "float", data: "10.0", err: nil, funcValue: reflect.ValueOf(Value.GetFloat)},
+		// Float beyond the range of 32 bits
+		{testType: TestTypeSuccess, typeName: "float64", data: "1e+300", err: nil, funcValue: reflect.ValueOf(Value.GetFloat)},
 
 		// Bool with error data
 		{testType: TestTypeValueError, typeName: "bool", err: InvalidValueErr{Data: invalidData}, funcValue: reflect.ValueOf(Value.GetBool)},
@@ -1,9 +1,13 @@
 package interpreter
 
 // Variable is a representation of data with a fixed data type and a value that can be changed.
+// Constants are variables whose value can never be changed after they are declared.
 type Variable struct {
 	name  string
 	value Value
+
+	constant    bool
+	declContext ParseContext
 }
 
 // NewVariable returns a new instance of a variable.
@@ -14,7 +18,22 @@ func NewVariable(name string, value Value) Variable {
 	}
 }
 
+// NewConstant returns a new instance of a constant declared at the given context.
+func NewConstant(name string, value Value, context ParseContext) Variable {
+	return Variable{
+		name:        name,
+		value:       value,
+		constant:    true,
+		declContext: NewParseContext(context.line, context.column),
+	}
+}
+
 // Value returns the value of the variable.
 func (v Variable) Value() Value {
 	return v.value
 }
+
+// IsConstant returns whether the variable is a constant.
+func (v Variable) IsConstant() bool {
+	return v.constant
+}
@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 44, 281,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 80, 519,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -23,119 +23,223 @@ var serializedLexerAtn = []uint16{
 	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33,
 	4, 34, 9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4,
 	39, 9, 39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44,
-	9, 44, 4, 45, 9, 45, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3,
-	6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3,
-	7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3,
-	9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11,
-	3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3,
-	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 16, 3, 16, 3, 17, 3, 17,
-	3, 18, 3, 18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3,
-	22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25,
-	3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3, 29, 3,
-	30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 33, 3, 33, 3, 34,
-	3, 34, 3, 35, 3, 35, 3, 36, 3, 36, 3, 37, 3, 37, 3, 38, 5, 38, 218, 10,
-	38, 3, 39, 3, 39, 3, 40, 6, 40, 223, 10, 40, 13, 40, 14, 40, 224, 3, 40,
-	3, 40, 6, 40, 229, 10, 40, 13, 40, 14, 40, 230, 5, 40, 233, 10, 40, 3,
-	41, 3, 41, 3, 41, 7, 41, 238, 10, 41, 12, 41, 14, 41, 241, 11, 41, 3, 42,
-	6, 42, 244, 10, 42, 13, 42, 14, 42, 245, 3, 42, 3, 42, 3, 43, 6, 43, 251,
-	10, 43, 13, 43, 14, 43, 252, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44,
-	7, 44, 261, 10, 44, 12, 44, 14, 44, 264, 11, 44, 3, 44, 3, 44, 3, 45, 3,
-	45, 3, 45, 3, 45, 7, 45, 272, 10, 45, 12, 45, 14, 45, 275, 11, 45, 3, 45,
-	3, 45, 3, 45, 3, 45, 3, 45, 3, 273, 2, 46, 3, 3, 5, 4, 7, 5, 9, 6, 11,
-	7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16,
-	31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25,
-	49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34,
-	67, 35, 69, 36, 71, 37, 73, 38, 75, 2, 77, 2, 79, 39, 81, 40, 83, 41, 85,
-	42, 87, 43, 89, 44, 3, 2, 7, 6, 2, 67, 92, 97, 97, 99, 124, 126, 126, 3,
-	2, 50, 59, 3, 2, 48, 48, 4, 2, 12, 12, 15, 15, 4, 2, 11, 11, 34, 34, 2,
-	287, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2,
-	2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3,
-	2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25,
-	3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2,
-	33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2,
-	2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2,
-	2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2,
-	2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3,
-	2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71,
-	3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2,
-	83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2,
-	3, 91, 3, 2, 2, 2, 5, 100, 3, 2, 2, 2, 7, 103, 3, 2, 2, 2, 9, 108, 3, 2,
-	2, 2, 11, 111, 3, 2, 2, 2, 13, 118, 3, 2, 2, 2, 15, 124, 3, 2, 2, 2, 17,
-	133, 3, 2, 2, 2, 19, 138, 3, 2, 2, 2, 21, 144, 3, 2, 2, 2, 23, 148, 3,
-	2, 2, 2, 25, 151, 3, 2, 2, 2, 27, 155, 3, 2, 2, 2, 29, 161, 3, 2, 2, 2,
-	31, 163, 3, 2, 2, 2, 33, 165, 3, 2, 2, 2, 35, 167, 3, 2, 2, 2, 37, 169,
-	3, 2, 2, 2, 39, 171, 3, 2, 2, 2, 41, 173, 3, 2, 2, 2, 43, 176, 3, 2, 2,
-	2, 45, 179, 3, 2, 2, 2, 47, 182, 3, 2, 2, 2, 49, 185, 3, 2, 2, 2, 51, 188,
-	3, 2, 2, 2, 53, 191, 3, 2, 2, 2, 55, 194, 3, 2, 2, 2, 57, 196, 3, 2, 2,
-	2, 59, 198, 3, 2, 2, 2, 61, 201, 3, 2, 2, 2, 63, 204, 3, 2, 2, 2, 65, 206,
-	3, 2, 2, 2, 67, 208, 3, 2, 2, 2, 69, 210, 3, 2, 2, 2, 71, 212, 3, 2, 2,
-	2, 73, 214, 3, 2, 2, 2, 75, 217, 3, 2, 2, 2, 77, 219, 3, 2, 2, 2, 79, 222,
-	3, 2, 2, 2, 81, 234, 3, 2, 2, 2, 83, 243, 3, 2, 2, 2, 85, 250, 3, 2, 2,
-	2, 87, 256, 3, 2, 2, 2, 89, 267, 3, 2, 2, 2, 91, 92, 7, 104, 2, 2, 92,
-	93, 7, 119, 2, 2, 93, 94, 7, 112, 2, 2, 94, 95, 7, 101, 2, 2, 95, 96, 7,
-	118, 2, 2, 96, 97, 7, 107, 2, 2, 97, 98, 7, 113, 2, 2, 98, 99, 7, 112,
-	2, 2, 99, 4, 3, 2, 2, 2, 100, 101, 7, 107, 2, 2, 101, 102, 7, 104, 2, 2,
-	102, 6, 3, 2, 2, 2, 103, 104, 7, 110, 2, 2, 104, 105, 7, 113, 2, 2, 105,
-	106, 7, 113, 2, 2, 106, 107, 7, 114, 2, 2, 107, 8, 3, 2, 2, 2, 108, 109,
-	7, 118, 2, 2, 109, 110, 7, 113, 2, 2, 110, 10, 3, 2, 2, 2, 111, 112, 7,
-	116, 2, 2, 112, 113, 7, 103, 2, 2, 113, 114, 7, 118, 2, 2, 114, 115, 7,
-	119, 2, 2, 115, 116, 7, 116, 2, 2, 116, 117, 7, 112, 2, 2, 117, 12, 3,
-	2, 2, 2, 118, 119, 7, 100, 2, 2, 119, 120, 7, 116, 2, 2, 120, 121, 7, 103,
-	2, 2, 121, 122, 7, 99, 2, 2, 122, 123, 7, 109, 2, 2, 123, 14, 3, 2, 2,
-	2, 124, 125, 7, 101, 2, 2, 125, 126, 7, 113, 2, 2, 126, 127, 7, 112, 2,
-	2, 127, 128, 7, 118, 2, 2, 128, 129, 7, 107, 2, 2, 129, 130, 7, 112, 2,
-	2, 130, 131, 7, 119, 2, 2, 131, 132, 7, 103, 2, 2, 132, 16, 3, 2, 2, 2,
-	133, 134, 7, 118, 2, 2, 134, 135, 7, 116, 2, 2, 135, 136, 7, 119, 2, 2,
-	136, 137, 7, 103, 2, 2, 137, 18, 3, 2, 2, 2, 138, 139, 7, 104, 2, 2, 139,
-	140, 7, 99, 2, 2, 140, 141, 7, 110, 2, 2, 141, 142, 7, 117, 2, 2, 142,
-	143, 7, 103, 2, 2, 143, 20, 3, 2, 2, 2, 144, 145, 7, 99, 2, 2, 145, 146,
-	7, 112, 2, 2, 146, 147, 7, 102, 2, 2, 147, 22, 3, 2, 2, 2, 148, 149, 7,
-	113, 2, 2, 149, 150, 7, 116, 2, 2, 150, 24, 3, 2, 2, 2, 151, 152, 7, 112,
-	2, 2, 152, 153, 7, 113, 2, 2, 153, 154, 7, 118, 2, 2, 154, 26, 3, 2, 2,
-	2, 155, 156, 7, 114, 2, 2, 156, 157, 7, 116, 2, 2, 157, 158, 7, 107, 2,
-	2, 158, 159, 7, 112, 2, 2, 159, 160, 7, 118, 2, 2, 160, 28, 3, 2, 2, 2,
-	161, 162, 7, 44, 2, 2, 162, 30, 3, 2, 2, 2, 163, 164, 7, 49, 2, 2, 164,
-	32, 3, 2, 2, 2, 165, 166, 7, 45, 2, 2, 166, 34, 3, 2, 2, 2, 167, 168, 7,
-	47, 2, 2, 168, 36, 3, 2, 2, 2, 169, 170, 7, 39, 2, 2, 170, 38, 3, 2, 2,
-	2, 171, 172, 7, 63, 2, 2, 172, 40, 3, 2, 2, 2, 173, 174, 7, 45, 2, 2, 174,
-	175, 7, 63, 2, 2, 175, 42, 3, 2, 2, 2, 176, 177, 7, 47, 2, 2, 177, 178,
-	7, 63, 2, 2, 178, 44, 3, 2, 2, 2, 179, 180, 7, 44, 2, 2, 180, 181, 7, 63,
-	2, 2, 181, 46, 3, 2, 2, 2, 182, 183, 7, 49, 2, 2, 183, 184, 7, 63, 2, 2,
-	184, 48, 3, 2, 2, 2, 185, 186, 7, 39, 2, 2, 186, 187, 7, 63, 2, 2, 187,
-	50, 3, 2, 2, 2, 188, 189, 7, 63, 2, 2, 189, 190, 7, 63, 2, 2, 190, 52,
-	3, 2, 2, 2, 191, 192, 7, 35, 2, 2, 192, 193, 7, 63, 2, 2, 193, 54, 3, 2,
-	2, 2, 194, 195, 7, 64, 2, 2, 195, 56, 3, 2, 2, 2, 196, 197, 7, 62, 2, 2,
-	197, 58, 3, 2, 2, 2, 198, 199, 7, 64, 2, 2, 199, 200, 7, 63, 2, 2, 200,
-	60, 3, 2, 2, 2, 201, 202, 7, 62, 2, 2, 202, 203, 7, 63, 2, 2, 203, 62,
-	3, 2, 2, 2, 204, 205, 7, 42, 2, 2, 205, 64, 3, 2, 2, 2, 206, 207, 7, 43,
-	2, 2, 207, 66, 3, 2, 2, 2, 208, 209, 7, 125, 2, 2, 209, 68, 3, 2, 2, 2,
-	210, 211, 7, 127, 2, 2, 211, 70, 3, 2, 2, 2, 212, 213, 7, 60, 2, 2, 213,
-	72, 3, 2, 2, 2, 214, 215, 7, 46, 2, 2, 215, 74, 3, 2, 2, 2, 216, 218, 9,
-	2, 2, 2, 217, 216, 3, 2, 2, 2, 218, 76, 3, 2, 2, 2, 219, 220, 9, 3, 2,
-	2, 220, 78, 3, 2, 2, 2, 221, 223, 5, 77, 39, 2, 222, 221, 3, 2, 2, 2, 223,
-	224, 3, 2, 2, 2, 224, 222, 3, 2, 2, 2, 224, 225, 3, 2, 2, 2, 225, 232,
-	3, 2, 2, 2, 226, 228, 9, 4, 2, 2, 227, 229, 5, 77, 39, 2, 228, 227, 3,
-	2, 2, 2, 229, 230, 3, 2, 2, 2, 230, 228, 3, 2, 2, 2, 230, 231, 3, 2, 2,
-	2, 231, 233, 3, 2, 2, 2, 232, 226, 3, 2, 2, 2, 232, 233, 3, 2, 2, 2, 233,
-	80, 3, 2, 2, 2, 234, 239, 5, 75, 38, 2, 235, 238, 5, 75, 38, 2, 236, 238,
-	5, 77, 39, 2, 237, 235, 3, 2, 2, 2, 237, 236, 3, 2, 2, 2, 238, 241, 3,
-	2, 2, 2, 239, 237, 3, 2, 2, 2, 239, 240, 3, 2, 2, 2, 240, 82, 3, 2, 2,
-	2, 241, 239, 3, 2, 2, 2, 242, 244, 9, 5, 2, 2, 243, 242, 3, 2, 2, 2, 244,
-	245, 3, 2, 2, 2, 245, 243, 3, 2, 2, 2, 245, 246, 3, 2, 2, 2, 246, 247,
-	3, 2, 2, 2, 247, 248, 8, 42, 2, 2, 248, 84, 3, 2, 2, 2, 249, 251, 9, 6,
-	2, 2, 250, 249, 3, 2, 2, 2, 251, 252, 3, 2, 2, 2, 252, 250, 3, 2, 2, 2,
-	252, 253, 3, 2, 2, 2, 253, 254, 3, 2, 2, 2, 254, 255, 8, 43, 2, 2, 255,
-	86, 3, 2, 2, 2, 256, 257, 7, 49, 2, 2, 257, 258, 7, 49, 2, 2, 258, 262,
-	3, 2, 2, 2, 259, 261, 10, 5, 2, 2, 260, 259, 3, 2, 2, 2, 261, 264, 3, 2,
-	2, 2, 262, 260, 3, 2, 2, 2, 262, 263, 3, 2, 2, 2, 263, 265, 3, 2, 2, 2,
-	264, 262, 3, 2, 2, 2, 265, 266, 8, 44, 2, 2, 266, 88, 3, 2, 2, 2, 267,
-	268, 7, 49, 2, 2, 268, 269, 7, 44, 2, 2, 269, 273, 3, 2, 2, 2, 270, 272,
-	11, 2, 2, 2, 271, 270, 3, 2, 2, 2, 272, 275, 3, 2, 2, 2, 273, 274, 3, 2,
-	2, 2, 273, 271, 3, 2, 2, 2, 274, 276, 3, 2, 2, 2, 275, 273, 3, 2, 2, 2,
-	276, 277, 7, 44, 2, 2, 277, 278, 7, 49, 2, 2, 278, 279, 3, 2, 2, 2, 279,
-	280, 8, 45, 2, 2, 280, 90, 3, 2, 2, 2, 13, 2, 217, 224, 230, 232, 237,
-	239, 245, 252, 262, 273, 3, 2, 3, 2,
+	9, 44, 4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9,
+	49, 4, 50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54,
+	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
+	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65,
+	9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9,
+	70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4, 74, 9, 74, 4, 75, 9, 75,
+	4, 76, 9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79, 9, 79, 4, 80, 9, 80, 4,
+	81, 9, 81, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3,
+	3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
+	3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7,
+	3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9,
+	3, 9, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3,
+	12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13,
+	3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3,
+	15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17,
+	3, 17, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3,
+	19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22,
+	3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3,
+	23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24,
+	3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3,
+	26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29,
+	3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 32, 3,
+	32, 3, 33, 3, 33, 3, 34, 3, 34, 3, 35, 3, 35, 3, 36, 3, 36, 3, 37, 3, 37,
+	3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3,
+	42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45,
+	3, 45, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3,
+	49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52,
+	3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 55, 3,
+	55, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 59, 3, 59,
+	3, 60, 3, 60, 3, 61, 3, 61, 3, 62, 3, 62, 3, 63, 3, 63, 3, 64, 3, 64, 3,
+	65, 3, 65, 3, 66, 3, 66, 3, 67, 3, 67, 3, 68, 3, 68, 3, 69, 3, 69, 3, 70,
+	3, 70, 3, 70, 3, 71, 3, 71, 3, 72, 3, 72, 3, 73, 6, 73, 427, 10, 73, 13,
+	73, 14, 73, 428, 3, 73, 3, 73, 6, 73, 433, 10, 73, 13, 73, 14, 73, 434,
+	5, 73, 437, 10, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 7, 74, 444, 10,
+	74, 12, 74, 14, 74, 447, 11, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3,
+	75, 3, 75, 3, 75, 7, 75, 457, 10, 75, 12, 75, 14, 75, 460, 11, 75, 3, 75,
+	3, 75, 3, 76, 3, 76, 7, 76, 466, 10, 76, 12, 76, 14, 76, 469, 11, 76, 3,
+	76, 3, 76, 3, 77, 3, 77, 3, 77, 7, 77, 476, 10, 77, 12, 77, 14, 77, 479,
+	11, 77, 3, 78, 6, 78, 482, 10, 78, 13, 78, 14, 78, 483, 3, 78, 3, 78, 3,
+	79, 6, 79, 489, 10, 79, 13, 79, 14, 79, 490, 3, 79, 3, 79, 3, 80, 3, 80,
+	3, 80, 3, 80, 7, 80, 499, 10, 80, 12, 80, 14, 80, 502, 11, 80, 3, 80, 3,
+	80, 3, 81, 3, 81, 3, 81, 3, 81, 7, 81, 510, 10, 81, 12, 81, 14, 81, 513,
+	11, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 4, 445, 511, 2, 82, 3, 3, 5,
+	4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25,
+	14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43,
+	23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61,
+	32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79,
+	41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49, 97,
+	50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113,
+	58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63, 125, 64, 127, 65, 129,
+	66, 131, 67, 133, 68, 135, 69, 137, 70, 139, 71, 141, 2, 143, 2, 145, 72,
+	147, 73, 149, 74, 151, 75, 153, 76, 155, 77, 157, 78, 159, 79, 161, 80,
+	3, 2, 9, 5, 2, 67, 92, 97, 97, 99, 124, 3, 2, 50, 59, 3, 2, 48, 48, 6,
+	2, 12, 12, 15, 15, 36, 36, 94, 94, 3, 2, 98, 98, 4, 2, 12, 12, 15, 15,
+	4, 2, 11, 11, 34, 34, 2, 529, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7,
+	3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2,
+	15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2,
+	2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2,
+	2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2,
+	2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3,
+	2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53,
+	3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2,
+	61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2,
+	2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2,
+	2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2,
+	2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3,
+	2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99,
+	3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2,
+	2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3,
+	2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2,
+	121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127, 3, 2,
+	2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3, 2, 2, 2, 2, 135,
+	3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2,
+	2, 147, 3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153, 3,
+	2, 2, 2, 2, 155, 3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 2,
+	161, 3, 2, 2, 2, 3, 163, 3, 2, 2, 2, 5, 172, 3, 2, 2, 2, 7, 175, 3, 2,
+	2, 2, 9, 180, 3, 2, 2, 2, 11, 186, 3, 2, 2, 2, 13, 190, 3, 2, 2, 2, 15,
+	197, 3, 2, 2, 2, 17, 202, 3, 2, 2, 2, 19, 210, 3, 2, 2, 2, 21, 213, 3,
+	2, 2, 2, 23, 219, 3, 2, 2, 2, 25, 226, 3, 2, 2, 2, 27, 231, 3, 2, 2, 2,
+	29, 239, 3, 2, 2, 2, 31, 242, 3, 2, 2, 2, 33, 247, 3, 2, 2, 2, 35, 252,
+	3, 2, 2, 2, 37, 255, 3, 2, 2, 2, 39, 263, 3, 2, 2, 2, 41, 268, 3, 2, 2,
+	2, 43, 271, 3, 2, 2, 2, 45, 278, 3, 2, 2, 2, 47, 284, 3, 2, 2, 2, 49, 293,
+	3, 2, 2, 2, 51, 298, 3, 2, 2, 2, 53, 304, 3, 2, 2, 2, 55, 308, 3, 2, 2,
+	2, 57, 311, 3, 2, 2, 2, 59, 315, 3, 2, 2, 2, 61, 321, 3, 2, 2, 2, 63, 323,
+	3, 2, 2, 2, 65, 325, 3, 2, 2, 2, 67, 327, 3, 2, 2, 2, 69, 329, 3, 2, 2,
+	2, 71, 331, 3, 2, 2, 2, 73, 333, 3, 2, 2, 2, 75, 335, 3, 2, 2, 2, 77, 337,
+	3, 2, 2, 2, 79, 340, 3, 2, 2, 2, 81, 343, 3, 2, 2, 2, 83, 345, 3, 2, 2,
+	2, 85, 348, 3, 2, 2, 2, 87, 351, 3, 2, 2, 2, 89, 354, 3, 2, 2, 2, 91, 357,
+	3, 2, 2, 2, 93, 360, 3, 2, 2, 2, 95, 363, 3, 2, 2, 2, 97, 366, 3, 2, 2,
+	2, 99, 369, 3, 2, 2, 2, 101, 372, 3, 2, 2, 2, 103, 376, 3, 2, 2, 2, 105,
+	380, 3, 2, 2, 2, 107, 383, 3, 2, 2, 2, 109, 386, 3, 2, 2, 2, 111, 388,
+	3, 2, 2, 2, 113, 390, 3, 2, 2, 2, 115, 393, 3, 2, 2, 2, 117, 396, 3, 2,
+	2, 2, 119, 398, 3, 2, 2, 2, 121, 400, 3, 2, 2, 2, 123, 402, 3, 2, 2, 2,
+	125, 404, 3, 2, 2, 2, 127, 406, 3, 2, 2, 2, 129, 408, 3, 2, 2, 2, 131,
+	410, 3, 2, 2, 2, 133, 412, 3, 2, 2, 2, 135, 414, 3, 2, 2, 2, 137, 416,
+	3, 2, 2, 2, 139, 418, 3, 2, 2, 2, 141, 421, 3, 2, 2, 2, 143, 423, 3, 2,
+	2, 2, 145, 426, 3, 2, 2, 2, 147, 438, 3, 2, 2, 2, 149, 452, 3, 2, 2, 2,
+	151, 463, 3, 2, 2, 2, 153, 472, 3, 2, 2, 2, 155, 481, 3, 2, 2, 2, 157,
+	488, 3, 2, 2, 2, 159, 494, 3, 2, 2, 2, 161, 505, 3, 2, 2, 2, 163, 164,
+	7, 104, 2, 2, 164, 165, 7, 119, 2, 2, 165, 166, 7, 112, 2, 2, 166, 167,
+	7, 101, 2, 2, 167, 168, 7, 118, 2, 2, 168, 169, 7, 107, 2, 2, 169, 170,
+	7, 113, 2, 2, 170, 171, 7, 112, 2, 2, 171, 4, 3, 2, 2, 2, 172, 173, 7,
+	104, 2, 2, 173, 174, 7, 112, 2, 2, 174, 6, 3, 2, 2, 2, 175, 176, 7, 118,
+	2, 2, 176, 177, 7, 123, 2, 2, 177, 178, 7, 114, 2, 2, 178, 179, 7, 103,
+	2, 2, 179, 8, 3, 2, 2, 2, 180, 181, 7, 101, 2, 2, 181, 182, 7, 113, 2,
+	2, 182, 183, 7, 112, 2, 2, 183, 184, 7, 117, 2, 2, 184, 185, 7, 118, 2,
+	2, 185, 10, 3, 2, 2, 2, 186, 187, 7, 120, 2, 2, 187, 188, 7, 99, 2, 2,
+	188, 189, 7, 116, 2, 2, 189, 12, 3, 2, 2, 2, 190, 191, 7, 117, 2, 2, 191,
+	192, 7, 118, 2, 2, 192, 193, 7, 116, 2, 2, 193, 194, 7, 119, 2, 2, 194,
+	195, 7, 101, 2, 2, 195, 196, 7, 118, 2, 2, 196, 14, 3, 2, 2, 2, 197, 198,
+	7, 103, 2, 2, 198, 199, 7, 112, 2, 2, 199, 200, 7, 119, 2, 2, 200, 201,
+	7, 111, 2, 2, 201, 16, 3, 2, 2, 2, 202, 203, 7, 113, 2, 2, 203, 204, 7,
+	116, 2, 2, 204, 205, 7, 102, 2, 2, 205, 206, 7, 103, 2, 2, 206, 207, 7,
+	116, 2, 2, 207, 208, 7, 103, 2, 2, 208, 209, 7, 102, 2, 2, 209, 18, 3,
+	2, 2, 2, 210, 211, 7, 100, 2, 2, 211, 212, 7, 123, 2, 2, 212, 20, 3, 2,
+	2, 2, 213, 214, 7, 111, 2, 2, 214, 215, 7, 99, 2, 2, 215, 216, 7, 118,
+	2, 2, 216, 217, 7, 101, 2, 2, 217, 218, 7, 106, 2, 2, 218, 22, 3, 2, 2,
+	2, 219, 220, 7, 117, 2, 2, 220, 221, 7, 121, 2, 2, 221, 222, 7, 107, 2,
+	2, 222, 223, 7, 118, 2, 2, 223, 224, 7, 101, 2, 2, 224, 225, 7, 106, 2,
+	2, 225, 24, 3, 2, 2, 2, 226, 227, 7, 101, 2, 2, 227, 228, 7, 99, 2, 2,
+	228, 229, 7, 117, 2, 2, 229, 230, 7, 103, 2, 2, 230, 26, 3, 2, 2, 2, 231,
+	232, 7, 102, 2, 2, 232, 233, 7, 103, 2, 2, 233, 234, 7, 104, 2, 2, 234,
+	235, 7, 99, 2, 2, 235, 236, 7, 119, 2, 2, 236, 237, 7, 110, 2, 2, 237,
+	238, 7, 118, 2, 2, 238, 28, 3, 2, 2, 2, 239, 240, 7, 107, 2, 2, 240, 241,
+	7, 104, 2, 2, 241, 30, 3, 2, 2, 2, 242, 243, 7, 103, 2, 2, 243, 244, 7,
+	110, 2, 2, 244, 245, 7, 117, 2, 2, 245, 246, 7, 103, 2, 2, 246, 32, 3,
+	2, 2, 2, 247, 248, 7, 110, 2, 2, 248, 249, 7, 113, 2, 2, 249, 250, 7, 113,
+	2, 2, 250, 251, 7, 114, 2, 2, 251, 34, 3, 2, 2, 2, 252, 253, 7, 118, 2,
+	2, 253, 254, 7, 113, 2, 2, 254, 36, 3, 2, 2, 2, 255, 256, 7, 118, 2, 2,
+	256, 257, 7, 106, 2, 2, 257, 258, 7, 116, 2, 2, 258, 259, 7, 113, 2, 2,
+	259, 260, 7, 119, 2, 2, 260, 261, 7, 105, 2, 2, 261, 262, 7, 106, 2, 2,
+	262, 38, 3, 2, 2, 2, 263, 264, 7, 117, 2, 2, 264, 265, 7, 118, 2, 2, 265,
+	266, 7, 103, 2, 2, 266, 267, 7, 114, 2, 2, 267, 40, 3, 2, 2, 2, 268, 269,
+	7, 107, 2, 2, 269, 270, 7, 112, 2, 2, 270, 42, 3, 2, 2, 2, 271, 272, 7,
+	116, 2, 2, 272, 273, 7, 103, 2, 2, 273, 274, 7, 118, 2, 2, 274, 275, 7,
+	119, 2, 2, 275, 276, 7, 116, 2, 2, 276, 277, 7, 112, 2, 2, 277, 44, 3,
+	2, 2, 2, 278, 279, 7, 100, 2, 2, 279, 280, 7, 116, 2, 2, 280, 281, 7, 103,
+	2, 2, 281, 282, 7, 99, 2, 2, 282, 283, 7, 109, 2, 2, 283, 46, 3, 2, 2,
+	2, 284, 285, 7, 101, 2, 2, 285, 286, 7, 113, 2, 2, 286, 287, 7, 112, 2,
+	2, 287, 288, 7, 118, 2, 2, 288, 289, 7, 107, 2, 2, 289, 290, 7, 112, 2,
+	2, 290, 291, 7, 119, 2, 2, 291, 292, 7, 103, 2, 2, 292, 48, 3, 2, 2, 2,
+	293, 294, 7, 118, 2, 2, 294, 295, 7, 116, 2, 2, 295, 296, 7, 119, 2, 2,
+	296, 297, 7, 103, 2, 2, 297, 50, 3, 2, 2, 2, 298, 299, 7, 104, 2, 2, 299,
+	300, 7, 99, 2, 2, 300, 301, 7, 110, 2, 2, 301, 302, 7, 117, 2, 2, 302,
+	303, 7, 103, 2, 2, 303, 52, 3, 2, 2, 2, 304, 305, 7, 99, 2, 2, 305, 306,
+	7, 112, 2, 2, 306, 307, 7, 102, 2, 2, 307, 54, 3, 2, 2, 2, 308, 309, 7,
+	113, 2, 2, 309, 310, 7, 116, 2, 2, 310, 56, 3, 2, 2, 2, 311, 312, 7, 112,
+	2, 2, 312, 313, 7, 113, 2, 2, 313, 314, 7, 118, 2, 2, 314, 58, 3, 2, 2,
+	2, 315, 316, 7, 114, 2, 2, 316, 317, 7, 116, 2, 2, 317, 318, 7, 107, 2,
+	2, 318, 319, 7, 112, 2, 2, 319, 320, 7, 118, 2, 2, 320, 60, 3, 2, 2, 2,
+	321, 322, 7, 44, 2, 2, 322, 62, 3, 2, 2, 2, 323, 324, 7, 49, 2, 2, 324,
+	64, 3, 2, 2, 2, 325, 326, 7, 45, 2, 2, 326, 66, 3, 2, 2, 2, 327, 328, 7,
+	47, 2, 2, 328, 68, 3, 2, 2, 2, 329, 330, 7, 39, 2, 2, 330, 70, 3, 2, 2,
+	2, 331, 332, 7, 40, 2, 2, 332, 72, 3, 2, 2, 2, 333, 334, 7, 96, 2, 2, 334,
+	74, 3, 2, 2, 2, 335, 336, 7, 128, 2, 2, 336, 76, 3, 2, 2, 2, 337, 338,
+	7, 62, 2, 2, 338, 339, 7, 62, 2, 2, 339, 78, 3, 2, 2, 2, 340, 341, 7, 64,
+	2, 2, 341, 342, 7, 64, 2, 2, 342, 80, 3, 2, 2, 2, 343, 344, 7, 63, 2, 2,
+	344, 82, 3, 2, 2, 2, 345, 346, 7, 60, 2, 2, 346, 347, 7, 63, 2, 2, 347,
+	84, 3, 2, 2, 2, 348, 349, 7, 45, 2, 2, 349, 350, 7, 63, 2, 2, 350, 86,
+	3, 2, 2, 2, 351, 352, 7, 47, 2, 2, 352, 353, 7, 63, 2, 2, 353, 88, 3, 2,
+	2, 2, 354, 355, 7, 44, 2, 2, 355, 356, 7, 63, 2, 2, 356, 90, 3, 2, 2, 2,
+	357, 358, 7, 49, 2, 2, 358, 359, 7, 63, 2, 2, 359, 92, 3, 2, 2, 2, 360,
+	361, 7, 39, 2, 2, 361, 362, 7, 63, 2, 2, 362, 94, 3, 2, 2, 2, 363, 364,
+	7, 40, 2, 2, 364, 365, 7, 63, 2, 2, 365, 96, 3, 2, 2, 2, 366, 367, 7, 126,
+	2, 2, 367, 368, 7, 63, 2, 2, 368, 98, 3, 2, 2, 2, 369, 370, 7, 96, 2, 2,
+	370, 371, 7, 63, 2, 2, 371, 100, 3, 2, 2, 2, 372, 373, 7, 62, 2, 2, 373,
+	374, 7, 62, 2, 2, 374, 375, 7, 63, 2, 2, 375, 102, 3, 2, 2, 2, 376, 377,
+	7, 64, 2, 2, 377, 378, 7, 64, 2, 2, 378, 379, 7, 63, 2, 2, 379, 104, 3,
+	2, 2, 2, 380, 381, 7, 63, 2, 2, 381, 382, 7, 63, 2, 2, 382, 106, 3, 2,
+	2, 2, 383, 384, 7, 35, 2, 2, 384, 385, 7, 63, 2, 2, 385, 108, 3, 2, 2,
+	2, 386, 387, 7, 64, 2, 2, 387, 110, 3, 2, 2, 2, 388, 389, 7, 62, 2, 2,
+	389, 112, 3, 2, 2, 2, 390, 391, 7, 64, 2, 2, 391, 392, 7, 63, 2, 2, 392,
+	114, 3, 2, 2, 2, 393, 394, 7, 62, 2, 2, 394, 395, 7, 63, 2, 2, 395, 116,
+	3, 2, 2, 2, 396, 397, 7, 42, 2, 2, 397, 118, 3, 2, 2, 2, 398, 399, 7, 43,
+	2, 2, 399, 120, 3, 2, 2, 2, 400, 401, 7, 125, 2, 2, 401, 122, 3, 2, 2,
+	2, 402, 403, 7, 127, 2, 2, 403, 124, 3, 2, 2, 2, 404, 405, 7, 93, 2, 2,
+	405, 126, 3, 2, 2, 2, 406, 407, 7, 95, 2, 2, 407, 128, 3, 2, 2, 2, 408,
+	409, 7, 60, 2, 2, 409, 130, 3, 2, 2, 2, 410, 411, 7, 61, 2, 2, 411, 132,
+	3, 2, 2, 2, 412, 413, 7, 46, 2, 2, 413, 134, 3, 2, 2, 2, 414, 415, 7, 48,
+	2, 2, 415, 136, 3, 2, 2, 2, 416, 417, 7, 126, 2, 2, 417, 138, 3, 2, 2,
+	2, 418, 419, 7, 63, 2, 2, 419, 420, 7, 64, 2, 2, 420, 140, 3, 2, 2, 2,
+	421, 422, 9, 2, 2, 2, 422, 142, 3, 2, 2, 2, 423, 424, 9, 3, 2, 2, 424,
+	144, 3, 2, 2, 2, 425, 427, 5, 143, 72, 2, 426, 425, 3, 2, 2, 2, 427, 428,
+	3, 2, 2, 2, 428, 426, 3, 2, 2, 2, 428, 429, 3, 2, 2, 2, 429, 436, 3, 2,
+	2, 2, 430, 432, 9, 4, 2, 2, 431, 433, 5, 143, 72, 2, 432, 431, 3, 2, 2,
+	2, 433, 434, 3, 2, 2, 2, 434, 432, 3, 2, 2, 2, 434, 435, 3, 2, 2, 2, 435,
+	437, 3, 2, 2, 2, 436, 430, 3, 2, 2, 2, 436, 437, 3, 2, 2, 2, 437, 146,
+	3, 2, 2, 2, 438, 439, 7, 36, 2, 2, 439, 440, 7, 36, 2, 2, 440, 441, 7,
+	36, 2, 2, 441, 445, 3, 2, 2, 2, 442, 444, 11, 2, 2, 2, 443, 442, 3, 2,
+	2, 2, 444, 447, 3, 2, 2, 2, 445, 446, 3, 2, 2, 2, 445, 443, 3, 2, 2, 2,
+	446, 448, 3, 2, 2, 2, 447, 445, 3, 2, 2, 2, 448, 449, 7, 36, 2, 2, 449,
+	450, 7, 36, 2, 2, 450, 451, 7, 36, 2, 2, 451, 148, 3, 2, 2, 2, 452, 458,
+	7, 36, 2, 2, 453, 454, 7, 94, 2, 2, 454, 457, 11, 2, 2, 2, 455, 457, 10,
+	5, 2, 2, 456, 453, 3, 2, 2, 2, 456, 455, 3, 2, 2, 2, 457, 460, 3, 2, 2,
+	2, 458, 456, 3, 2, 2, 2, 458, 459, 3, 2, 2, 2, 459, 461, 3, 2, 2, 2, 460,
+	458, 3, 2, 2, 2, 461, 462, 7, 36, 2, 2, 462, 150, 3, 2, 2, 2, 463, 467,
+	7, 98, 2, 2, 464, 466, 10, 6, 2, 2, 465, 464, 3, 2, 2, 2, 466, 469, 3,
+	2, 2, 2, 467, 465, 3, 2, 2, 2, 467, 468, 3, 2, 2, 2, 468, 470, 3, 2, 2,
+	2, 469, 467, 3, 2, 2, 2, 470, 471, 7, 98, 2, 2, 471, 152, 3, 2, 2, 2, 472,
+	477, 5, 141, 71, 2, 473, 476, 5, 141, 71, 2, 474, 476, 5, 143, 72, 2, 475,
+	473, 3, 2, 2, 2, 475, 474, 3, 2, 2, 2, 476, 479, 3, 2, 2, 2, 477, 475,
+	3, 2, 2, 2, 477, 478, 3, 2, 2, 2, 478, 154, 3, 2, 2, 2, 479, 477, 3, 2,
+	2, 2, 480, 482, 9, 7, 2, 2, 481, 480, 3, 2, 2, 2, 482, 483, 3, 2, 2, 2,
+	483, 481, 3, 2, 2, 2, 483, 484, 3, 2, 2, 2, 484, 485, 3, 2, 2, 2, 485,
+	486, 8, 78, 2, 2, 486, 156, 3, 2, 2, 2, 487, 489, 9, 8, 2, 2, 488, 487,
+	3, 2, 2, 2, 489, 490, 3, 2, 2, 2, 490, 488, 3, 2, 2, 2, 490, 491, 3, 2,
+	2, 2, 491, 492, 3, 2, 2, 2, 492, 493, 8, 79, 2, 2, 493, 158, 3, 2, 2, 2,
+	494, 495, 7, 49, 2, 2, 495, 496, 7, 49, 2, 2, 496, 500, 3, 2, 2, 2, 497,
+	499, 10, 7, 2, 2, 498, 497, 3, 2, 2, 2, 499, 502, 3, 2, 2, 2, 500, 498,
+	3, 2, 2, 2, 500, 501, 3, 2, 2, 2, 501, 503, 3, 2, 2, 2, 502, 500, 3, 2,
+	2, 2, 503, 504, 8, 80, 2, 2, 504, 160, 3, 2, 2, 2, 505, 506, 7, 49, 2,
+	2, 506, 507, 7, 44, 2, 2, 507, 511, 3, 2, 2, 2, 508, 510, 11, 2, 2, 2,
+	509, 508, 3, 2, 2, 2, 510, 513, 3, 2, 2, 2, 511, 512, 3, 2, 2, 2, 511,
+	509, 3, 2, 2, 2, 512, 514, 3, 2, 2, 2, 513, 511, 3, 2, 2, 2, 514, 515,
+	7, 44, 2, 2, 515, 516, 7, 49, 2, 2, 516, 517, 3, 2, 2, 2, 517, 518, 8,
+	81, 2, 2, 518, 162, 3, 2, 2, 2, 16, 2, 428, 434, 436, 445, 456, 458, 467,
+	475, 477, 483, 490, 500, 511, 3, 2, 3, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -150,31 +254,47 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "'function'", "'if'", "'loop'", "'to'", "'return'", "'break'", "'continue'",
-	"'true'", "'false'", "'and'", "'or'", "'not'", "'print'", "'*'", "'/'",
-	"'+'", "'-'", "'%'", "'='", "'+='", "'-='", "'*='", "'/='", "'%='", "'=='",
-	"'!='", "'>'", "'<'", "'>='", "'<='", "'('", "')'", "'{'", "'}'", "':'",
-	"','",
+	"", "'function'", "'fn'", "'type'", "'const'", "'var'", "'struct'", "'enum'",
+	"'ordered'", "'by'", "'match'", "'switch'", "'case'", "'default'", "'if'",
+	"'else'", "'loop'", "'to'", "'through'", "'step'", "'in'", "'return'",
+	"'break'", "'continue'", "'true'", "'false'", "'and'", "'or'", "'not'",
+	"'print'", "'*'", "'/'", "'+'", "'-'", "'%'", "'&'", "'^'", "'~'", "'<<'",
+	"'>>'", "'='", "':='", "'+='", "'-='", "'*='", "'/='", "'%='", "'&='",
+	"'|='", "'^='", "'<<='", "'>>='", "'=='", "'!='", "'>'", "'<'", "'>='",
+	"'<='", "'('", "')'", "'{'", "'}'", "'['", "']'", "':'", "';'", "','",
+	"'.'", "'|'", "'=>'",
 }
 
 var lexerSymbolicNames = []string{
-	"", "FUNCTION", "IF", "LOOP", "TO", "RETURN", "BREAK", "CONTINUE", "TRUE",
-	"FALSE", "AND", "OR", "NOT", "PRINT", "MULTIPLY", "DIVIDE", "ADD", "SUBTRACT",
-	"MODULO", "ASSIGNMENT", "ADD_ASSIGNMENT", "SUB_ASSIGNMENT", "MUL_ASSIGNMENT",
-	"DIV_ASSIGNMENT", "MOD_ASSIGNMENT", "EQUALS", "NOT_EQUALS", "GREATER",
-	"LESSER", "GREATER_OR_EQUAL", "LESSER_OR_EQUAL", "LPAREN", "RPAREN", "LBRACE",
-	"RBRACE", "COLON", "COMMA", "NUMBER", "IDENTIFIER", "NEWLINE", "WHITESPACE",
-	"LINE_COMMENT", "BLOCK_COMMENT",
+	"", "FUNCTION", "FN", "TYPE", "CONST", "VAR", "STRUCT", "ENUM", "ORDERED",
+	"BY", "MATCH", "SWITCH", "CASE", "DEFAULT", "IF", "ELSE", "LOOP", "TO",
+	"THROUGH", "STEP", "IN", "RETURN", "BREAK", "CONTINUE", "TRUE", "FALSE",
+	"AND", "OR", "NOT", "PRINT", "MULTIPLY", "DIVIDE", "ADD", "SUBTRACT", "MODULO",
+	"BITWISE_AND", "BITWISE_XOR", "BITWISE_NOT", "LEFT_SHIFT", "RIGHT_SHIFT",
+	"ASSIGNMENT", "DECLARE_ASSIGNMENT", "ADD_ASSIGNMENT", "SUB_ASSIGNMENT",
+	"MUL_ASSIGNMENT", "DIV_ASSIGNMENT", "MOD_ASSIGNMENT", "BITWISE_AND_ASSIGNMENT",
+	"BITWISE_OR_ASSIGNMENT", "BITWISE_XOR_ASSIGNMENT", "LEFT_SHIFT_ASSIGNMENT",
+	"RIGHT_SHIFT_ASSIGNMENT", "EQUALS", "NOT_EQUALS", "GREATER", "LESSER",
+	"GREATER_OR_EQUAL", "LESSER_OR_EQUAL", "LPAREN", "RPAREN", "LBRACE", "RBRACE",
+	"LBRACKET", "RBRACKET", "COLON", "SEMICOLON", "COMMA", "DOT", "PIPE", "ARROW",
+	"NUMBER", "MULTILINE_STRING", "STRING", "RAW_STRING", "IDENTIFIER", "NEWLINE",
+	"WHITESPACE", "LINE_COMMENT", "BLOCK_COMMENT",
 }
 
 var lexerRuleNames = []string{
-	"FUNCTION", "IF", "LOOP", "TO", "RETURN", "BREAK", "CONTINUE", "TRUE",
-	"FALSE", "AND", "OR", "NOT", "PRINT", "MULTIPLY", "DIVIDE", "ADD", "SUBTRACT",
-	"MODULO", "ASSIGNMENT", "ADD_ASSIGNMENT", "SUB_ASSIGNMENT", "MUL_ASSIGNMENT",
-	"DIV_ASSIGNMENT", "MOD_ASSIGNMENT", "EQUALS", "NOT_EQUALS", "GREATER",
-	"LESSER", "GREATER_OR_EQUAL", "LESSER_OR_EQUAL", "LPAREN", "RPAREN", "LBRACE",
-	"RBRACE", "COLON", "COMMA", "LETTER", "DIGIT", "NUMBER", "IDENTIFIER",
-	"NEWLINE", "WHITESPACE", "LINE_COMMENT", "BLOCK_COMMENT",
+	"FUNCTION", "FN", "TYPE", "CONST", "VAR", "STRUCT", "ENUM", "ORDERED",
+	"BY", "MATCH", "SWITCH", "CASE", "DEFAULT", "IF", "ELSE", "LOOP", "TO",
+	"THROUGH", "STEP", "IN", "RETURN", "BREAK", "CONTINUE", "TRUE", "FALSE",
+	"AND", "OR", "NOT", "PRINT", "MULTIPLY", "DIVIDE", "ADD", "SUBTRACT", "MODULO",
+	"BITWISE_AND", "BITWISE_XOR", "BITWISE_NOT", "LEFT_SHIFT", "RIGHT_SHIFT",
+	"ASSIGNMENT", "DECLARE_ASSIGNMENT", "ADD_ASSIGNMENT", "SUB_ASSIGNMENT",
+	"MUL_ASSIGNMENT", "DIV_ASSIGNMENT", "MOD_ASSIGNMENT", "BITWISE_AND_ASSIGNMENT",
+	"BITWISE_OR_ASSIGNMENT", "BITWISE_XOR_ASSIGNMENT", "LEFT_SHIFT_ASSIGNMENT",
+	"RIGHT_SHIFT_ASSIGNMENT", "EQUALS", "NOT_EQUALS", "GREATER", "LESSER",
+	"GREATER_OR_EQUAL", "LESSER_OR_EQUAL", "LPAREN", "RPAREN", "LBRACE", "RBRACE",
+	"LBRACKET", "RBRACKET", "COLON", "SEMICOLON", "COMMA", "DOT", "PIPE", "ARROW",
+	"LETTER", "DIGIT", "NUMBER", "MULTILINE_STRING", "STRING", "RAW_STRING",
+	"IDENTIFIER", "NEWLINE", "WHITESPACE", "LINE_COMMENT", "BLOCK_COMMENT",
 }
 
 type SimLexer struct {
@@ -212,46 +332,82 @@ func NewSimLexer(input antlr.CharStream) *SimLexer {
 
 // SimLexer tokens.
 const (
-	SimLexerFUNCTION         = 1
-	SimLexerIF               = 2
-	SimLexerLOOP             = 3
-	SimLexerTO               = 4
-	SimLexerRETURN           = 5
-	SimLexerBREAK            = 6
-	SimLexerCONTINUE         = 7
-	SimLexerTRUE             = 8
-	SimLexerFALSE            = 9
-	SimLexerAND              = 10
-	SimLexerOR               = 11
-	SimLexerNOT              = 12
-	SimLexerPRINT            = 13
-	SimLexerMULTIPLY         = 14
-	SimLexerDIVIDE           = 15
-	SimLexerADD              = 16
-	SimLexerSUBTRACT         = 17
-	SimLexerMODULO           = 18
-	SimLexerASSIGNMENT       = 19
-	SimLexerADD_ASSIGNMENT   = 20
-	SimLexerSUB_ASSIGNMENT   = 21
-	SimLexerMUL_ASSIGNMENT   = 22
-	SimLexerDIV_ASSIGNMENT   = 23
-	SimLexerMOD_ASSIGNMENT   = 24
-	SimLexerEQUALS           = 25
-	SimLexerNOT_EQUALS       = 26
-	SimLexerGREATER          = 27
-	SimLexerLESSER           = 28
-	SimLexerGREATER_OR_EQUAL = 29
-	SimLexerLESSER_OR_EQUAL  = 30
-	SimLexerLPAREN           = 31
-	SimLexerRPAREN           = 32
-	SimLexerLBRACE           = 33
-	SimLexerRBRACE           = 34
-	SimLexerCOLON            = 35
-	SimLexerCOMMA            = 36
-	SimLexerNUMBER           = 37
-	SimLexerIDENTIFIER       = 38
-	SimLexerNEWLINE          = 39
-	SimLexerWHITESPACE       = 40
-	SimLexerLINE_COMMENT     = 41
-	SimLexerBLOCK_COMMENT    = 42
+	SimLexerFUNCTION               = 1
+	SimLexerFN                     = 2
+	SimLexerTYPE                   = 3
+	SimLexerCONST                  = 4
+	SimLexerVAR                    = 5
+	SimLexerSTRUCT                 = 6
+	SimLexerENUM                   = 7
+	SimLexerORDERED                = 8
+	SimLexerBY                     = 9
+	SimLexerMATCH                  = 10
+	SimLexerSWITCH                 = 11
+	SimLexerCASE                   = 12
+	SimLexerDEFAULT                = 13
+	SimLexerIF                     = 14
+	SimLexerELSE                   = 15
+	SimLexerLOOP                   = 16
+	SimLexerTO                     = 17
+	SimLexerTHROUGH                = 18
+	SimLexerSTEP                   = 19
+	SimLexerIN                     = 20
+	SimLexerRETURN                 = 21
+	SimLexerBREAK                  = 22
+	SimLexerCONTINUE               = 23
+	SimLexerTRUE                   = 24
+	SimLexerFALSE                  = 25
+	SimLexerAND                    = 26
+	SimLexerOR                     = 27
+	SimLexerNOT                    = 28
+	SimLexerPRINT                  = 29
+	SimLexerMULTIPLY               = 30
+	SimLexerDIVIDE                 = 31
+	SimLexerADD                    = 32
+	SimLexerSUBTRACT               = 33
+	SimLexerMODULO                 = 34
+	SimLexerBITWISE_AND            = 35
+	SimLexerBITWISE_XOR            = 36
+	SimLexerBITWISE_NOT            = 37
+	SimLexerLEFT_SHIFT             = 38
+	SimLexerRIGHT_SHIFT            = 39
+	SimLexerASSIGNMENT             = 40
+	SimLexerDECLARE_ASSIGNMENT     = 41
+	SimLexerADD_ASSIGNMENT         = 42
+	SimLexerSUB_ASSIGNMENT         = 43
+	SimLexerMUL_ASSIGNMENT         = 44
+	SimLexerDIV_ASSIGNMENT         = 45
+	SimLexerMOD_ASSIGNMENT         = 46
+	SimLexerBITWISE_AND_ASSIGNMENT = 47
+	SimLexerBITWISE_OR_ASSIGNMENT  = 48
+	SimLexerBITWISE_XOR_ASSIGNMENT = 49
+	SimLexerLEFT_SHIFT_ASSIGNMENT  = 50
+	SimLexerRIGHT_SHIFT_ASSIGNMENT = 51
+	SimLexerEQUALS                 = 52
+	SimLexerNOT_EQUALS             = 53
+	SimLexerGREATER                = 54
+	SimLexerLESSER                 = 55
+	SimLexerGREATER_OR_EQUAL       = 56
+	SimLexerLESSER_OR_EQUAL        = 57
+	SimLexerLPAREN                 = 58
+	SimLexerRPAREN                 = 59
+	SimLexerLBRACE                 = 60
+	SimLexerRBRACE                 = 61
+	SimLexerLBRACKET               = 62
+	SimLexerRBRACKET               = 63
+	SimLexerCOLON                  = 64
+	SimLexerSEMICOLON              = 65
+	SimLexerCOMMA                  = 66
+	SimLexerDOT                    = 67
+	SimLexerPIPE                   = 68
+	SimLexerARROW                  = 69
+	SimLexerNUMBER                 = 70
+	SimLexerMULTILINE_STRING       = 71
+	SimLexerSTRING                 = 72
+	SimLexerRAW_STRING             = 73
+	SimLexerIDENTIFIER             = 74
+	SimLexerNEWLINE                = 75
+	SimLexerWHITESPACE             = 76
+	SimLexerLINE_COMMENT           = 77
+	SimLexerBLOCK_COMMENT          = 78
 )
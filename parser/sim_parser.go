@@ -15,87 +15,268 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 44, 128,
-	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 3, 2, 3, 2,
-	3, 2, 7, 2, 16, 10, 2, 12, 2, 14, 2, 19, 11, 2, 3, 3, 3, 3, 7, 3, 23, 10,
-	3, 12, 3, 14, 3, 26, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 80, 482,
+	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
+	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
+	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 3, 2, 3, 2, 3, 2, 7, 2, 34, 10, 2, 12,
+	2, 14, 2, 37, 11, 2, 3, 3, 3, 3, 7, 3, 41, 10, 3, 12, 3, 14, 3, 44, 11,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 52, 10, 3, 3, 3, 3, 3, 5,
+	3, 56, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 62, 10, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 5, 3, 70, 10, 3, 3, 3, 3, 3, 3, 3, 5, 3, 75, 10, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 82, 10, 3, 3, 3, 3, 3, 3, 3, 5, 3, 87,
+	10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 93, 10, 3, 3, 3, 3, 3, 3, 3, 5, 3,
+	98, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 7, 3, 111, 10, 3, 12, 3, 14, 3, 114, 11, 3, 5, 3, 116, 10, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 129,
+	10, 3, 7, 3, 131, 10, 3, 12, 3, 14, 3, 134, 11, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 5, 3, 140, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 148, 10,
+	3, 12, 3, 14, 3, 151, 11, 3, 3, 3, 5, 3, 154, 10, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 164, 10, 3, 12, 3, 14, 3, 167, 11,
+	3, 3, 3, 3, 3, 3, 3, 5, 3, 172, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 178,
+	10, 3, 12, 3, 14, 3, 181, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7,
+	3, 189, 10, 3, 12, 3, 14, 3, 192, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 5, 3, 200, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 53, 10, 3, 12, 3, 14, 3, 56, 11,
-	3, 5, 3, 58, 10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 67,
-	10, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 5, 3, 83, 10, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 5, 4, 96, 10, 4, 3, 4, 3, 4, 3, 4, 3, 4,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 5, 3, 230, 10, 3, 3, 3,
+	3, 3, 3, 3, 5, 3, 235, 10, 3, 5, 3, 237, 10, 3, 3, 4, 3, 4, 3, 4, 3, 4,
 	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 7, 4, 116, 10, 4, 12, 4, 14, 4, 119, 11, 4, 3, 5, 3, 5, 3,
-	6, 3, 6, 3, 6, 5, 6, 126, 10, 6, 3, 6, 2, 3, 6, 7, 2, 4, 6, 8, 10, 2, 8,
-	4, 2, 10, 11, 39, 39, 4, 2, 16, 17, 20, 20, 3, 2, 18, 19, 3, 2, 29, 32,
-	3, 2, 27, 28, 3, 2, 21, 26, 2, 151, 2, 17, 3, 2, 2, 2, 4, 82, 3, 2, 2,
-	2, 6, 95, 3, 2, 2, 2, 8, 120, 3, 2, 2, 2, 10, 125, 3, 2, 2, 2, 12, 13,
-	5, 4, 3, 2, 13, 14, 5, 10, 6, 2, 14, 16, 3, 2, 2, 2, 15, 12, 3, 2, 2, 2,
-	16, 19, 3, 2, 2, 2, 17, 15, 3, 2, 2, 2, 17, 18, 3, 2, 2, 2, 18, 3, 3, 2,
-	2, 2, 19, 17, 3, 2, 2, 2, 20, 24, 7, 35, 2, 2, 21, 23, 5, 4, 3, 2, 22,
-	21, 3, 2, 2, 2, 23, 26, 3, 2, 2, 2, 24, 22, 3, 2, 2, 2, 24, 25, 3, 2, 2,
-	2, 25, 27, 3, 2, 2, 2, 26, 24, 3, 2, 2, 2, 27, 83, 7, 36, 2, 2, 28, 29,
-	7, 4, 2, 2, 29, 30, 5, 6, 4, 2, 30, 31, 5, 4, 3, 2, 31, 83, 3, 2, 2, 2,
-	32, 33, 7, 5, 2, 2, 33, 83, 5, 4, 3, 2, 34, 35, 7, 5, 2, 2, 35, 36, 5,
-	6, 4, 2, 36, 37, 5, 4, 3, 2, 37, 83, 3, 2, 2, 2, 38, 39, 7, 5, 2, 2, 39,
-	40, 7, 40, 2, 2, 40, 41, 7, 21, 2, 2, 41, 42, 5, 6, 4, 2, 42, 43, 7, 6,
-	2, 2, 43, 44, 5, 6, 4, 2, 44, 45, 5, 4, 3, 2, 45, 83, 3, 2, 2, 2, 46, 47,
-	7, 3, 2, 2, 47, 48, 7, 40, 2, 2, 48, 57, 7, 33, 2, 2, 49, 54, 5, 6, 4,
-	2, 50, 51, 7, 38, 2, 2, 51, 53, 5, 6, 4, 2, 52, 50, 3, 2, 2, 2, 53, 56,
-	3, 2, 2, 2, 54, 52, 3, 2, 2, 2, 54, 55, 3, 2, 2, 2, 55, 58, 3, 2, 2, 2,
-	56, 54, 3, 2, 2, 2, 57, 49, 3, 2, 2, 2, 57, 58, 3, 2, 2, 2, 58, 59, 3,
-	2, 2, 2, 59, 60, 7, 34, 2, 2, 60, 61, 7, 37, 2, 2, 61, 83, 7, 40, 2, 2,
-	62, 63, 7, 40, 2, 2, 63, 66, 7, 40, 2, 2, 64, 65, 7, 21, 2, 2, 65, 67,
-	5, 6, 4, 2, 66, 64, 3, 2, 2, 2, 66, 67, 3, 2, 2, 2, 67, 83, 3, 2, 2, 2,
-	68, 69, 7, 40, 2, 2, 69, 70, 5, 8, 5, 2, 70, 71, 5, 6, 4, 2, 71, 83, 3,
-	2, 2, 2, 72, 73, 7, 7, 2, 2, 73, 83, 5, 6, 4, 2, 74, 75, 7, 15, 2, 2, 75,
-	76, 7, 33, 2, 2, 76, 77, 5, 6, 4, 2, 77, 78, 7, 34, 2, 2, 78, 83, 3, 2,
-	2, 2, 79, 83, 7, 7, 2, 2, 80, 83, 7, 8, 2, 2, 81, 83, 7, 9, 2, 2, 82, 20,
-	3, 2, 2, 2, 82, 28, 3, 2, 2, 2, 82, 32, 3, 2, 2, 2, 82, 34, 3, 2, 2, 2,
-	82, 38, 3, 2, 2, 2, 82, 46, 3, 2, 2, 2, 82, 62, 3, 2, 2, 2, 82, 68, 3,
-	2, 2, 2, 82, 72, 3, 2, 2, 2, 82, 74, 3, 2, 2, 2, 82, 79, 3, 2, 2, 2, 82,
-	80, 3, 2, 2, 2, 82, 81, 3, 2, 2, 2, 83, 5, 3, 2, 2, 2, 84, 85, 8, 4, 1,
-	2, 85, 86, 7, 33, 2, 2, 86, 87, 5, 6, 4, 2, 87, 88, 7, 34, 2, 2, 88, 96,
-	3, 2, 2, 2, 89, 90, 7, 19, 2, 2, 90, 96, 5, 6, 4, 12, 91, 92, 7, 14, 2,
-	2, 92, 96, 5, 6, 4, 11, 93, 96, 7, 40, 2, 2, 94, 96, 9, 2, 2, 2, 95, 84,
-	3, 2, 2, 2, 95, 89, 3, 2, 2, 2, 95, 91, 3, 2, 2, 2, 95, 93, 3, 2, 2, 2,
-	95, 94, 3, 2, 2, 2, 96, 117, 3, 2, 2, 2, 97, 98, 12, 10, 2, 2, 98, 99,
-	9, 3, 2, 2, 99, 116, 5, 6, 4, 11, 100, 101, 12, 9, 2, 2, 101, 102, 9, 4,
-	2, 2, 102, 116, 5, 6, 4, 10, 103, 104, 12, 8, 2, 2, 104, 105, 9, 5, 2,
-	2, 105, 116, 5, 6, 4, 9, 106, 107, 12, 7, 2, 2, 107, 108, 9, 6, 2, 2, 108,
-	116, 5, 6, 4, 8, 109, 110, 12, 6, 2, 2, 110, 111, 7, 12, 2, 2, 111, 116,
-	5, 6, 4, 7, 112, 113, 12, 5, 2, 2, 113, 114, 7, 13, 2, 2, 114, 116, 5,
-	6, 4, 6, 115, 97, 3, 2, 2, 2, 115, 100, 3, 2, 2, 2, 115, 103, 3, 2, 2,
-	2, 115, 106, 3, 2, 2, 2, 115, 109, 3, 2, 2, 2, 115, 112, 3, 2, 2, 2, 116,
-	119, 3, 2, 2, 2, 117, 115, 3, 2, 2, 2, 117, 118, 3, 2, 2, 2, 118, 7, 3,
-	2, 2, 2, 119, 117, 3, 2, 2, 2, 120, 121, 9, 7, 2, 2, 121, 9, 3, 2, 2, 2,
-	122, 126, 7, 2, 2, 3, 123, 126, 6, 6, 8, 2, 124, 126, 6, 6, 9, 2, 125,
-	122, 3, 2, 2, 2, 125, 123, 3, 2, 2, 2, 125, 124, 3, 2, 2, 2, 126, 11, 3,
-	2, 2, 2, 12, 17, 24, 54, 57, 66, 82, 95, 115, 117, 125,
+	7, 4, 255, 10, 4, 12, 4, 14, 4, 258, 11, 4, 5, 4, 260, 10, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 7, 4, 272, 10, 4, 12,
+	4, 14, 4, 275, 11, 4, 5, 4, 277, 10, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 7, 4, 285, 10, 4, 12, 4, 14, 4, 288, 11, 4, 5, 4, 290, 10, 4, 3, 4,
+	3, 4, 3, 4, 3, 4, 3, 4, 7, 4, 297, 10, 4, 12, 4, 14, 4, 300, 11, 4, 5,
+	4, 302, 10, 4, 3, 4, 3, 4, 5, 4, 306, 10, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 5, 4, 316, 10, 4, 3, 4, 3, 4, 5, 4, 320, 10, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 7, 4, 331, 10, 4, 12,
+	4, 14, 4, 334, 11, 4, 5, 4, 336, 10, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 7, 4, 369, 10, 4, 12, 4, 14, 4, 372, 11, 4, 3, 5, 3, 5, 3, 5,
+	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 5, 5, 382, 10, 5, 3, 5, 7, 5, 385, 10, 5,
+	12, 5, 14, 5, 388, 11, 5, 5, 5, 390, 10, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3,
+	5, 7, 5, 397, 10, 5, 12, 5, 14, 5, 400, 11, 5, 5, 5, 402, 10, 5, 3, 5,
+	3, 5, 3, 5, 5, 5, 407, 10, 5, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 8,
+	3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 7, 9, 422, 10, 9, 12, 9, 14, 9, 425,
+	11, 9, 5, 9, 427, 10, 9, 3, 9, 5, 9, 430, 10, 9, 3, 10, 3, 10, 3, 10, 3,
+	10, 3, 10, 7, 10, 437, 10, 10, 12, 10, 14, 10, 440, 11, 10, 5, 10, 442,
+	10, 10, 3, 10, 5, 10, 445, 10, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3,
+	12, 3, 12, 3, 12, 3, 12, 7, 12, 456, 10, 12, 12, 12, 14, 12, 459, 11, 12,
+	3, 12, 5, 12, 462, 10, 12, 3, 12, 3, 12, 7, 12, 466, 10, 12, 12, 12, 14,
+	12, 469, 11, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15, 3, 15,
+	3, 15, 5, 15, 480, 10, 15, 3, 15, 2, 3, 6, 16, 2, 4, 6, 8, 10, 12, 14,
+	16, 18, 20, 22, 24, 26, 28, 2, 9, 4, 2, 26, 27, 72, 75, 4, 2, 32, 33, 36,
+	36, 3, 2, 34, 35, 3, 2, 40, 41, 3, 2, 56, 59, 3, 2, 54, 55, 4, 2, 42, 42,
+	44, 53, 2, 565, 2, 35, 3, 2, 2, 2, 4, 236, 3, 2, 2, 2, 6, 305, 3, 2, 2,
+	2, 8, 406, 3, 2, 2, 2, 10, 408, 3, 2, 2, 2, 12, 411, 3, 2, 2, 2, 14, 414,
+	3, 2, 2, 2, 16, 416, 3, 2, 2, 2, 18, 431, 3, 2, 2, 2, 20, 449, 3, 2, 2,
+	2, 22, 461, 3, 2, 2, 2, 24, 470, 3, 2, 2, 2, 26, 474, 3, 2, 2, 2, 28, 479,
+	3, 2, 2, 2, 30, 31, 5, 4, 3, 2, 31, 32, 5, 28, 15, 2, 32, 34, 3, 2, 2,
+	2, 33, 30, 3, 2, 2, 2, 34, 37, 3, 2, 2, 2, 35, 33, 3, 2, 2, 2, 35, 36,
+	3, 2, 2, 2, 36, 3, 3, 2, 2, 2, 37, 35, 3, 2, 2, 2, 38, 42, 7, 62, 2, 2,
+	39, 41, 5, 4, 3, 2, 40, 39, 3, 2, 2, 2, 41, 44, 3, 2, 2, 2, 42, 40, 3,
+	2, 2, 2, 42, 43, 3, 2, 2, 2, 43, 45, 3, 2, 2, 2, 44, 42, 3, 2, 2, 2, 45,
+	237, 7, 63, 2, 2, 46, 47, 7, 16, 2, 2, 47, 48, 5, 6, 4, 2, 48, 51, 5, 4,
+	3, 2, 49, 50, 7, 17, 2, 2, 50, 52, 5, 4, 3, 2, 51, 49, 3, 2, 2, 2, 51,
+	52, 3, 2, 2, 2, 52, 237, 3, 2, 2, 2, 53, 54, 7, 76, 2, 2, 54, 56, 7, 66,
+	2, 2, 55, 53, 3, 2, 2, 2, 55, 56, 3, 2, 2, 2, 56, 57, 3, 2, 2, 2, 57, 58,
+	7, 18, 2, 2, 58, 237, 5, 4, 3, 2, 59, 60, 7, 76, 2, 2, 60, 62, 7, 66, 2,
+	2, 61, 59, 3, 2, 2, 2, 61, 62, 3, 2, 2, 2, 62, 63, 3, 2, 2, 2, 63, 64,
+	7, 18, 2, 2, 64, 65, 5, 6, 4, 2, 65, 66, 5, 4, 3, 2, 66, 237, 3, 2, 2,
+	2, 67, 68, 7, 76, 2, 2, 68, 70, 7, 66, 2, 2, 69, 67, 3, 2, 2, 2, 69, 70,
+	3, 2, 2, 2, 70, 71, 3, 2, 2, 2, 71, 74, 7, 18, 2, 2, 72, 73, 7, 76, 2,
+	2, 73, 75, 7, 68, 2, 2, 74, 72, 3, 2, 2, 2, 74, 75, 3, 2, 2, 2, 75, 76,
+	3, 2, 2, 2, 76, 77, 7, 76, 2, 2, 77, 78, 7, 42, 2, 2, 78, 81, 5, 6, 4,
+	2, 79, 82, 7, 19, 2, 2, 80, 82, 7, 20, 2, 2, 81, 79, 3, 2, 2, 2, 81, 80,
+	3, 2, 2, 2, 82, 83, 3, 2, 2, 2, 83, 86, 5, 6, 4, 2, 84, 85, 7, 21, 2, 2,
+	85, 87, 5, 6, 4, 2, 86, 84, 3, 2, 2, 2, 86, 87, 3, 2, 2, 2, 87, 88, 3,
+	2, 2, 2, 88, 89, 5, 4, 3, 2, 89, 237, 3, 2, 2, 2, 90, 91, 7, 76, 2, 2,
+	91, 93, 7, 66, 2, 2, 92, 90, 3, 2, 2, 2, 92, 93, 3, 2, 2, 2, 93, 94, 3,
+	2, 2, 2, 94, 97, 7, 18, 2, 2, 95, 96, 7, 76, 2, 2, 96, 98, 7, 68, 2, 2,
+	97, 95, 3, 2, 2, 2, 97, 98, 3, 2, 2, 2, 98, 99, 3, 2, 2, 2, 99, 100, 7,
+	76, 2, 2, 100, 101, 7, 22, 2, 2, 101, 102, 5, 6, 4, 2, 102, 103, 5, 4,
+	3, 2, 103, 237, 3, 2, 2, 2, 104, 105, 7, 3, 2, 2, 105, 106, 7, 76, 2, 2,
+	106, 115, 7, 60, 2, 2, 107, 112, 5, 10, 6, 2, 108, 109, 7, 68, 2, 2, 109,
+	111, 5, 10, 6, 2, 110, 108, 3, 2, 2, 2, 111, 114, 3, 2, 2, 2, 112, 110,
+	3, 2, 2, 2, 112, 113, 3, 2, 2, 2, 113, 116, 3, 2, 2, 2, 114, 112, 3, 2,
+	2, 2, 115, 107, 3, 2, 2, 2, 115, 116, 3, 2, 2, 2, 116, 117, 3, 2, 2, 2,
+	117, 118, 7, 61, 2, 2, 118, 119, 7, 66, 2, 2, 119, 120, 5, 8, 5, 2, 120,
+	121, 5, 4, 3, 2, 121, 237, 3, 2, 2, 2, 122, 123, 7, 5, 2, 2, 123, 124,
+	7, 76, 2, 2, 124, 125, 7, 8, 2, 2, 125, 132, 7, 62, 2, 2, 126, 128, 5,
+	12, 7, 2, 127, 129, 7, 67, 2, 2, 128, 127, 3, 2, 2, 2, 128, 129, 3, 2,
+	2, 2, 129, 131, 3, 2, 2, 2, 130, 126, 3, 2, 2, 2, 131, 134, 3, 2, 2, 2,
+	132, 130, 3, 2, 2, 2, 132, 133, 3, 2, 2, 2, 133, 135, 3, 2, 2, 2, 134,
+	132, 3, 2, 2, 2, 135, 139, 7, 63, 2, 2, 136, 137, 7, 10, 2, 2, 137, 138,
+	7, 11, 2, 2, 138, 140, 7, 76, 2, 2, 139, 136, 3, 2, 2, 2, 139, 140, 3,
+	2, 2, 2, 140, 237, 3, 2, 2, 2, 141, 142, 7, 9, 2, 2, 142, 143, 7, 76, 2,
+	2, 143, 144, 7, 62, 2, 2, 144, 149, 5, 14, 8, 2, 145, 146, 7, 68, 2, 2,
+	146, 148, 5, 14, 8, 2, 147, 145, 3, 2, 2, 2, 148, 151, 3, 2, 2, 2, 149,
+	147, 3, 2, 2, 2, 149, 150, 3, 2, 2, 2, 150, 153, 3, 2, 2, 2, 151, 149,
+	3, 2, 2, 2, 152, 154, 7, 68, 2, 2, 153, 152, 3, 2, 2, 2, 153, 154, 3, 2,
+	2, 2, 154, 155, 3, 2, 2, 2, 155, 156, 7, 63, 2, 2, 156, 237, 3, 2, 2, 2,
+	157, 158, 7, 5, 2, 2, 158, 159, 7, 76, 2, 2, 159, 160, 7, 42, 2, 2, 160,
+	165, 5, 16, 9, 2, 161, 162, 7, 70, 2, 2, 162, 164, 5, 16, 9, 2, 163, 161,
+	3, 2, 2, 2, 164, 167, 3, 2, 2, 2, 165, 163, 3, 2, 2, 2, 165, 166, 3, 2,
+	2, 2, 166, 171, 3, 2, 2, 2, 167, 165, 3, 2, 2, 2, 168, 169, 7, 10, 2, 2,
+	169, 170, 7, 11, 2, 2, 170, 172, 7, 76, 2, 2, 171, 168, 3, 2, 2, 2, 171,
+	172, 3, 2, 2, 2, 172, 237, 3, 2, 2, 2, 173, 174, 7, 12, 2, 2, 174, 175,
+	5, 6, 4, 2, 175, 179, 7, 62, 2, 2, 176, 178, 5, 18, 10, 2, 177, 176, 3,
+	2, 2, 2, 178, 181, 3, 2, 2, 2, 179, 177, 3, 2, 2, 2, 179, 180, 3, 2, 2,
+	2, 180, 182, 3, 2, 2, 2, 181, 179, 3, 2, 2, 2, 182, 183, 7, 63, 2, 2, 183,
+	237, 3, 2, 2, 2, 184, 185, 7, 13, 2, 2, 185, 186, 5, 6, 4, 2, 186, 190,
+	7, 62, 2, 2, 187, 189, 5, 22, 12, 2, 188, 187, 3, 2, 2, 2, 189, 192, 3,
+	2, 2, 2, 190, 188, 3, 2, 2, 2, 190, 191, 3, 2, 2, 2, 191, 193, 3, 2, 2,
+	2, 192, 190, 3, 2, 2, 2, 193, 194, 7, 63, 2, 2, 194, 237, 3, 2, 2, 2, 195,
+	196, 5, 8, 5, 2, 196, 199, 7, 76, 2, 2, 197, 198, 7, 42, 2, 2, 198, 200,
+	5, 6, 4, 2, 199, 197, 3, 2, 2, 2, 199, 200, 3, 2, 2, 2, 200, 237, 3, 2,
+	2, 2, 201, 202, 7, 6, 2, 2, 202, 203, 5, 8, 5, 2, 203, 204, 7, 76, 2, 2,
+	204, 205, 7, 42, 2, 2, 205, 206, 5, 6, 4, 2, 206, 237, 3, 2, 2, 2, 207,
+	208, 7, 7, 2, 2, 208, 209, 7, 76, 2, 2, 209, 210, 7, 42, 2, 2, 210, 237,
+	5, 6, 4, 2, 211, 212, 7, 76, 2, 2, 212, 213, 7, 43, 2, 2, 213, 237, 5,
+	6, 4, 2, 214, 215, 5, 6, 4, 2, 215, 216, 5, 26, 14, 2, 216, 217, 5, 6,
+	4, 2, 217, 237, 3, 2, 2, 2, 218, 219, 7, 23, 2, 2, 219, 237, 5, 6, 4, 2,
+	220, 221, 7, 31, 2, 2, 221, 222, 7, 60, 2, 2, 222, 223, 5, 6, 4, 2, 223,
+	224, 7, 61, 2, 2, 224, 237, 3, 2, 2, 2, 225, 237, 7, 23, 2, 2, 226, 229,
+	7, 24, 2, 2, 227, 228, 6, 3, 2, 2, 228, 230, 7, 76, 2, 2, 229, 227, 3,
+	2, 2, 2, 229, 230, 3, 2, 2, 2, 230, 237, 3, 2, 2, 2, 231, 234, 7, 25, 2,
+	2, 232, 233, 6, 3, 3, 2, 233, 235, 7, 76, 2, 2, 234, 232, 3, 2, 2, 2, 234,
+	235, 3, 2, 2, 2, 235, 237, 3, 2, 2, 2, 236, 38, 3, 2, 2, 2, 236, 46, 3,
+	2, 2, 2, 236, 55, 3, 2, 2, 2, 236, 61, 3, 2, 2, 2, 236, 69, 3, 2, 2, 2,
+	236, 92, 3, 2, 2, 2, 236, 104, 3, 2, 2, 2, 236, 122, 3, 2, 2, 2, 236, 141,
+	3, 2, 2, 2, 236, 157, 3, 2, 2, 2, 236, 173, 3, 2, 2, 2, 236, 184, 3, 2,
+	2, 2, 236, 195, 3, 2, 2, 2, 236, 201, 3, 2, 2, 2, 236, 207, 3, 2, 2, 2,
+	236, 211, 3, 2, 2, 2, 236, 214, 3, 2, 2, 2, 236, 218, 3, 2, 2, 2, 236,
+	220, 3, 2, 2, 2, 236, 225, 3, 2, 2, 2, 236, 226, 3, 2, 2, 2, 236, 231,
+	3, 2, 2, 2, 237, 5, 3, 2, 2, 2, 238, 239, 8, 4, 1, 2, 239, 240, 7, 60,
+	2, 2, 240, 241, 5, 6, 4, 2, 241, 242, 7, 61, 2, 2, 242, 306, 3, 2, 2, 2,
+	243, 244, 7, 35, 2, 2, 244, 306, 5, 6, 4, 21, 245, 246, 7, 30, 2, 2, 246,
+	306, 5, 6, 4, 20, 247, 248, 7, 39, 2, 2, 248, 306, 5, 6, 4, 19, 249, 250,
+	7, 4, 2, 2, 250, 259, 7, 60, 2, 2, 251, 256, 5, 10, 6, 2, 252, 253, 7,
+	68, 2, 2, 253, 255, 5, 10, 6, 2, 254, 252, 3, 2, 2, 2, 255, 258, 3, 2,
+	2, 2, 256, 254, 3, 2, 2, 2, 256, 257, 3, 2, 2, 2, 257, 260, 3, 2, 2, 2,
+	258, 256, 3, 2, 2, 2, 259, 251, 3, 2, 2, 2, 259, 260, 3, 2, 2, 2, 260,
+	261, 3, 2, 2, 2, 261, 262, 7, 61, 2, 2, 262, 263, 7, 66, 2, 2, 263, 264,
+	5, 8, 5, 2, 264, 265, 5, 4, 3, 2, 265, 306, 3, 2, 2, 2, 266, 267, 7, 76,
+	2, 2, 267, 276, 7, 60, 2, 2, 268, 273, 5, 6, 4, 2, 269, 270, 7, 68, 2,
+	2, 270, 272, 5, 6, 4, 2, 271, 269, 3, 2, 2, 2, 272, 275, 3, 2, 2, 2, 273,
+	271, 3, 2, 2, 2, 273, 274, 3, 2, 2, 2, 274, 277, 3, 2, 2, 2, 275, 273,
+	3, 2, 2, 2, 276, 268, 3, 2, 2, 2, 276, 277, 3, 2, 2, 2, 277, 278, 3, 2,
+	2, 2, 278, 306, 7, 61, 2, 2, 279, 306, 7, 76, 2, 2, 280, 289, 7, 64, 2,
+	2, 281, 286, 5, 6, 4, 2, 282, 283, 7, 68, 2, 2, 283, 285, 5, 6, 4, 2, 284,
+	282, 3, 2, 2, 2, 285, 288, 3, 2, 2, 2, 286, 284, 3, 2, 2, 2, 286, 287,
+	3, 2, 2, 2, 287, 290, 3, 2, 2, 2, 288, 286, 3, 2, 2, 2, 289, 281, 3, 2,
+	2, 2, 289, 290, 3, 2, 2, 2, 290, 291, 3, 2, 2, 2, 291, 306, 7, 65, 2, 2,
+	292, 301, 7, 62, 2, 2, 293, 298, 5, 24, 13, 2, 294, 295, 7, 68, 2, 2, 295,
+	297, 5, 24, 13, 2, 296, 294, 3, 2, 2, 2, 297, 300, 3, 2, 2, 2, 298, 296,
+	3, 2, 2, 2, 298, 299, 3, 2, 2, 2, 299, 302, 3, 2, 2, 2, 300, 298, 3, 2,
+	2, 2, 301, 293, 3, 2, 2, 2, 301, 302, 3, 2, 2, 2, 302, 303, 3, 2, 2, 2,
+	303, 306, 7, 63, 2, 2, 304, 306, 9, 2, 2, 2, 305, 238, 3, 2, 2, 2, 305,
+	243, 3, 2, 2, 2, 305, 245, 3, 2, 2, 2, 305, 247, 3, 2, 2, 2, 305, 249,
+	3, 2, 2, 2, 305, 266, 3, 2, 2, 2, 305, 279, 3, 2, 2, 2, 305, 280, 3, 2,
+	2, 2, 305, 292, 3, 2, 2, 2, 305, 304, 3, 2, 2, 2, 306, 370, 3, 2, 2, 2,
+	307, 308, 12, 25, 2, 2, 308, 309, 7, 64, 2, 2, 309, 310, 5, 6, 4, 2, 310,
+	311, 7, 65, 2, 2, 311, 369, 3, 2, 2, 2, 312, 313, 12, 24, 2, 2, 313, 315,
+	7, 64, 2, 2, 314, 316, 5, 6, 4, 2, 315, 314, 3, 2, 2, 2, 315, 316, 3, 2,
+	2, 2, 316, 317, 3, 2, 2, 2, 317, 319, 7, 66, 2, 2, 318, 320, 5, 6, 4, 2,
+	319, 318, 3, 2, 2, 2, 319, 320, 3, 2, 2, 2, 320, 321, 3, 2, 2, 2, 321,
+	369, 7, 65, 2, 2, 322, 323, 12, 23, 2, 2, 323, 324, 7, 69, 2, 2, 324, 369,
+	7, 76, 2, 2, 325, 326, 12, 22, 2, 2, 326, 335, 7, 60, 2, 2, 327, 332, 5,
+	6, 4, 2, 328, 329, 7, 68, 2, 2, 329, 331, 5, 6, 4, 2, 330, 328, 3, 2, 2,
+	2, 331, 334, 3, 2, 2, 2, 332, 330, 3, 2, 2, 2, 332, 333, 3, 2, 2, 2, 333,
+	336, 3, 2, 2, 2, 334, 332, 3, 2, 2, 2, 335, 327, 3, 2, 2, 2, 335, 336,
+	3, 2, 2, 2, 336, 337, 3, 2, 2, 2, 337, 369, 7, 61, 2, 2, 338, 339, 12,
+	18, 2, 2, 339, 340, 9, 3, 2, 2, 340, 369, 5, 6, 4, 19, 341, 342, 12, 17,
+	2, 2, 342, 343, 9, 4, 2, 2, 343, 369, 5, 6, 4, 18, 344, 345, 12, 16, 2,
+	2, 345, 346, 9, 5, 2, 2, 346, 369, 5, 6, 4, 17, 347, 348, 12, 15, 2, 2,
+	348, 349, 7, 37, 2, 2, 349, 369, 5, 6, 4, 16, 350, 351, 12, 14, 2, 2, 351,
+	352, 7, 38, 2, 2, 352, 369, 5, 6, 4, 15, 353, 354, 12, 13, 2, 2, 354, 355,
+	7, 70, 2, 2, 355, 369, 5, 6, 4, 14, 356, 357, 12, 12, 2, 2, 357, 358, 9,
+	6, 2, 2, 358, 369, 5, 6, 4, 13, 359, 360, 12, 11, 2, 2, 360, 361, 9, 7,
+	2, 2, 361, 369, 5, 6, 4, 12, 362, 363, 12, 10, 2, 2, 363, 364, 7, 28, 2,
+	2, 364, 369, 5, 6, 4, 11, 365, 366, 12, 9, 2, 2, 366, 367, 7, 29, 2, 2,
+	367, 369, 5, 6, 4, 10, 368, 307, 3, 2, 2, 2, 368, 312, 3, 2, 2, 2, 368,
+	322, 3, 2, 2, 2, 368, 325, 3, 2, 2, 2, 368, 338, 3, 2, 2, 2, 368, 341,
+	3, 2, 2, 2, 368, 344, 3, 2, 2, 2, 368, 347, 3, 2, 2, 2, 368, 350, 3, 2,
+	2, 2, 368, 353, 3, 2, 2, 2, 368, 356, 3, 2, 2, 2, 368, 359, 3, 2, 2, 2,
+	368, 362, 3, 2, 2, 2, 368, 365, 3, 2, 2, 2, 369, 372, 3, 2, 2, 2, 370,
+	368, 3, 2, 2, 2, 370, 371, 3, 2, 2, 2, 371, 7, 3, 2, 2, 2, 372, 370, 3,
+	2, 2, 2, 373, 389, 7, 76, 2, 2, 374, 375, 7, 64, 2, 2, 375, 376, 5, 8,
+	5, 2, 376, 377, 7, 65, 2, 2, 377, 378, 5, 8, 5, 2, 378, 390, 3, 2, 2, 2,
+	379, 381, 7, 64, 2, 2, 380, 382, 7, 72, 2, 2, 381, 380, 3, 2, 2, 2, 381,
+	382, 3, 2, 2, 2, 382, 383, 3, 2, 2, 2, 383, 385, 7, 65, 2, 2, 384, 379,
+	3, 2, 2, 2, 385, 388, 3, 2, 2, 2, 386, 384, 3, 2, 2, 2, 386, 387, 3, 2,
+	2, 2, 387, 390, 3, 2, 2, 2, 388, 386, 3, 2, 2, 2, 389, 374, 3, 2, 2, 2,
+	389, 386, 3, 2, 2, 2, 390, 407, 3, 2, 2, 2, 391, 392, 7, 4, 2, 2, 392,
+	401, 7, 60, 2, 2, 393, 398, 5, 8, 5, 2, 394, 395, 7, 68, 2, 2, 395, 397,
+	5, 8, 5, 2, 396, 394, 3, 2, 2, 2, 397, 400, 3, 2, 2, 2, 398, 396, 3, 2,
+	2, 2, 398, 399, 3, 2, 2, 2, 399, 402, 3, 2, 2, 2, 400, 398, 3, 2, 2, 2,
+	401, 393, 3, 2, 2, 2, 401, 402, 3, 2, 2, 2, 402, 403, 3, 2, 2, 2, 403,
+	404, 7, 61, 2, 2, 404, 405, 7, 66, 2, 2, 405, 407, 5, 8, 5, 2, 406, 373,
+	3, 2, 2, 2, 406, 391, 3, 2, 2, 2, 407, 9, 3, 2, 2, 2, 408, 409, 5, 8, 5,
+	2, 409, 410, 7, 76, 2, 2, 410, 11, 3, 2, 2, 2, 411, 412, 5, 8, 5, 2, 412,
+	413, 7, 76, 2, 2, 413, 13, 3, 2, 2, 2, 414, 415, 7, 76, 2, 2, 415, 15,
+	3, 2, 2, 2, 416, 429, 7, 76, 2, 2, 417, 426, 7, 60, 2, 2, 418, 423, 5,
+	12, 7, 2, 419, 420, 7, 68, 2, 2, 420, 422, 5, 12, 7, 2, 421, 419, 3, 2,
+	2, 2, 422, 425, 3, 2, 2, 2, 423, 421, 3, 2, 2, 2, 423, 424, 3, 2, 2, 2,
+	424, 427, 3, 2, 2, 2, 425, 423, 3, 2, 2, 2, 426, 418, 3, 2, 2, 2, 426,
+	427, 3, 2, 2, 2, 427, 428, 3, 2, 2, 2, 428, 430, 7, 61, 2, 2, 429, 417,
+	3, 2, 2, 2, 429, 430, 3, 2, 2, 2, 430, 17, 3, 2, 2, 2, 431, 444, 7, 76,
+	2, 2, 432, 441, 7, 60, 2, 2, 433, 438, 5, 20, 11, 2, 434, 435, 7, 68, 2,
+	2, 435, 437, 5, 20, 11, 2, 436, 434, 3, 2, 2, 2, 437, 440, 3, 2, 2, 2,
+	438, 436, 3, 2, 2, 2, 438, 439, 3, 2, 2, 2, 439, 442, 3, 2, 2, 2, 440,
+	438, 3, 2, 2, 2, 441, 433, 3, 2, 2, 2, 441, 442, 3, 2, 2, 2, 442, 443,
+	3, 2, 2, 2, 443, 445, 7, 61, 2, 2, 444, 432, 3, 2, 2, 2, 444, 445, 3, 2,
+	2, 2, 445, 446, 3, 2, 2, 2, 446, 447, 7, 71, 2, 2, 447, 448, 5, 4, 3, 2,
+	448, 19, 3, 2, 2, 2, 449, 450, 7, 76, 2, 2, 450, 21, 3, 2, 2, 2, 451, 452,
+	7, 14, 2, 2, 452, 457, 5, 6, 4, 2, 453, 454, 7, 68, 2, 2, 454, 456, 5,
+	6, 4, 2, 455, 453, 3, 2, 2, 2, 456, 459, 3, 2, 2, 2, 457, 455, 3, 2, 2,
+	2, 457, 458, 3, 2, 2, 2, 458, 462, 3, 2, 2, 2, 459, 457, 3, 2, 2, 2, 460,
+	462, 7, 15, 2, 2, 461, 451, 3, 2, 2, 2, 461, 460, 3, 2, 2, 2, 462, 463,
+	3, 2, 2, 2, 463, 467, 7, 66, 2, 2, 464, 466, 5, 4, 3, 2, 465, 464, 3, 2,
+	2, 2, 466, 469, 3, 2, 2, 2, 467, 465, 3, 2, 2, 2, 467, 468, 3, 2, 2, 2,
+	468, 23, 3, 2, 2, 2, 469, 467, 3, 2, 2, 2, 470, 471, 5, 6, 4, 2, 471, 472,
+	7, 66, 2, 2, 472, 473, 5, 6, 4, 2, 473, 25, 3, 2, 2, 2, 474, 475, 9, 8,
+	2, 2, 475, 27, 3, 2, 2, 2, 476, 480, 7, 2, 2, 3, 477, 480, 6, 15, 18, 2,
+	478, 480, 6, 15, 19, 2, 479, 476, 3, 2, 2, 2, 479, 477, 3, 2, 2, 2, 479,
+	478, 3, 2, 2, 2, 480, 29, 3, 2, 2, 2, 59, 35, 42, 51, 55, 61, 69, 74, 81,
+	86, 92, 97, 112, 115, 128, 132, 139, 149, 153, 165, 171, 179, 190, 199,
+	229, 234, 236, 256, 259, 273, 276, 286, 289, 298, 301, 305, 315, 319, 332,
+	335, 368, 370, 381, 386, 389, 398, 401, 406, 423, 426, 429, 438, 441, 444,
+	457, 461, 467, 479,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "'function'", "'if'", "'loop'", "'to'", "'return'", "'break'", "'continue'",
-	"'true'", "'false'", "'and'", "'or'", "'not'", "'print'", "'*'", "'/'",
-	"'+'", "'-'", "'%'", "'='", "'+='", "'-='", "'*='", "'/='", "'%='", "'=='",
-	"'!='", "'>'", "'<'", "'>='", "'<='", "'('", "')'", "'{'", "'}'", "':'",
-	"','",
+	"", "'function'", "'fn'", "'type'", "'const'", "'var'", "'struct'", "'enum'",
+	"'ordered'", "'by'", "'match'", "'switch'", "'case'", "'default'", "'if'",
+	"'else'", "'loop'", "'to'", "'through'", "'step'", "'in'", "'return'",
+	"'break'", "'continue'", "'true'", "'false'", "'and'", "'or'", "'not'",
+	"'print'", "'*'", "'/'", "'+'", "'-'", "'%'", "'&'", "'^'", "'~'", "'<<'",
+	"'>>'", "'='", "':='", "'+='", "'-='", "'*='", "'/='", "'%='", "'&='",
+	"'|='", "'^='", "'<<='", "'>>='", "'=='", "'!='", "'>'", "'<'", "'>='",
+	"'<='", "'('", "')'", "'{'", "'}'", "'['", "']'", "':'", "';'", "','",
+	"'.'", "'|'", "'=>'",
 }
 var symbolicNames = []string{
-	"", "FUNCTION", "IF", "LOOP", "TO", "RETURN", "BREAK", "CONTINUE", "TRUE",
-	"FALSE", "AND", "OR", "NOT", "PRINT", "MULTIPLY", "DIVIDE", "ADD", "SUBTRACT",
-	"MODULO", "ASSIGNMENT", "ADD_ASSIGNMENT", "SUB_ASSIGNMENT", "MUL_ASSIGNMENT",
-	"DIV_ASSIGNMENT", "MOD_ASSIGNMENT", "EQUALS", "NOT_EQUALS", "GREATER",
-	"LESSER", "GREATER_OR_EQUAL", "LESSER_OR_EQUAL", "LPAREN", "RPAREN", "LBRACE",
-	"RBRACE", "COLON", "COMMA", "NUMBER", "IDENTIFIER", "NEWLINE", "WHITESPACE",
-	"LINE_COMMENT", "BLOCK_COMMENT",
+	"", "FUNCTION", "FN", "TYPE", "CONST", "VAR", "STRUCT", "ENUM", "ORDERED",
+	"BY", "MATCH", "SWITCH", "CASE", "DEFAULT", "IF", "ELSE", "LOOP", "TO",
+	"THROUGH", "STEP", "IN", "RETURN", "BREAK", "CONTINUE", "TRUE", "FALSE",
+	"AND", "OR", "NOT", "PRINT", "MULTIPLY", "DIVIDE", "ADD", "SUBTRACT", "MODULO",
+	"BITWISE_AND", "BITWISE_XOR", "BITWISE_NOT", "LEFT_SHIFT", "RIGHT_SHIFT",
+	"ASSIGNMENT", "DECLARE_ASSIGNMENT", "ADD_ASSIGNMENT", "SUB_ASSIGNMENT",
+	"MUL_ASSIGNMENT", "DIV_ASSIGNMENT", "MOD_ASSIGNMENT", "BITWISE_AND_ASSIGNMENT",
+	"BITWISE_OR_ASSIGNMENT", "BITWISE_XOR_ASSIGNMENT", "LEFT_SHIFT_ASSIGNMENT",
+	"RIGHT_SHIFT_ASSIGNMENT", "EQUALS", "NOT_EQUALS", "GREATER", "LESSER",
+	"GREATER_OR_EQUAL", "LESSER_OR_EQUAL", "LPAREN", "RPAREN", "LBRACE", "RBRACE",
+	"LBRACKET", "RBRACKET", "COLON", "SEMICOLON", "COMMA", "DOT", "PIPE", "ARROW",
+	"NUMBER", "MULTILINE_STRING", "STRING", "RAW_STRING", "IDENTIFIER", "NEWLINE",
+	"WHITESPACE", "LINE_COMMENT", "BLOCK_COMMENT",
 }
 
 var ruleNames = []string{
-	"start", "statement", "expression", "assignment_op", "eos",
+	"start", "statement", "expression", "typeSpec", "parameter", "structField",
+	"enumMember", "unionVariant", "matchCase", "matchBinding", "switchCase",
+	"mapEntry", "assignment_op", "eos",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -125,49 +306,85 @@ func NewSimParser(input antlr.TokenStream) *SimParser {
 
 // SimParser tokens.
 const (
-	SimParserEOF              = antlr.TokenEOF
-	SimParserFUNCTION         = 1
-	SimParserIF               = 2
-	SimParserLOOP             = 3
-	SimParserTO               = 4
-	SimParserRETURN           = 5
-	SimParserBREAK            = 6
-	SimParserCONTINUE         = 7
-	SimParserTRUE             = 8
-	SimParserFALSE            = 9
-	SimParserAND              = 10
-	SimParserOR               = 11
-	SimParserNOT              = 12
-	SimParserPRINT            = 13
-	SimParserMULTIPLY         = 14
-	SimParserDIVIDE           = 15
-	SimParserADD              = 16
-	SimParserSUBTRACT         = 17
-	SimParserMODULO           = 18
-	SimParserASSIGNMENT       = 19
-	SimParserADD_ASSIGNMENT   = 20
-	SimParserSUB_ASSIGNMENT   = 21
-	SimParserMUL_ASSIGNMENT   = 22
-	SimParserDIV_ASSIGNMENT   = 23
-	SimParserMOD_ASSIGNMENT   = 24
-	SimParserEQUALS           = 25
-	SimParserNOT_EQUALS       = 26
-	SimParserGREATER          = 27
-	SimParserLESSER           = 28
-	SimParserGREATER_OR_EQUAL = 29
-	SimParserLESSER_OR_EQUAL  = 30
-	SimParserLPAREN           = 31
-	SimParserRPAREN           = 32
-	SimParserLBRACE           = 33
-	SimParserRBRACE           = 34
-	SimParserCOLON            = 35
-	SimParserCOMMA            = 36
-	SimParserNUMBER           = 37
-	SimParserIDENTIFIER       = 38
-	SimParserNEWLINE          = 39
-	SimParserWHITESPACE       = 40
-	SimParserLINE_COMMENT     = 41
-	SimParserBLOCK_COMMENT    = 42
+	SimParserEOF                    = antlr.TokenEOF
+	SimParserFUNCTION               = 1
+	SimParserFN                     = 2
+	SimParserTYPE                   = 3
+	SimParserCONST                  = 4
+	SimParserVAR                    = 5
+	SimParserSTRUCT                 = 6
+	SimParserENUM                   = 7
+	SimParserORDERED                = 8
+	SimParserBY                     = 9
+	SimParserMATCH                  = 10
+	SimParserSWITCH                 = 11
+	SimParserCASE                   = 12
+	SimParserDEFAULT                = 13
+	SimParserIF                     = 14
+	SimParserELSE                   = 15
+	SimParserLOOP                   = 16
+	SimParserTO                     = 17
+	SimParserTHROUGH                = 18
+	SimParserSTEP                   = 19
+	SimParserIN                     = 20
+	SimParserRETURN                 = 21
+	SimParserBREAK                  = 22
+	SimParserCONTINUE               = 23
+	SimParserTRUE                   = 24
+	SimParserFALSE                  = 25
+	SimParserAND                    = 26
+	SimParserOR                     = 27
+	SimParserNOT                    = 28
+	SimParserPRINT                  = 29
+	SimParserMULTIPLY               = 30
+	SimParserDIVIDE                 = 31
+	SimParserADD                    = 32
+	SimParserSUBTRACT               = 33
+	SimParserMODULO                 = 34
+	SimParserBITWISE_AND            = 35
+	SimParserBITWISE_XOR            = 36
+	SimParserBITWISE_NOT            = 37
+	SimParserLEFT_SHIFT             = 38
+	SimParserRIGHT_SHIFT            = 39
+	SimParserASSIGNMENT             = 40
+	SimParserDECLARE_ASSIGNMENT     = 41
+	SimParserADD_ASSIGNMENT         = 42
+	SimParserSUB_ASSIGNMENT         = 43
+	SimParserMUL_ASSIGNMENT         = 44
+	SimParserDIV_ASSIGNMENT         = 45
+	SimParserMOD_ASSIGNMENT         = 46
+	SimParserBITWISE_AND_ASSIGNMENT = 47
+	SimParserBITWISE_OR_ASSIGNMENT  = 48
+	SimParserBITWISE_XOR_ASSIGNMENT = 49
+	SimParserLEFT_SHIFT_ASSIGNMENT  = 50
+	SimParserRIGHT_SHIFT_ASSIGNMENT = 51
+	SimParserEQUALS                 = 52
+	SimParserNOT_EQUALS             = 53
+	SimParserGREATER                = 54
+	SimParserLESSER                 = 55
+	SimParserGREATER_OR_EQUAL       = 56
+	SimParserLESSER_OR_EQUAL        = 57
+	SimParserLPAREN                 = 58
+	SimParserRPAREN                 = 59
+	SimParserLBRACE                 = 60
+	SimParserRBRACE                 = 61
+	SimParserLBRACKET               = 62
+	SimParserRBRACKET               = 63
+	SimParserCOLON                  = 64
+	SimParserSEMICOLON              = 65
+	SimParserCOMMA                  = 66
+	SimParserDOT                    = 67
+	SimParserPIPE                   = 68
+	SimParserARROW                  = 69
+	SimParserNUMBER                 = 70
+	SimParserMULTILINE_STRING       = 71
+	SimParserSTRING                 = 72
+	SimParserRAW_STRING             = 73
+	SimParserIDENTIFIER             = 74
+	SimParserNEWLINE                = 75
+	SimParserWHITESPACE             = 76
+	SimParserLINE_COMMENT           = 77
+	SimParserBLOCK_COMMENT          = 78
 )
 
 // SimParser rules.
@@ -175,8 +392,17 @@ const (
 	SimParserRULE_start         = 0
 	SimParserRULE_statement     = 1
 	SimParserRULE_expression    = 2
-	SimParserRULE_assignment_op = 3
-	SimParserRULE_eos           = 4
+	SimParserRULE_typeSpec      = 3
+	SimParserRULE_parameter     = 4
+	SimParserRULE_structField   = 5
+	SimParserRULE_enumMember    = 6
+	SimParserRULE_unionVariant  = 7
+	SimParserRULE_matchCase     = 8
+	SimParserRULE_matchBinding  = 9
+	SimParserRULE_switchCase    = 10
+	SimParserRULE_mapEntry      = 11
+	SimParserRULE_assignment_op = 12
+	SimParserRULE_eos           = 13
 )
 
 // IStartContext is an interface to support dynamic dispatch.
@@ -315,21 +541,21 @@ func (p *SimParser) Start() (localctx IStartContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(15)
+	p.SetState(33)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFUNCTION)|(1<<SimParserIF)|(1<<SimParserLOOP)|(1<<SimParserRETURN)|(1<<SimParserBREAK)|(1<<SimParserCONTINUE)|(1<<SimParserPRINT))) != 0) || _la == SimParserLBRACE || _la == SimParserIDENTIFIER {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFUNCTION)|(1<<SimParserFN)|(1<<SimParserTYPE)|(1<<SimParserCONST)|(1<<SimParserVAR)|(1<<SimParserENUM)|(1<<SimParserMATCH)|(1<<SimParserSWITCH)|(1<<SimParserIF)|(1<<SimParserLOOP)|(1<<SimParserRETURN)|(1<<SimParserBREAK)|(1<<SimParserCONTINUE)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT)|(1<<SimParserPRINT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
 		{
-			p.SetState(10)
+			p.SetState(28)
 			p.Statement()
 		}
 		{
-			p.SetState(11)
+			p.SetState(29)
 			p.Eos()
 		}
 
-		p.SetState(17)
+		p.SetState(35)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -387,12 +613,13 @@ func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recogniz
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-type BreakStatementContext struct {
+type MatchStatementContext struct {
 	*StatementContext
+	value IExpressionContext
 }
 
-func NewBreakStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BreakStatementContext {
-	var p = new(BreakStatementContext)
+func NewMatchStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MatchStatementContext {
+	var p = new(MatchStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -401,42 +628,88 @@ func NewBreakStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *BreakStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchStatementContext) GetValue() IExpressionContext { return s.value }
+
+func (s *MatchStatementContext) SetValue(v IExpressionContext) { s.value = v }
+
+func (s *MatchStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BreakStatementContext) BREAK() antlr.TerminalNode {
-	return s.GetToken(SimParserBREAK, 0)
+func (s *MatchStatementContext) MATCH() antlr.TerminalNode {
+	return s.GetToken(SimParserMATCH, 0)
 }
 
-func (s *BreakStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchStatementContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACE, 0)
+}
+
+func (s *MatchStatementContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACE, 0)
+}
+
+func (s *MatchStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MatchStatementContext) AllMatchCase() []IMatchCaseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMatchCaseContext)(nil)).Elem())
+	var tst = make([]IMatchCaseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMatchCaseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MatchStatementContext) MatchCase(i int) IMatchCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchCaseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchCaseContext)
+}
+
+func (s *MatchStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterBreakStatement(s)
+		listenerT.EnterMatchStatement(s)
 	}
 }
 
-func (s *BreakStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitBreakStatement(s)
+		listenerT.ExitMatchStatement(s)
 	}
 }
 
-func (s *BreakStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MatchStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitBreakStatement(s)
+		return t.VisitMatchStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type IfStatementContext struct {
+type InfiniteLoopStatementContext struct {
 	*StatementContext
+	label antlr.Token
 }
 
-func NewIfStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IfStatementContext {
-	var p = new(IfStatementContext)
+func NewInfiniteLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InfiniteLoopStatementContext {
+	var p = new(InfiniteLoopStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -445,62 +718,64 @@ func NewIfStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *If
 	return p
 }
 
-func (s *IfStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *InfiniteLoopStatementContext) GetLabel() antlr.Token { return s.label }
+
+func (s *InfiniteLoopStatementContext) SetLabel(v antlr.Token) { s.label = v }
+
+func (s *InfiniteLoopStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IfStatementContext) IF() antlr.TerminalNode {
-	return s.GetToken(SimParserIF, 0)
+func (s *InfiniteLoopStatementContext) LOOP() antlr.TerminalNode {
+	return s.GetToken(SimParserLOOP, 0)
 }
 
-func (s *IfStatementContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *InfiniteLoopStatementContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IStatementContext)
 }
 
-func (s *IfStatementContext) Statement() IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *InfiniteLoopStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
+}
 
-	return t.(IStatementContext)
+func (s *InfiniteLoopStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *IfStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InfiniteLoopStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterIfStatement(s)
+		listenerT.EnterInfiniteLoopStatement(s)
 	}
 }
 
-func (s *IfStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InfiniteLoopStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitIfStatement(s)
+		listenerT.ExitInfiniteLoopStatement(s)
 	}
 }
 
-func (s *IfStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *InfiniteLoopStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitIfStatement(s)
+		return t.VisitInfiniteLoopStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ConditionalLoopStatementContext struct {
+type BlockStatementContext struct {
 	*StatementContext
 }
 
-func NewConditionalLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ConditionalLoopStatementContext {
-	var p = new(ConditionalLoopStatementContext)
+func NewBlockStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BlockStatementContext {
+	var p = new(BlockStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -509,26 +784,33 @@ func NewConditionalLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRul
 	return p
 }
 
-func (s *ConditionalLoopStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *BlockStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConditionalLoopStatementContext) LOOP() antlr.TerminalNode {
-	return s.GetToken(SimParserLOOP, 0)
+func (s *BlockStatementContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACE, 0)
 }
 
-func (s *ConditionalLoopStatementContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *BlockStatementContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACE, 0)
+}
 
-	if t == nil {
-		return nil
+func (s *BlockStatementContext) AllStatement() []IStatementContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementContext)(nil)).Elem())
+	var tst = make([]IStatementContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IStatementContext)
+		}
 	}
 
-	return t.(IExpressionContext)
+	return tst
 }
 
-func (s *ConditionalLoopStatementContext) Statement() IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+func (s *BlockStatementContext) Statement(i int) IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -537,34 +819,41 @@ func (s *ConditionalLoopStatementContext) Statement() IStatementContext {
 	return t.(IStatementContext)
 }
 
-func (s *ConditionalLoopStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BlockStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterConditionalLoopStatement(s)
+		listenerT.EnterBlockStatement(s)
 	}
 }
 
-func (s *ConditionalLoopStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BlockStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitConditionalLoopStatement(s)
+		listenerT.ExitBlockStatement(s)
 	}
 }
 
-func (s *ConditionalLoopStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BlockStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitConditionalLoopStatement(s)
+		return t.VisitBlockStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type FunctionStatementContext struct {
+type LoopStatementContext struct {
 	*StatementContext
+	label     antlr.Token
+	indexName antlr.Token
+	varName   antlr.Token
+	min       IExpressionContext
+	inclusive antlr.Token
+	max       IExpressionContext
+	step      IExpressionContext
 }
 
-func NewFunctionStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FunctionStatementContext {
-	var p = new(FunctionStatementContext)
+func NewLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LoopStatementContext {
+	var p = new(LoopStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -573,35 +862,65 @@ func NewFunctionStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContex
 	return p
 }
 
-func (s *FunctionStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *LoopStatementContext) GetLabel() antlr.Token { return s.label }
+
+func (s *LoopStatementContext) GetIndexName() antlr.Token { return s.indexName }
+
+func (s *LoopStatementContext) GetVarName() antlr.Token { return s.varName }
+
+func (s *LoopStatementContext) GetInclusive() antlr.Token { return s.inclusive }
+
+func (s *LoopStatementContext) SetLabel(v antlr.Token) { s.label = v }
+
+func (s *LoopStatementContext) SetIndexName(v antlr.Token) { s.indexName = v }
+
+func (s *LoopStatementContext) SetVarName(v antlr.Token) { s.varName = v }
+
+func (s *LoopStatementContext) SetInclusive(v antlr.Token) { s.inclusive = v }
+
+func (s *LoopStatementContext) GetMin() IExpressionContext { return s.min }
+
+func (s *LoopStatementContext) GetMax() IExpressionContext { return s.max }
+
+func (s *LoopStatementContext) GetStep() IExpressionContext { return s.step }
+
+func (s *LoopStatementContext) SetMin(v IExpressionContext) { s.min = v }
+
+func (s *LoopStatementContext) SetMax(v IExpressionContext) { s.max = v }
+
+func (s *LoopStatementContext) SetStep(v IExpressionContext) { s.step = v }
+
+func (s *LoopStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionStatementContext) FUNCTION() antlr.TerminalNode {
-	return s.GetToken(SimParserFUNCTION, 0)
+func (s *LoopStatementContext) LOOP() antlr.TerminalNode {
+	return s.GetToken(SimParserLOOP, 0)
 }
 
-func (s *FunctionStatementContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(SimParserIDENTIFIER)
+func (s *LoopStatementContext) ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserASSIGNMENT, 0)
 }
 
-func (s *FunctionStatementContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(SimParserIDENTIFIER, i)
-}
+func (s *LoopStatementContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
 
-func (s *FunctionStatementContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(SimParserLPAREN, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
 }
 
-func (s *FunctionStatementContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(SimParserRPAREN, 0)
+func (s *LoopStatementContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(SimParserIDENTIFIER)
 }
 
-func (s *FunctionStatementContext) COLON() antlr.TerminalNode {
-	return s.GetToken(SimParserCOLON, 0)
+func (s *LoopStatementContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, i)
 }
 
-func (s *FunctionStatementContext) AllExpression() []IExpressionContext {
+func (s *LoopStatementContext) AllExpression() []IExpressionContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
 	var tst = make([]IExpressionContext, len(ts))
 
@@ -614,7 +933,7 @@ func (s *FunctionStatementContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *FunctionStatementContext) Expression(i int) IExpressionContext {
+func (s *LoopStatementContext) Expression(i int) IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -624,43 +943,56 @@ func (s *FunctionStatementContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *FunctionStatementContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(SimParserCOMMA)
+func (s *LoopStatementContext) TO() antlr.TerminalNode {
+	return s.GetToken(SimParserTO, 0)
 }
 
-func (s *FunctionStatementContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SimParserCOMMA, i)
+func (s *LoopStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
 }
 
-func (s *FunctionStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LoopStatementContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, 0)
+}
+
+func (s *LoopStatementContext) THROUGH() antlr.TerminalNode {
+	return s.GetToken(SimParserTHROUGH, 0)
+}
+
+func (s *LoopStatementContext) STEP() antlr.TerminalNode {
+	return s.GetToken(SimParserSTEP, 0)
+}
+
+func (s *LoopStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterFunctionStatement(s)
+		listenerT.EnterLoopStatement(s)
 	}
 }
 
-func (s *FunctionStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LoopStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitFunctionStatement(s)
+		listenerT.ExitLoopStatement(s)
 	}
 }
 
-func (s *FunctionStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LoopStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitFunctionStatement(s)
+		return t.VisitLoopStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type AssignmentStatementContext struct {
+type UnionStatementContext struct {
 	*StatementContext
-	varName antlr.Token
+	typeName    antlr.Token
+	compareName antlr.Token
 }
 
-func NewAssignmentStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AssignmentStatementContext {
-	var p = new(AssignmentStatementContext)
+func NewUnionStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnionStatementContext {
+	var p = new(UnionStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -669,67 +1001,103 @@ func NewAssignmentStatementContext(parser antlr.Parser, ctx antlr.ParserRuleCont
 	return p
 }
 
-func (s *AssignmentStatementContext) GetVarName() antlr.Token { return s.varName }
+func (s *UnionStatementContext) GetTypeName() antlr.Token { return s.typeName }
 
-func (s *AssignmentStatementContext) SetVarName(v antlr.Token) { s.varName = v }
+func (s *UnionStatementContext) GetCompareName() antlr.Token { return s.compareName }
 
-func (s *AssignmentStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *UnionStatementContext) SetTypeName(v antlr.Token) { s.typeName = v }
+
+func (s *UnionStatementContext) SetCompareName(v antlr.Token) { s.compareName = v }
+
+func (s *UnionStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AssignmentStatementContext) Assignment_op() IAssignment_opContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssignment_opContext)(nil)).Elem(), 0)
+func (s *UnionStatementContext) TYPE() antlr.TerminalNode {
+	return s.GetToken(SimParserTYPE, 0)
+}
 
-	if t == nil {
-		return nil
+func (s *UnionStatementContext) ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserASSIGNMENT, 0)
+}
+
+func (s *UnionStatementContext) AllUnionVariant() []IUnionVariantContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IUnionVariantContext)(nil)).Elem())
+	var tst = make([]IUnionVariantContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IUnionVariantContext)
+		}
 	}
 
-	return t.(IAssignment_opContext)
+	return tst
 }
 
-func (s *AssignmentStatementContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *UnionStatementContext) UnionVariant(i int) IUnionVariantContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUnionVariantContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IUnionVariantContext)
 }
 
-func (s *AssignmentStatementContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SimParserIDENTIFIER, 0)
+func (s *UnionStatementContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(SimParserIDENTIFIER)
 }
 
-func (s *AssignmentStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnionStatementContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, i)
+}
+
+func (s *UnionStatementContext) AllPIPE() []antlr.TerminalNode {
+	return s.GetTokens(SimParserPIPE)
+}
+
+func (s *UnionStatementContext) PIPE(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserPIPE, i)
+}
+
+func (s *UnionStatementContext) ORDERED() antlr.TerminalNode {
+	return s.GetToken(SimParserORDERED, 0)
+}
+
+func (s *UnionStatementContext) BY() antlr.TerminalNode {
+	return s.GetToken(SimParserBY, 0)
+}
+
+func (s *UnionStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterAssignmentStatement(s)
+		listenerT.EnterUnionStatement(s)
 	}
 }
 
-func (s *AssignmentStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnionStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitAssignmentStatement(s)
+		listenerT.ExitUnionStatement(s)
 	}
 }
 
-func (s *AssignmentStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UnionStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitAssignmentStatement(s)
+		return t.VisitUnionStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type InfiniteLoopStatementContext struct {
+type BreakStatementContext struct {
 	*StatementContext
+	label antlr.Token
 }
 
-func NewInfiniteLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InfiniteLoopStatementContext {
-	var p = new(InfiniteLoopStatementContext)
-
+func NewBreakStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BreakStatementContext {
+	var p = new(BreakStatementContext)
+
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
 	p.CopyFrom(ctx.(*StatementContext))
@@ -737,52 +1105,52 @@ func NewInfiniteLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRuleCo
 	return p
 }
 
-func (s *InfiniteLoopStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *BreakStatementContext) GetLabel() antlr.Token { return s.label }
+
+func (s *BreakStatementContext) SetLabel(v antlr.Token) { s.label = v }
+
+func (s *BreakStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InfiniteLoopStatementContext) LOOP() antlr.TerminalNode {
-	return s.GetToken(SimParserLOOP, 0)
+func (s *BreakStatementContext) BREAK() antlr.TerminalNode {
+	return s.GetToken(SimParserBREAK, 0)
 }
 
-func (s *InfiniteLoopStatementContext) Statement() IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStatementContext)
+func (s *BreakStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *InfiniteLoopStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BreakStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterInfiniteLoopStatement(s)
+		listenerT.EnterBreakStatement(s)
 	}
 }
 
-func (s *InfiniteLoopStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BreakStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitInfiniteLoopStatement(s)
+		listenerT.ExitBreakStatement(s)
 	}
 }
 
-func (s *InfiniteLoopStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BreakStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitInfiniteLoopStatement(s)
+		return t.VisitBreakStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ReturnStatementContext struct {
+type IfStatementContext struct {
 	*StatementContext
+	body     IStatementContext
+	elseBody IStatementContext
 }
 
-func NewReturnStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ReturnStatementContext {
-	var p = new(ReturnStatementContext)
+func NewIfStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IfStatementContext {
+	var p = new(IfStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -791,15 +1159,23 @@ func NewReturnStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *ReturnStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *IfStatementContext) GetBody() IStatementContext { return s.body }
+
+func (s *IfStatementContext) GetElseBody() IStatementContext { return s.elseBody }
+
+func (s *IfStatementContext) SetBody(v IStatementContext) { s.body = v }
+
+func (s *IfStatementContext) SetElseBody(v IStatementContext) { s.elseBody = v }
+
+func (s *IfStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReturnStatementContext) RETURN() antlr.TerminalNode {
-	return s.GetToken(SimParserRETURN, 0)
+func (s *IfStatementContext) IF() antlr.TerminalNode {
+	return s.GetToken(SimParserIF, 0)
 }
 
-func (s *ReturnStatementContext) Expression() IExpressionContext {
+func (s *IfStatementContext) Expression() IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -809,34 +1185,62 @@ func (s *ReturnStatementContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *ReturnStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IfStatementContext) AllStatement() []IStatementContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementContext)(nil)).Elem())
+	var tst = make([]IStatementContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IStatementContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *IfStatementContext) Statement(i int) IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *IfStatementContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(SimParserELSE, 0)
+}
+
+func (s *IfStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterReturnStatement(s)
+		listenerT.EnterIfStatement(s)
 	}
 }
 
-func (s *ReturnStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IfStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitReturnStatement(s)
+		listenerT.ExitIfStatement(s)
 	}
 }
 
-func (s *ReturnStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IfStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitReturnStatement(s)
+		return t.VisitIfStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type PrintStatementContext struct {
+type ConditionalLoopStatementContext struct {
 	*StatementContext
+	label antlr.Token
 }
 
-func NewPrintStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrintStatementContext {
-	var p = new(PrintStatementContext)
+func NewConditionalLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ConditionalLoopStatementContext {
+	var p = new(ConditionalLoopStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -845,19 +1249,19 @@ func NewPrintStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *PrintStatementContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *ConditionalLoopStatementContext) GetLabel() antlr.Token { return s.label }
 
-func (s *PrintStatementContext) PRINT() antlr.TerminalNode {
-	return s.GetToken(SimParserPRINT, 0)
+func (s *ConditionalLoopStatementContext) SetLabel(v antlr.Token) { s.label = v }
+
+func (s *ConditionalLoopStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *PrintStatementContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(SimParserLPAREN, 0)
+func (s *ConditionalLoopStatementContext) LOOP() antlr.TerminalNode {
+	return s.GetToken(SimParserLOOP, 0)
 }
 
-func (s *PrintStatementContext) Expression() IExpressionContext {
+func (s *ConditionalLoopStatementContext) Expression() IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -867,38 +1271,55 @@ func (s *PrintStatementContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *PrintStatementContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(SimParserRPAREN, 0)
+func (s *ConditionalLoopStatementContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
 }
 
-func (s *PrintStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConditionalLoopStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
+}
+
+func (s *ConditionalLoopStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *ConditionalLoopStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterPrintStatement(s)
+		listenerT.EnterConditionalLoopStatement(s)
 	}
 }
 
-func (s *PrintStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConditionalLoopStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitPrintStatement(s)
+		listenerT.ExitConditionalLoopStatement(s)
 	}
 }
 
-func (s *PrintStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ConditionalLoopStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitPrintStatement(s)
+		return t.VisitConditionalLoopStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BlockStatementContext struct {
+type FunctionStatementContext struct {
 	*StatementContext
+	funcName   antlr.Token
+	returnType ITypeSpecContext
+	body       IStatementContext
 }
 
-func NewBlockStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BlockStatementContext {
-	var p = new(BlockStatementContext)
+func NewFunctionStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FunctionStatementContext {
+	var p = new(FunctionStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -907,71 +1328,124 @@ func NewBlockStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext)
 	return p
 }
 
-func (s *BlockStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *FunctionStatementContext) GetFuncName() antlr.Token { return s.funcName }
+
+func (s *FunctionStatementContext) SetFuncName(v antlr.Token) { s.funcName = v }
+
+func (s *FunctionStatementContext) GetReturnType() ITypeSpecContext { return s.returnType }
+
+func (s *FunctionStatementContext) GetBody() IStatementContext { return s.body }
+
+func (s *FunctionStatementContext) SetReturnType(v ITypeSpecContext) { s.returnType = v }
+
+func (s *FunctionStatementContext) SetBody(v IStatementContext) { s.body = v }
+
+func (s *FunctionStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BlockStatementContext) LBRACE() antlr.TerminalNode {
-	return s.GetToken(SimParserLBRACE, 0)
+func (s *FunctionStatementContext) FUNCTION() antlr.TerminalNode {
+	return s.GetToken(SimParserFUNCTION, 0)
 }
 
-func (s *BlockStatementContext) RBRACE() antlr.TerminalNode {
-	return s.GetToken(SimParserRBRACE, 0)
+func (s *FunctionStatementContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
 }
 
-func (s *BlockStatementContext) AllStatement() []IStatementContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementContext)(nil)).Elem())
-	var tst = make([]IStatementContext, len(ts))
+func (s *FunctionStatementContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
+}
+
+func (s *FunctionStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
+}
+
+func (s *FunctionStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *FunctionStatementContext) TypeSpec() ITypeSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *FunctionStatementContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *FunctionStatementContext) AllParameter() []IParameterContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IParameterContext)(nil)).Elem())
+	var tst = make([]IParameterContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IStatementContext)
+			tst[i] = t.(IParameterContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *BlockStatementContext) Statement(i int) IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), i)
+func (s *FunctionStatementContext) Parameter(i int) IParameterContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParameterContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStatementContext)
+	return t.(IParameterContext)
 }
 
-func (s *BlockStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *FunctionStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *FunctionStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterBlockStatement(s)
+		listenerT.EnterFunctionStatement(s)
 	}
 }
 
-func (s *BlockStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitBlockStatement(s)
+		listenerT.ExitFunctionStatement(s)
 	}
 }
 
-func (s *BlockStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitBlockStatement(s)
+		return t.VisitFunctionStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type LoopStatementContext struct {
+type ConstStatementContext struct {
 	*StatementContext
-	min IExpressionContext
-	max IExpressionContext
+	type_   ITypeSpecContext
+	varName antlr.Token
+	value   IExpressionContext
 }
 
-func NewLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LoopStatementContext {
-	var p = new(LoopStatementContext)
+func NewConstStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ConstStatementContext {
+	var p = new(ConstStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -980,59 +1454,46 @@ func NewLoopStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *
 	return p
 }
 
-func (s *LoopStatementContext) GetMin() IExpressionContext { return s.min }
+func (s *ConstStatementContext) GetVarName() antlr.Token { return s.varName }
 
-func (s *LoopStatementContext) GetMax() IExpressionContext { return s.max }
+func (s *ConstStatementContext) SetVarName(v antlr.Token) { s.varName = v }
 
-func (s *LoopStatementContext) SetMin(v IExpressionContext) { s.min = v }
+func (s *ConstStatementContext) GetType_() ITypeSpecContext { return s.type_ }
 
-func (s *LoopStatementContext) SetMax(v IExpressionContext) { s.max = v }
+func (s *ConstStatementContext) GetValue() IExpressionContext { return s.value }
 
-func (s *LoopStatementContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *ConstStatementContext) SetType_(v ITypeSpecContext) { s.type_ = v }
 
-func (s *LoopStatementContext) LOOP() antlr.TerminalNode {
-	return s.GetToken(SimParserLOOP, 0)
-}
+func (s *ConstStatementContext) SetValue(v IExpressionContext) { s.value = v }
 
-func (s *LoopStatementContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SimParserIDENTIFIER, 0)
+func (s *ConstStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *LoopStatementContext) ASSIGNMENT() antlr.TerminalNode {
-	return s.GetToken(SimParserASSIGNMENT, 0)
+func (s *ConstStatementContext) CONST() antlr.TerminalNode {
+	return s.GetToken(SimParserCONST, 0)
 }
 
-func (s *LoopStatementContext) TO() antlr.TerminalNode {
-	return s.GetToken(SimParserTO, 0)
+func (s *ConstStatementContext) ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserASSIGNMENT, 0)
 }
 
-func (s *LoopStatementContext) Statement() IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+func (s *ConstStatementContext) TypeSpec() ITypeSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStatementContext)
+	return t.(ITypeSpecContext)
 }
 
-func (s *LoopStatementContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
-		}
-	}
-
-	return tst
+func (s *ConstStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *LoopStatementContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *ConstStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
@@ -1041,34 +1502,35 @@ func (s *LoopStatementContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *LoopStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterLoopStatement(s)
+		listenerT.EnterConstStatement(s)
 	}
 }
 
-func (s *LoopStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitLoopStatement(s)
+		listenerT.ExitConstStatement(s)
 	}
 }
 
-func (s *LoopStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ConstStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitLoopStatement(s)
+		return t.VisitConstStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type ContinueStatementContext struct {
+type SwitchStatementContext struct {
 	*StatementContext
+	value IExpressionContext
 }
 
-func NewContinueStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ContinueStatementContext {
-	var p = new(ContinueStatementContext)
+func NewSwitchStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SwitchStatementContext {
+	var p = new(SwitchStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -1077,44 +1539,89 @@ func NewContinueStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContex
 	return p
 }
 
-func (s *ContinueStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *SwitchStatementContext) GetValue() IExpressionContext { return s.value }
+
+func (s *SwitchStatementContext) SetValue(v IExpressionContext) { s.value = v }
+
+func (s *SwitchStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ContinueStatementContext) CONTINUE() antlr.TerminalNode {
-	return s.GetToken(SimParserCONTINUE, 0)
+func (s *SwitchStatementContext) SWITCH() antlr.TerminalNode {
+	return s.GetToken(SimParserSWITCH, 0)
 }
 
-func (s *ContinueStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SwitchStatementContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACE, 0)
+}
+
+func (s *SwitchStatementContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACE, 0)
+}
+
+func (s *SwitchStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SwitchStatementContext) AllSwitchCase() []ISwitchCaseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISwitchCaseContext)(nil)).Elem())
+	var tst = make([]ISwitchCaseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISwitchCaseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SwitchStatementContext) SwitchCase(i int) ISwitchCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchCaseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchCaseContext)
+}
+
+func (s *SwitchStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterContinueStatement(s)
+		listenerT.EnterSwitchStatement(s)
 	}
 }
 
-func (s *ContinueStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SwitchStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitContinueStatement(s)
+		listenerT.ExitSwitchStatement(s)
 	}
 }
 
-func (s *ContinueStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SwitchStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitContinueStatement(s)
+		return t.VisitSwitchStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type DeclarationStatementContext struct {
+type InferredDeclarationStatementContext struct {
 	*StatementContext
-	type_   antlr.Token
 	varName antlr.Token
+	value   IExpressionContext
 }
 
-func NewDeclarationStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DeclarationStatementContext {
-	var p = new(DeclarationStatementContext)
+func NewInferredDeclarationStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InferredDeclarationStatementContext {
+	var p = new(InferredDeclarationStatementContext)
 
 	p.StatementContext = NewEmptyStatementContext()
 	p.parser = parser
@@ -1123,31 +1630,31 @@ func NewDeclarationStatementContext(parser antlr.Parser, ctx antlr.ParserRuleCon
 	return p
 }
 
-func (s *DeclarationStatementContext) GetType_() antlr.Token { return s.type_ }
+func (s *InferredDeclarationStatementContext) GetVarName() antlr.Token { return s.varName }
 
-func (s *DeclarationStatementContext) GetVarName() antlr.Token { return s.varName }
+func (s *InferredDeclarationStatementContext) SetVarName(v antlr.Token) { s.varName = v }
 
-func (s *DeclarationStatementContext) SetType_(v antlr.Token) { s.type_ = v }
+func (s *InferredDeclarationStatementContext) GetValue() IExpressionContext { return s.value }
 
-func (s *DeclarationStatementContext) SetVarName(v antlr.Token) { s.varName = v }
+func (s *InferredDeclarationStatementContext) SetValue(v IExpressionContext) { s.value = v }
 
-func (s *DeclarationStatementContext) GetRuleContext() antlr.RuleContext {
+func (s *InferredDeclarationStatementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DeclarationStatementContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(SimParserIDENTIFIER)
+func (s *InferredDeclarationStatementContext) VAR() antlr.TerminalNode {
+	return s.GetToken(SimParserVAR, 0)
 }
 
-func (s *DeclarationStatementContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(SimParserIDENTIFIER, i)
+func (s *InferredDeclarationStatementContext) ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserASSIGNMENT, 0)
 }
 
-func (s *DeclarationStatementContext) ASSIGNMENT() antlr.TerminalNode {
-	return s.GetToken(SimParserASSIGNMENT, 0)
+func (s *InferredDeclarationStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *DeclarationStatementContext) Expression() IExpressionContext {
+func (s *InferredDeclarationStatementContext) Expression() IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -1157,1095 +1664,5585 @@ func (s *DeclarationStatementContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *DeclarationStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InferredDeclarationStatementContext) DECLARE_ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserDECLARE_ASSIGNMENT, 0)
+}
+
+func (s *InferredDeclarationStatementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterDeclarationStatement(s)
+		listenerT.EnterInferredDeclarationStatement(s)
 	}
 }
 
-func (s *DeclarationStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InferredDeclarationStatementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitDeclarationStatement(s)
+		listenerT.ExitInferredDeclarationStatement(s)
 	}
 }
 
-func (s *DeclarationStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *InferredDeclarationStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitDeclarationStatement(s)
+		return t.VisitInferredDeclarationStatement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimParser) Statement() (localctx IStatementContext) {
-	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, SimParserRULE_statement)
-	var _la int
+type AssignmentStatementContext struct {
+	*StatementContext
+	target IExpressionContext
+	value  IExpressionContext
+}
 
-	defer func() {
-		p.ExitRule()
-	}()
+func NewAssignmentStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AssignmentStatementContext {
+	var p = new(AssignmentStatementContext)
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
+	p.StatementContext = NewEmptyStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*StatementContext))
 
-	p.SetState(80)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
-	case 1:
-		localctx = NewBlockStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(18)
-			p.Match(SimParserLBRACE)
-		}
-		p.SetState(22)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	return p
+}
 
-		for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFUNCTION)|(1<<SimParserIF)|(1<<SimParserLOOP)|(1<<SimParserRETURN)|(1<<SimParserBREAK)|(1<<SimParserCONTINUE)|(1<<SimParserPRINT))) != 0) || _la == SimParserLBRACE || _la == SimParserIDENTIFIER {
-			{
-				p.SetState(19)
-				p.Statement()
-			}
+func (s *AssignmentStatementContext) GetTarget() IExpressionContext { return s.target }
 
-			p.SetState(24)
-			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
-		}
-		{
-			p.SetState(25)
-			p.Match(SimParserRBRACE)
-		}
+func (s *AssignmentStatementContext) GetValue() IExpressionContext { return s.value }
 
-	case 2:
-		localctx = NewIfStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(26)
-			p.Match(SimParserIF)
-		}
-		{
-			p.SetState(27)
-			p.expression(0)
-		}
-		{
-			p.SetState(28)
-			p.Statement()
-		}
+func (s *AssignmentStatementContext) SetTarget(v IExpressionContext) { s.target = v }
 
-	case 3:
-		localctx = NewInfiniteLoopStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(30)
-			p.Match(SimParserLOOP)
-		}
-		{
-			p.SetState(31)
-			p.Statement()
-		}
+func (s *AssignmentStatementContext) SetValue(v IExpressionContext) { s.value = v }
 
-	case 4:
-		localctx = NewConditionalLoopStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(32)
-			p.Match(SimParserLOOP)
-		}
-		{
-			p.SetState(33)
-			p.expression(0)
-		}
-		{
-			p.SetState(34)
-			p.Statement()
-		}
+func (s *AssignmentStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	case 5:
-		localctx = NewLoopStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(36)
-			p.Match(SimParserLOOP)
-		}
-		{
-			p.SetState(37)
-			p.Match(SimParserIDENTIFIER)
+func (s *AssignmentStatementContext) Assignment_op() IAssignment_opContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssignment_opContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAssignment_opContext)
+}
+
+func (s *AssignmentStatementContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
 		}
-		{
-			p.SetState(38)
-			p.Match(SimParserASSIGNMENT)
+	}
+
+	return tst
+}
+
+func (s *AssignmentStatementContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AssignmentStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterAssignmentStatement(s)
+	}
+}
+
+func (s *AssignmentStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitAssignmentStatement(s)
+	}
+}
+
+func (s *AssignmentStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitAssignmentStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type StructStatementContext struct {
+	*StatementContext
+	typeName    antlr.Token
+	compareName antlr.Token
+}
+
+func NewStructStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StructStatementContext {
+	var p = new(StructStatementContext)
+
+	p.StatementContext = NewEmptyStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*StatementContext))
+
+	return p
+}
+
+func (s *StructStatementContext) GetTypeName() antlr.Token { return s.typeName }
+
+func (s *StructStatementContext) GetCompareName() antlr.Token { return s.compareName }
+
+func (s *StructStatementContext) SetTypeName(v antlr.Token) { s.typeName = v }
+
+func (s *StructStatementContext) SetCompareName(v antlr.Token) { s.compareName = v }
+
+func (s *StructStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StructStatementContext) TYPE() antlr.TerminalNode {
+	return s.GetToken(SimParserTYPE, 0)
+}
+
+func (s *StructStatementContext) STRUCT() antlr.TerminalNode {
+	return s.GetToken(SimParserSTRUCT, 0)
+}
+
+func (s *StructStatementContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACE, 0)
+}
+
+func (s *StructStatementContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACE, 0)
+}
+
+func (s *StructStatementContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(SimParserIDENTIFIER)
+}
+
+func (s *StructStatementContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, i)
+}
+
+func (s *StructStatementContext) AllStructField() []IStructFieldContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStructFieldContext)(nil)).Elem())
+	var tst = make([]IStructFieldContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IStructFieldContext)
 		}
-		{
-			p.SetState(39)
+	}
+
+	return tst
+}
+
+func (s *StructStatementContext) StructField(i int) IStructFieldContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStructFieldContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructFieldContext)
+}
+
+func (s *StructStatementContext) ORDERED() antlr.TerminalNode {
+	return s.GetToken(SimParserORDERED, 0)
+}
+
+func (s *StructStatementContext) BY() antlr.TerminalNode {
+	return s.GetToken(SimParserBY, 0)
+}
+
+func (s *StructStatementContext) AllSEMICOLON() []antlr.TerminalNode {
+	return s.GetTokens(SimParserSEMICOLON)
+}
+
+func (s *StructStatementContext) SEMICOLON(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserSEMICOLON, i)
+}
+
+func (s *StructStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterStructStatement(s)
+	}
+}
+
+func (s *StructStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitStructStatement(s)
+	}
+}
+
+func (s *StructStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitStructStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type EnumStatementContext struct {
+	*StatementContext
+	typeName antlr.Token
+}
+
+func NewEnumStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EnumStatementContext {
+	var p = new(EnumStatementContext)
+
+	p.StatementContext = NewEmptyStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*StatementContext))
+
+	return p
+}
+
+func (s *EnumStatementContext) GetTypeName() antlr.Token { return s.typeName }
+
+func (s *EnumStatementContext) SetTypeName(v antlr.Token) { s.typeName = v }
+
+func (s *EnumStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EnumStatementContext) ENUM() antlr.TerminalNode {
+	return s.GetToken(SimParserENUM, 0)
+}
+
+func (s *EnumStatementContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACE, 0)
+}
+
+func (s *EnumStatementContext) AllEnumMember() []IEnumMemberContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEnumMemberContext)(nil)).Elem())
+	var tst = make([]IEnumMemberContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEnumMemberContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *EnumStatementContext) EnumMember(i int) IEnumMemberContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumMemberContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumMemberContext)
+}
+
+func (s *EnumStatementContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACE, 0)
+}
+
+func (s *EnumStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *EnumStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *EnumStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *EnumStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterEnumStatement(s)
+	}
+}
+
+func (s *EnumStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitEnumStatement(s)
+	}
+}
+
+func (s *EnumStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitEnumStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ReturnStatementContext struct {
+	*StatementContext
+}
+
+func NewReturnStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ReturnStatementContext {
+	var p = new(ReturnStatementContext)
+
+	p.StatementContext = NewEmptyStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*StatementContext))
+
+	return p
+}
+
+func (s *ReturnStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ReturnStatementContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(SimParserRETURN, 0)
+}
+
+func (s *ReturnStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ReturnStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterReturnStatement(s)
+	}
+}
+
+func (s *ReturnStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitReturnStatement(s)
+	}
+}
+
+func (s *ReturnStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitReturnStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type PrintStatementContext struct {
+	*StatementContext
+}
+
+func NewPrintStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrintStatementContext {
+	var p = new(PrintStatementContext)
+
+	p.StatementContext = NewEmptyStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*StatementContext))
+
+	return p
+}
+
+func (s *PrintStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrintStatementContext) PRINT() antlr.TerminalNode {
+	return s.GetToken(SimParserPRINT, 0)
+}
+
+func (s *PrintStatementContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
+}
+
+func (s *PrintStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *PrintStatementContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
+}
+
+func (s *PrintStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterPrintStatement(s)
+	}
+}
+
+func (s *PrintStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitPrintStatement(s)
+	}
+}
+
+func (s *PrintStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitPrintStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ContinueStatementContext struct {
+	*StatementContext
+	label antlr.Token
+}
+
+func NewContinueStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ContinueStatementContext {
+	var p = new(ContinueStatementContext)
+
+	p.StatementContext = NewEmptyStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*StatementContext))
+
+	return p
+}
+
+func (s *ContinueStatementContext) GetLabel() antlr.Token { return s.label }
+
+func (s *ContinueStatementContext) SetLabel(v antlr.Token) { s.label = v }
+
+func (s *ContinueStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ContinueStatementContext) CONTINUE() antlr.TerminalNode {
+	return s.GetToken(SimParserCONTINUE, 0)
+}
+
+func (s *ContinueStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *ContinueStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterContinueStatement(s)
+	}
+}
+
+func (s *ContinueStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitContinueStatement(s)
+	}
+}
+
+func (s *ContinueStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitContinueStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ForEachStatementContext struct {
+	*StatementContext
+	label     antlr.Token
+	indexName antlr.Token
+	varName   antlr.Token
+	value     IExpressionContext
+}
+
+func NewForEachStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ForEachStatementContext {
+	var p = new(ForEachStatementContext)
+
+	p.StatementContext = NewEmptyStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*StatementContext))
+
+	return p
+}
+
+func (s *ForEachStatementContext) GetLabel() antlr.Token { return s.label }
+
+func (s *ForEachStatementContext) GetIndexName() antlr.Token { return s.indexName }
+
+func (s *ForEachStatementContext) GetVarName() antlr.Token { return s.varName }
+
+func (s *ForEachStatementContext) SetLabel(v antlr.Token) { s.label = v }
+
+func (s *ForEachStatementContext) SetIndexName(v antlr.Token) { s.indexName = v }
+
+func (s *ForEachStatementContext) SetVarName(v antlr.Token) { s.varName = v }
+
+func (s *ForEachStatementContext) GetValue() IExpressionContext { return s.value }
+
+func (s *ForEachStatementContext) SetValue(v IExpressionContext) { s.value = v }
+
+func (s *ForEachStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ForEachStatementContext) LOOP() antlr.TerminalNode {
+	return s.GetToken(SimParserLOOP, 0)
+}
+
+func (s *ForEachStatementContext) IN() antlr.TerminalNode {
+	return s.GetToken(SimParserIN, 0)
+}
+
+func (s *ForEachStatementContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *ForEachStatementContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(SimParserIDENTIFIER)
+}
+
+func (s *ForEachStatementContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, i)
+}
+
+func (s *ForEachStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ForEachStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
+}
+
+func (s *ForEachStatementContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, 0)
+}
+
+func (s *ForEachStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterForEachStatement(s)
+	}
+}
+
+func (s *ForEachStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitForEachStatement(s)
+	}
+}
+
+func (s *ForEachStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitForEachStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type DeclarationStatementContext struct {
+	*StatementContext
+	type_   ITypeSpecContext
+	varName antlr.Token
+}
+
+func NewDeclarationStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DeclarationStatementContext {
+	var p = new(DeclarationStatementContext)
+
+	p.StatementContext = NewEmptyStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*StatementContext))
+
+	return p
+}
+
+func (s *DeclarationStatementContext) GetVarName() antlr.Token { return s.varName }
+
+func (s *DeclarationStatementContext) SetVarName(v antlr.Token) { s.varName = v }
+
+func (s *DeclarationStatementContext) GetType_() ITypeSpecContext { return s.type_ }
+
+func (s *DeclarationStatementContext) SetType_(v ITypeSpecContext) { s.type_ = v }
+
+func (s *DeclarationStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DeclarationStatementContext) TypeSpec() ITypeSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *DeclarationStatementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *DeclarationStatementContext) ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserASSIGNMENT, 0)
+}
+
+func (s *DeclarationStatementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *DeclarationStatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterDeclarationStatement(s)
+	}
+}
+
+func (s *DeclarationStatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitDeclarationStatement(s)
+	}
+}
+
+func (s *DeclarationStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitDeclarationStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimParser) Statement() (localctx IStatementContext) {
+	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, SimParserRULE_statement)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(234)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewBlockStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(36)
+			p.Match(SimParserLBRACE)
+		}
+		p.SetState(40)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFUNCTION)|(1<<SimParserFN)|(1<<SimParserTYPE)|(1<<SimParserCONST)|(1<<SimParserVAR)|(1<<SimParserENUM)|(1<<SimParserMATCH)|(1<<SimParserSWITCH)|(1<<SimParserIF)|(1<<SimParserLOOP)|(1<<SimParserRETURN)|(1<<SimParserBREAK)|(1<<SimParserCONTINUE)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT)|(1<<SimParserPRINT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
+			{
+				p.SetState(37)
+				p.Statement()
+			}
+
+			p.SetState(42)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(43)
+			p.Match(SimParserRBRACE)
+		}
+
+	case 2:
+		localctx = NewIfStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(44)
+			p.Match(SimParserIF)
+		}
+		{
+			p.SetState(45)
+			p.expression(0)
+		}
+		{
+			p.SetState(46)
+
+			var _x = p.Statement()
+
+			localctx.(*IfStatementContext).body = _x
+		}
+		p.SetState(49)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(47)
+				p.Match(SimParserELSE)
+			}
+			{
+				p.SetState(48)
+
+				var _x = p.Statement()
+
+				localctx.(*IfStatementContext).elseBody = _x
+			}
+
+		}
+
+	case 3:
+		localctx = NewInfiniteLoopStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		p.SetState(53)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserIDENTIFIER {
+			{
+				p.SetState(51)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*InfiniteLoopStatementContext).label = _m
+			}
+			{
+				p.SetState(52)
+				p.Match(SimParserCOLON)
+			}
+
+		}
+		{
+			p.SetState(55)
+			p.Match(SimParserLOOP)
+		}
+		{
+			p.SetState(56)
+			p.Statement()
+		}
+
+	case 4:
+		localctx = NewConditionalLoopStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		p.SetState(59)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserIDENTIFIER {
+			{
+				p.SetState(57)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*ConditionalLoopStatementContext).label = _m
+			}
+			{
+				p.SetState(58)
+				p.Match(SimParserCOLON)
+			}
+
+		}
+		{
+			p.SetState(61)
+			p.Match(SimParserLOOP)
+		}
+		{
+			p.SetState(62)
+			p.expression(0)
+		}
+		{
+			p.SetState(63)
+			p.Statement()
+		}
+
+	case 5:
+		localctx = NewLoopStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		p.SetState(67)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserIDENTIFIER {
+			{
+				p.SetState(65)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*LoopStatementContext).label = _m
+			}
+			{
+				p.SetState(66)
+				p.Match(SimParserCOLON)
+			}
+
+		}
+		{
+			p.SetState(69)
+			p.Match(SimParserLOOP)
+		}
+		p.SetState(72)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(70)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*LoopStatementContext).indexName = _m
+			}
+			{
+				p.SetState(71)
+				p.Match(SimParserCOMMA)
+			}
+
+		}
+		{
+			p.SetState(74)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*LoopStatementContext).varName = _m
+		}
+		{
+			p.SetState(75)
+			p.Match(SimParserASSIGNMENT)
+		}
+		{
+			p.SetState(76)
+
+			var _x = p.expression(0)
+
+			localctx.(*LoopStatementContext).min = _x
+		}
+		p.SetState(79)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case SimParserTO:
+			{
+				p.SetState(77)
+				p.Match(SimParserTO)
+			}
+
+		case SimParserTHROUGH:
+			{
+				p.SetState(78)
+
+				var _m = p.Match(SimParserTHROUGH)
+
+				localctx.(*LoopStatementContext).inclusive = _m
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+		{
+			p.SetState(81)
+
+			var _x = p.expression(0)
+
+			localctx.(*LoopStatementContext).max = _x
+		}
+		p.SetState(84)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserSTEP {
+			{
+				p.SetState(82)
+				p.Match(SimParserSTEP)
+			}
+			{
+				p.SetState(83)
+
+				var _x = p.expression(0)
+
+				localctx.(*LoopStatementContext).step = _x
+			}
+
+		}
+		{
+			p.SetState(86)
+			p.Statement()
+		}
+
+	case 6:
+		localctx = NewForEachStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		p.SetState(90)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserIDENTIFIER {
+			{
+				p.SetState(88)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*ForEachStatementContext).label = _m
+			}
+			{
+				p.SetState(89)
+				p.Match(SimParserCOLON)
+			}
+
+		}
+		{
+			p.SetState(92)
+			p.Match(SimParserLOOP)
+		}
+		p.SetState(95)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(93)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*ForEachStatementContext).indexName = _m
+			}
+			{
+				p.SetState(94)
+				p.Match(SimParserCOMMA)
+			}
+
+		}
+		{
+			p.SetState(97)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*ForEachStatementContext).varName = _m
+		}
+		{
+			p.SetState(98)
+			p.Match(SimParserIN)
+		}
+		{
+			p.SetState(99)
+
+			var _x = p.expression(0)
+
+			localctx.(*ForEachStatementContext).value = _x
+		}
+		{
+			p.SetState(100)
+			p.Statement()
+		}
+
+	case 7:
+		localctx = NewFunctionStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(102)
+			p.Match(SimParserFUNCTION)
+		}
+		{
+			p.SetState(103)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*FunctionStatementContext).funcName = _m
+		}
+		{
+			p.SetState(104)
+			p.Match(SimParserLPAREN)
+		}
+		p.SetState(113)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserFN || _la == SimParserIDENTIFIER {
+			{
+				p.SetState(105)
+				p.Parameter()
+			}
+			p.SetState(110)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == SimParserCOMMA {
+				{
+					p.SetState(106)
+					p.Match(SimParserCOMMA)
+				}
+				{
+					p.SetState(107)
+					p.Parameter()
+				}
+
+				p.SetState(112)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(115)
+			p.Match(SimParserRPAREN)
+		}
+		{
+			p.SetState(116)
+			p.Match(SimParserCOLON)
+		}
+		{
+			p.SetState(117)
+
+			var _x = p.TypeSpec()
+
+			localctx.(*FunctionStatementContext).returnType = _x
+		}
+		{
+			p.SetState(118)
+
+			var _x = p.Statement()
+
+			localctx.(*FunctionStatementContext).body = _x
+		}
+
+	case 8:
+		localctx = NewStructStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(120)
+			p.Match(SimParserTYPE)
+		}
+		{
+			p.SetState(121)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*StructStatementContext).typeName = _m
+		}
+		{
+			p.SetState(122)
+			p.Match(SimParserSTRUCT)
+		}
+		{
+			p.SetState(123)
+			p.Match(SimParserLBRACE)
+		}
+		p.SetState(130)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SimParserFN || _la == SimParserIDENTIFIER {
+			{
+				p.SetState(124)
+				p.StructField()
+			}
+			p.SetState(126)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == SimParserSEMICOLON {
+				{
+					p.SetState(125)
+					p.Match(SimParserSEMICOLON)
+				}
+
+			}
+
+			p.SetState(132)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(133)
+			p.Match(SimParserRBRACE)
+		}
+		p.SetState(137)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(134)
+				p.Match(SimParserORDERED)
+			}
+			{
+				p.SetState(135)
+				p.Match(SimParserBY)
+			}
+			{
+				p.SetState(136)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*StructStatementContext).compareName = _m
+			}
+
+		}
+
+	case 9:
+		localctx = NewEnumStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(139)
+			p.Match(SimParserENUM)
+		}
+		{
+			p.SetState(140)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*EnumStatementContext).typeName = _m
+		}
+		{
+			p.SetState(141)
+			p.Match(SimParserLBRACE)
+		}
+		{
+			p.SetState(142)
+			p.EnumMember()
+		}
+		p.SetState(147)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(143)
+					p.Match(SimParserCOMMA)
+				}
+				{
+					p.SetState(144)
+					p.EnumMember()
+				}
+
+			}
+			p.SetState(149)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext())
+		}
+		p.SetState(151)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserCOMMA {
+			{
+				p.SetState(150)
+				p.Match(SimParserCOMMA)
+			}
+
+		}
+		{
+			p.SetState(153)
+			p.Match(SimParserRBRACE)
+		}
+
+	case 10:
+		localctx = NewUnionStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(155)
+			p.Match(SimParserTYPE)
+		}
+		{
+			p.SetState(156)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*UnionStatementContext).typeName = _m
+		}
+		{
+			p.SetState(157)
+			p.Match(SimParserASSIGNMENT)
+		}
+		{
+			p.SetState(158)
+			p.UnionVariant()
+		}
+		p.SetState(163)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(159)
+					p.Match(SimParserPIPE)
+				}
+				{
+					p.SetState(160)
+					p.UnionVariant()
+				}
+
+			}
+			p.SetState(165)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext())
+		}
+		p.SetState(169)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(166)
+				p.Match(SimParserORDERED)
+			}
+			{
+				p.SetState(167)
+				p.Match(SimParserBY)
+			}
+			{
+				p.SetState(168)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*UnionStatementContext).compareName = _m
+			}
+
+		}
+
+	case 11:
+		localctx = NewMatchStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(171)
+			p.Match(SimParserMATCH)
+		}
+		{
+			p.SetState(172)
+
+			var _x = p.expression(0)
+
+			localctx.(*MatchStatementContext).value = _x
+		}
+		{
+			p.SetState(173)
+			p.Match(SimParserLBRACE)
+		}
+		p.SetState(177)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SimParserIDENTIFIER {
+			{
+				p.SetState(174)
+				p.MatchCase()
+			}
+
+			p.SetState(179)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(180)
+			p.Match(SimParserRBRACE)
+		}
+
+	case 12:
+		localctx = NewSwitchStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(182)
+			p.Match(SimParserSWITCH)
+		}
+		{
+			p.SetState(183)
+
+			var _x = p.expression(0)
+
+			localctx.(*SwitchStatementContext).value = _x
+		}
+		{
+			p.SetState(184)
+			p.Match(SimParserLBRACE)
+		}
+		p.SetState(188)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SimParserCASE || _la == SimParserDEFAULT {
+			{
+				p.SetState(185)
+				p.SwitchCase()
+			}
+
+			p.SetState(190)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(191)
+			p.Match(SimParserRBRACE)
+		}
+
+	case 13:
+		localctx = NewDeclarationStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(193)
+
+			var _x = p.TypeSpec()
+
+			localctx.(*DeclarationStatementContext).type_ = _x
+		}
+		{
+			p.SetState(194)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*DeclarationStatementContext).varName = _m
+		}
+		p.SetState(197)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(195)
+				p.Match(SimParserASSIGNMENT)
+			}
+			{
+				p.SetState(196)
+				p.expression(0)
+			}
+
+		}
+
+	case 14:
+		localctx = NewConstStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(199)
+			p.Match(SimParserCONST)
+		}
+		{
+			p.SetState(200)
+
+			var _x = p.TypeSpec()
+
+			localctx.(*ConstStatementContext).type_ = _x
+		}
+		{
+			p.SetState(201)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*ConstStatementContext).varName = _m
+		}
+		{
+			p.SetState(202)
+			p.Match(SimParserASSIGNMENT)
+		}
+		{
+			p.SetState(203)
+
+			var _x = p.expression(0)
+
+			localctx.(*ConstStatementContext).value = _x
+		}
+
+	case 15:
+		localctx = NewInferredDeclarationStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(205)
+			p.Match(SimParserVAR)
+		}
+		{
+			p.SetState(206)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*InferredDeclarationStatementContext).varName = _m
+		}
+		{
+			p.SetState(207)
+			p.Match(SimParserASSIGNMENT)
+		}
+		{
+			p.SetState(208)
+
+			var _x = p.expression(0)
+
+			localctx.(*InferredDeclarationStatementContext).value = _x
+		}
+
+	case 16:
+		localctx = NewInferredDeclarationStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(209)
+
+			var _m = p.Match(SimParserIDENTIFIER)
+
+			localctx.(*InferredDeclarationStatementContext).varName = _m
+		}
+		{
+			p.SetState(210)
+			p.Match(SimParserDECLARE_ASSIGNMENT)
+		}
+		{
+			p.SetState(211)
+
+			var _x = p.expression(0)
+
+			localctx.(*InferredDeclarationStatementContext).value = _x
+		}
+
+	case 17:
+		localctx = NewAssignmentStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 17)
+		{
+			p.SetState(212)
+
+			var _x = p.expression(0)
+
+			localctx.(*AssignmentStatementContext).target = _x
+		}
+		{
+			p.SetState(213)
+			p.Assignment_op()
+		}
+		{
+			p.SetState(214)
+
+			var _x = p.expression(0)
+
+			localctx.(*AssignmentStatementContext).value = _x
+		}
+
+	case 18:
+		localctx = NewReturnStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 18)
+		{
+			p.SetState(216)
+			p.Match(SimParserRETURN)
+		}
+		{
+			p.SetState(217)
+			p.expression(0)
+		}
+
+	case 19:
+		localctx = NewPrintStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 19)
+		{
+			p.SetState(218)
+			p.Match(SimParserPRINT)
+		}
+		{
+			p.SetState(219)
+			p.Match(SimParserLPAREN)
+		}
+		{
+			p.SetState(220)
+			p.expression(0)
+		}
+		{
+			p.SetState(221)
+			p.Match(SimParserRPAREN)
+		}
+
+	case 20:
+		localctx = NewReturnStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(223)
+			p.Match(SimParserRETURN)
+		}
+
+	case 21:
+		localctx = NewBreakStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(224)
+			p.Match(SimParserBREAK)
+		}
+		p.SetState(227)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext()) == 1 {
+			p.SetState(225)
+
+			if !(!lineTerminatorAhead(p)) {
+				panic(antlr.NewFailedPredicateException(p, "!lineTerminatorAhead(p)", ""))
+			}
+			{
+				p.SetState(226)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*BreakStatementContext).label = _m
+			}
+
+		}
+
+	case 22:
+		localctx = NewContinueStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 22)
+		{
+			p.SetState(229)
+			p.Match(SimParserCONTINUE)
+		}
+		p.SetState(232)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) == 1 {
+			p.SetState(230)
+
+			if !(!lineTerminatorAhead(p)) {
+				panic(antlr.NewFailedPredicateException(p, "!lineTerminatorAhead(p)", ""))
+			}
+			{
+				p.SetState(231)
+
+				var _m = p.Match(SimParserIDENTIFIER)
+
+				localctx.(*ContinueStatementContext).label = _m
+			}
+
+		}
+
+	}
+
+	return localctx
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_expression
+	return p
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) CopyFrom(ctx *ExpressionContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type MapExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewMapExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MapExpressionContext {
+	var p = new(MapExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *MapExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MapExpressionContext) LBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACE, 0)
+}
+
+func (s *MapExpressionContext) RBRACE() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACE, 0)
+}
+
+func (s *MapExpressionContext) AllMapEntry() []IMapEntryContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMapEntryContext)(nil)).Elem())
+	var tst = make([]IMapEntryContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMapEntryContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MapExpressionContext) MapEntry(i int) IMapEntryContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMapEntryContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapEntryContext)
+}
+
+func (s *MapExpressionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *MapExpressionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *MapExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterMapExpression(s)
+	}
+}
+
+func (s *MapExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitMapExpression(s)
+	}
+}
+
+func (s *MapExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitMapExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type InvokeExpressionContext struct {
+	*ExpressionContext
+	callee IExpressionContext
+}
+
+func NewInvokeExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InvokeExpressionContext {
+	var p = new(InvokeExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *InvokeExpressionContext) GetCallee() IExpressionContext { return s.callee }
+
+func (s *InvokeExpressionContext) SetCallee(v IExpressionContext) { s.callee = v }
+
+func (s *InvokeExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InvokeExpressionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
+}
+
+func (s *InvokeExpressionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
+}
+
+func (s *InvokeExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *InvokeExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *InvokeExpressionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *InvokeExpressionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *InvokeExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterInvokeExpression(s)
+	}
+}
+
+func (s *InvokeExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitInvokeExpression(s)
+	}
+}
+
+func (s *InvokeExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitInvokeExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BitwiseXorExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	op    antlr.Token
+	right IExpressionContext
+}
+
+func NewBitwiseXorExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitwiseXorExpressionContext {
+	var p = new(BitwiseXorExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *BitwiseXorExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *BitwiseXorExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *BitwiseXorExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *BitwiseXorExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *BitwiseXorExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *BitwiseXorExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *BitwiseXorExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BitwiseXorExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *BitwiseXorExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *BitwiseXorExpressionContext) BITWISE_XOR() antlr.TerminalNode {
+	return s.GetToken(SimParserBITWISE_XOR, 0)
+}
+
+func (s *BitwiseXorExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterBitwiseXorExpression(s)
+	}
+}
+
+func (s *BitwiseXorExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitBitwiseXorExpression(s)
+	}
+}
+
+func (s *BitwiseXorExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitBitwiseXorExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LiteralExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LiteralExpressionContext {
+	var p = new(LiteralExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *LiteralExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LiteralExpressionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SimParserNUMBER, 0)
+}
+
+func (s *LiteralExpressionContext) TRUE() antlr.TerminalNode {
+	return s.GetToken(SimParserTRUE, 0)
+}
+
+func (s *LiteralExpressionContext) FALSE() antlr.TerminalNode {
+	return s.GetToken(SimParserFALSE, 0)
+}
+
+func (s *LiteralExpressionContext) STRING() antlr.TerminalNode {
+	return s.GetToken(SimParserSTRING, 0)
+}
+
+func (s *LiteralExpressionContext) MULTILINE_STRING() antlr.TerminalNode {
+	return s.GetToken(SimParserMULTILINE_STRING, 0)
+}
+
+func (s *LiteralExpressionContext) RAW_STRING() antlr.TerminalNode {
+	return s.GetToken(SimParserRAW_STRING, 0)
+}
+
+func (s *LiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterLiteralExpression(s)
+	}
+}
+
+func (s *LiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitLiteralExpression(s)
+	}
+}
+
+func (s *LiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitLiteralExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type SliceExpressionContext struct {
+	*ExpressionContext
+	value IExpressionContext
+	low   IExpressionContext
+	high  IExpressionContext
+}
+
+func NewSliceExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SliceExpressionContext {
+	var p = new(SliceExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *SliceExpressionContext) GetValue() IExpressionContext { return s.value }
+
+func (s *SliceExpressionContext) GetLow() IExpressionContext { return s.low }
+
+func (s *SliceExpressionContext) GetHigh() IExpressionContext { return s.high }
+
+func (s *SliceExpressionContext) SetValue(v IExpressionContext) { s.value = v }
+
+func (s *SliceExpressionContext) SetLow(v IExpressionContext) { s.low = v }
+
+func (s *SliceExpressionContext) SetHigh(v IExpressionContext) { s.high = v }
+
+func (s *SliceExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SliceExpressionContext) LBRACKET() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACKET, 0)
+}
+
+func (s *SliceExpressionContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
+}
+
+func (s *SliceExpressionContext) RBRACKET() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACKET, 0)
+}
+
+func (s *SliceExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SliceExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SliceExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterSliceExpression(s)
+	}
+}
+
+func (s *SliceExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitSliceExpression(s)
+	}
+}
+
+func (s *SliceExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitSliceExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BitwiseNotExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewBitwiseNotExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitwiseNotExpressionContext {
+	var p = new(BitwiseNotExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *BitwiseNotExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BitwiseNotExpressionContext) BITWISE_NOT() antlr.TerminalNode {
+	return s.GetToken(SimParserBITWISE_NOT, 0)
+}
+
+func (s *BitwiseNotExpressionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *BitwiseNotExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterBitwiseNotExpression(s)
+	}
+}
+
+func (s *BitwiseNotExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitBitwiseNotExpression(s)
+	}
+}
+
+func (s *BitwiseNotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitBitwiseNotExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type IndexExpressionContext struct {
+	*ExpressionContext
+	value IExpressionContext
+	index IExpressionContext
+}
+
+func NewIndexExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IndexExpressionContext {
+	var p = new(IndexExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *IndexExpressionContext) GetValue() IExpressionContext { return s.value }
+
+func (s *IndexExpressionContext) GetIndex() IExpressionContext { return s.index }
+
+func (s *IndexExpressionContext) SetValue(v IExpressionContext) { s.value = v }
+
+func (s *IndexExpressionContext) SetIndex(v IExpressionContext) { s.index = v }
+
+func (s *IndexExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IndexExpressionContext) LBRACKET() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACKET, 0)
+}
+
+func (s *IndexExpressionContext) RBRACKET() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACKET, 0)
+}
+
+func (s *IndexExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *IndexExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *IndexExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterIndexExpression(s)
+	}
+}
+
+func (s *IndexExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitIndexExpression(s)
+	}
+}
+
+func (s *IndexExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitIndexExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NotExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewNotExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NotExpressionContext {
+	var p = new(NotExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *NotExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NotExpressionContext) NOT() antlr.TerminalNode {
+	return s.GetToken(SimParserNOT, 0)
+}
+
+func (s *NotExpressionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *NotExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterNotExpression(s)
+	}
+}
+
+func (s *NotExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitNotExpression(s)
+	}
+}
+
+func (s *NotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitNotExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type VariableExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewVariableExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VariableExpressionContext {
+	var p = new(VariableExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *VariableExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VariableExpressionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *VariableExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterVariableExpression(s)
+	}
+}
+
+func (s *VariableExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitVariableExpression(s)
+	}
+}
+
+func (s *VariableExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitVariableExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ShiftExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	op    antlr.Token
+	right IExpressionContext
+}
+
+func NewShiftExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ShiftExpressionContext {
+	var p = new(ShiftExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ShiftExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *ShiftExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *ShiftExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *ShiftExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *ShiftExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *ShiftExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *ShiftExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShiftExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ShiftExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ShiftExpressionContext) LEFT_SHIFT() antlr.TerminalNode {
+	return s.GetToken(SimParserLEFT_SHIFT, 0)
+}
+
+func (s *ShiftExpressionContext) RIGHT_SHIFT() antlr.TerminalNode {
+	return s.GetToken(SimParserRIGHT_SHIFT, 0)
+}
+
+func (s *ShiftExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterShiftExpression(s)
+	}
+}
+
+func (s *ShiftExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitShiftExpression(s)
+	}
+}
+
+func (s *ShiftExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitShiftExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type OrExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	right IExpressionContext
+}
+
+func NewOrExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExpressionContext {
+	var p = new(OrExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *OrExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *OrExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *OrExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *OrExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *OrExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrExpressionContext) OR() antlr.TerminalNode {
+	return s.GetToken(SimParserOR, 0)
+}
+
+func (s *OrExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *OrExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *OrExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterOrExpression(s)
+	}
+}
+
+func (s *OrExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitOrExpression(s)
+	}
+}
+
+func (s *OrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitOrExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ParensExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewParensExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParensExpressionContext {
+	var p = new(ParensExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ParensExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParensExpressionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
+}
+
+func (s *ParensExpressionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ParensExpressionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
+}
+
+func (s *ParensExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterParensExpression(s)
+	}
+}
+
+func (s *ParensExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitParensExpression(s)
+	}
+}
+
+func (s *ParensExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitParensExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MulDivModExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	op    antlr.Token
+	right IExpressionContext
+}
+
+func NewMulDivModExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MulDivModExpressionContext {
+	var p = new(MulDivModExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *MulDivModExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *MulDivModExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *MulDivModExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *MulDivModExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *MulDivModExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *MulDivModExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *MulDivModExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MulDivModExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MulDivModExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *MulDivModExpressionContext) MULTIPLY() antlr.TerminalNode {
+	return s.GetToken(SimParserMULTIPLY, 0)
+}
+
+func (s *MulDivModExpressionContext) DIVIDE() antlr.TerminalNode {
+	return s.GetToken(SimParserDIVIDE, 0)
+}
+
+func (s *MulDivModExpressionContext) MODULO() antlr.TerminalNode {
+	return s.GetToken(SimParserMODULO, 0)
+}
+
+func (s *MulDivModExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterMulDivModExpression(s)
+	}
+}
+
+func (s *MulDivModExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitMulDivModExpression(s)
+	}
+}
+
+func (s *MulDivModExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitMulDivModExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type FieldExpressionContext struct {
+	*ExpressionContext
+	value     IExpressionContext
+	fieldName antlr.Token
+}
+
+func NewFieldExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FieldExpressionContext {
+	var p = new(FieldExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *FieldExpressionContext) GetFieldName() antlr.Token { return s.fieldName }
+
+func (s *FieldExpressionContext) SetFieldName(v antlr.Token) { s.fieldName = v }
+
+func (s *FieldExpressionContext) GetValue() IExpressionContext { return s.value }
+
+func (s *FieldExpressionContext) SetValue(v IExpressionContext) { s.value = v }
+
+func (s *FieldExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldExpressionContext) DOT() antlr.TerminalNode {
+	return s.GetToken(SimParserDOT, 0)
+}
+
+func (s *FieldExpressionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *FieldExpressionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *FieldExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterFieldExpression(s)
+	}
+}
+
+func (s *FieldExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitFieldExpression(s)
+	}
+}
+
+func (s *FieldExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitFieldExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NegateExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewNegateExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NegateExpressionContext {
+	var p = new(NegateExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *NegateExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NegateExpressionContext) SUBTRACT() antlr.TerminalNode {
+	return s.GetToken(SimParserSUBTRACT, 0)
+}
+
+func (s *NegateExpressionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *NegateExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterNegateExpression(s)
+	}
+}
+
+func (s *NegateExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitNegateExpression(s)
+	}
+}
+
+func (s *NegateExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitNegateExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AddSubExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	op    antlr.Token
+	right IExpressionContext
+}
+
+func NewAddSubExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AddSubExpressionContext {
+	var p = new(AddSubExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AddSubExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *AddSubExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *AddSubExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *AddSubExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *AddSubExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *AddSubExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *AddSubExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AddSubExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *AddSubExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AddSubExpressionContext) ADD() antlr.TerminalNode {
+	return s.GetToken(SimParserADD, 0)
+}
+
+func (s *AddSubExpressionContext) SUBTRACT() antlr.TerminalNode {
+	return s.GetToken(SimParserSUBTRACT, 0)
+}
+
+func (s *AddSubExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterAddSubExpression(s)
+	}
+}
+
+func (s *AddSubExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitAddSubExpression(s)
+	}
+}
+
+func (s *AddSubExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitAddSubExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BitwiseAndExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	op    antlr.Token
+	right IExpressionContext
+}
+
+func NewBitwiseAndExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitwiseAndExpressionContext {
+	var p = new(BitwiseAndExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *BitwiseAndExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *BitwiseAndExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *BitwiseAndExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *BitwiseAndExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *BitwiseAndExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *BitwiseAndExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *BitwiseAndExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BitwiseAndExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *BitwiseAndExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *BitwiseAndExpressionContext) BITWISE_AND() antlr.TerminalNode {
+	return s.GetToken(SimParserBITWISE_AND, 0)
+}
+
+func (s *BitwiseAndExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterBitwiseAndExpression(s)
+	}
+}
+
+func (s *BitwiseAndExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitBitwiseAndExpression(s)
+	}
+}
+
+func (s *BitwiseAndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitBitwiseAndExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type InequalityExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	op    antlr.Token
+	right IExpressionContext
+}
+
+func NewInequalityExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InequalityExpressionContext {
+	var p = new(InequalityExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *InequalityExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *InequalityExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *InequalityExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *InequalityExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *InequalityExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *InequalityExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *InequalityExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InequalityExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *InequalityExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *InequalityExpressionContext) GREATER() antlr.TerminalNode {
+	return s.GetToken(SimParserGREATER, 0)
+}
+
+func (s *InequalityExpressionContext) LESSER() antlr.TerminalNode {
+	return s.GetToken(SimParserLESSER, 0)
+}
+
+func (s *InequalityExpressionContext) GREATER_OR_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SimParserGREATER_OR_EQUAL, 0)
+}
+
+func (s *InequalityExpressionContext) LESSER_OR_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SimParserLESSER_OR_EQUAL, 0)
+}
+
+func (s *InequalityExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterInequalityExpression(s)
+	}
+}
+
+func (s *InequalityExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitInequalityExpression(s)
+	}
+}
+
+func (s *InequalityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitInequalityExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type FunctionExpressionContext struct {
+	*ExpressionContext
+	returnType ITypeSpecContext
+	body       IStatementContext
+}
+
+func NewFunctionExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FunctionExpressionContext {
+	var p = new(FunctionExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *FunctionExpressionContext) GetReturnType() ITypeSpecContext { return s.returnType }
+
+func (s *FunctionExpressionContext) GetBody() IStatementContext { return s.body }
+
+func (s *FunctionExpressionContext) SetReturnType(v ITypeSpecContext) { s.returnType = v }
+
+func (s *FunctionExpressionContext) SetBody(v IStatementContext) { s.body = v }
+
+func (s *FunctionExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionExpressionContext) FN() antlr.TerminalNode {
+	return s.GetToken(SimParserFN, 0)
+}
+
+func (s *FunctionExpressionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
+}
+
+func (s *FunctionExpressionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
+}
+
+func (s *FunctionExpressionContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
+}
+
+func (s *FunctionExpressionContext) TypeSpec() ITypeSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *FunctionExpressionContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *FunctionExpressionContext) AllParameter() []IParameterContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IParameterContext)(nil)).Elem())
+	var tst = make([]IParameterContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IParameterContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionExpressionContext) Parameter(i int) IParameterContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParameterContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParameterContext)
+}
+
+func (s *FunctionExpressionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *FunctionExpressionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *FunctionExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterFunctionExpression(s)
+	}
+}
+
+func (s *FunctionExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitFunctionExpression(s)
+	}
+}
+
+func (s *FunctionExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitFunctionExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AndExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	right IExpressionContext
+}
+
+func NewAndExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExpressionContext {
+	var p = new(AndExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *AndExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *AndExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *AndExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *AndExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *AndExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AndExpressionContext) AND() antlr.TerminalNode {
+	return s.GetToken(SimParserAND, 0)
+}
+
+func (s *AndExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *AndExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *AndExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterAndExpression(s)
+	}
+}
+
+func (s *AndExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitAndExpression(s)
+	}
+}
+
+func (s *AndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitAndExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ArrayExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewArrayExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayExpressionContext {
+	var p = new(ArrayExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *ArrayExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArrayExpressionContext) LBRACKET() antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACKET, 0)
+}
+
+func (s *ArrayExpressionContext) RBRACKET() antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACKET, 0)
+}
+
+func (s *ArrayExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ArrayExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ArrayExpressionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *ArrayExpressionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *ArrayExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterArrayExpression(s)
+	}
+}
+
+func (s *ArrayExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitArrayExpression(s)
+	}
+}
+
+func (s *ArrayExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitArrayExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type EqualityExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	op    antlr.Token
+	right IExpressionContext
+}
+
+func NewEqualityExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityExpressionContext {
+	var p = new(EqualityExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *EqualityExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *EqualityExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *EqualityExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *EqualityExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *EqualityExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *EqualityExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *EqualityExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EqualityExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *EqualityExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *EqualityExpressionContext) EQUALS() antlr.TerminalNode {
+	return s.GetToken(SimParserEQUALS, 0)
+}
+
+func (s *EqualityExpressionContext) NOT_EQUALS() antlr.TerminalNode {
+	return s.GetToken(SimParserNOT_EQUALS, 0)
+}
+
+func (s *EqualityExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterEqualityExpression(s)
+	}
+}
+
+func (s *EqualityExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitEqualityExpression(s)
+	}
+}
+
+func (s *EqualityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitEqualityExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type CallExpressionContext struct {
+	*ExpressionContext
+}
+
+func NewCallExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallExpressionContext {
+	var p = new(CallExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *CallExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CallExpressionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *CallExpressionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
+}
+
+func (s *CallExpressionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
+}
+
+func (s *CallExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *CallExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *CallExpressionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *CallExpressionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *CallExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterCallExpression(s)
+	}
+}
+
+func (s *CallExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitCallExpression(s)
+	}
+}
+
+func (s *CallExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitCallExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BitwiseOrExpressionContext struct {
+	*ExpressionContext
+	left  IExpressionContext
+	op    antlr.Token
+	right IExpressionContext
+}
+
+func NewBitwiseOrExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitwiseOrExpressionContext {
+	var p = new(BitwiseOrExpressionContext)
+
+	p.ExpressionContext = NewEmptyExpressionContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExpressionContext))
+
+	return p
+}
+
+func (s *BitwiseOrExpressionContext) GetOp() antlr.Token { return s.op }
+
+func (s *BitwiseOrExpressionContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *BitwiseOrExpressionContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *BitwiseOrExpressionContext) GetRight() IExpressionContext { return s.right }
+
+func (s *BitwiseOrExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *BitwiseOrExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *BitwiseOrExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BitwiseOrExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *BitwiseOrExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *BitwiseOrExpressionContext) PIPE() antlr.TerminalNode {
+	return s.GetToken(SimParserPIPE, 0)
+}
+
+func (s *BitwiseOrExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterBitwiseOrExpression(s)
+	}
+}
+
+func (s *BitwiseOrExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitBitwiseOrExpression(s)
+	}
+}
+
+func (s *BitwiseOrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitBitwiseOrExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimParser) Expression() (localctx IExpressionContext) {
+	return p.expression(0)
+}
+
+func (p *SimParser) expression(_p int) (localctx IExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 4
+	p.EnterRecursionRule(localctx, 4, SimParserRULE_expression, _p)
+	var _la int
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(303)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewParensExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+
+		{
+			p.SetState(237)
+			p.Match(SimParserLPAREN)
+		}
+		{
+			p.SetState(238)
+			p.expression(0)
+		}
+		{
+			p.SetState(239)
+			p.Match(SimParserRPAREN)
+		}
+
+	case 2:
+		localctx = NewNegateExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(241)
+			p.Match(SimParserSUBTRACT)
+		}
+		{
+			p.SetState(242)
+			p.expression(19)
+		}
+
+	case 3:
+		localctx = NewNotExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(243)
+			p.Match(SimParserNOT)
+		}
+		{
+			p.SetState(244)
+			p.expression(18)
+		}
+
+	case 4:
+		localctx = NewBitwiseNotExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(245)
+			p.Match(SimParserBITWISE_NOT)
+		}
+		{
+			p.SetState(246)
+			p.expression(17)
+		}
+
+	case 5:
+		localctx = NewFunctionExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(247)
+			p.Match(SimParserFN)
+		}
+		{
+			p.SetState(248)
+			p.Match(SimParserLPAREN)
+		}
+		p.SetState(257)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserFN || _la == SimParserIDENTIFIER {
+			{
+				p.SetState(249)
+				p.Parameter()
+			}
+			p.SetState(254)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == SimParserCOMMA {
+				{
+					p.SetState(250)
+					p.Match(SimParserCOMMA)
+				}
+				{
+					p.SetState(251)
+					p.Parameter()
+				}
+
+				p.SetState(256)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(259)
+			p.Match(SimParserRPAREN)
+		}
+		{
+			p.SetState(260)
+			p.Match(SimParserCOLON)
+		}
+		{
+			p.SetState(261)
+
+			var _x = p.TypeSpec()
+
+			localctx.(*FunctionExpressionContext).returnType = _x
+		}
+		{
+			p.SetState(262)
+
+			var _x = p.Statement()
+
+			localctx.(*FunctionExpressionContext).body = _x
+		}
+
+	case 6:
+		localctx = NewCallExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(264)
+			p.Match(SimParserIDENTIFIER)
+		}
+		{
+			p.SetState(265)
+			p.Match(SimParserLPAREN)
+		}
+		p.SetState(274)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFN)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
+			{
+				p.SetState(266)
+				p.expression(0)
+			}
+			p.SetState(271)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == SimParserCOMMA {
+				{
+					p.SetState(267)
+					p.Match(SimParserCOMMA)
+				}
+				{
+					p.SetState(268)
+					p.expression(0)
+				}
+
+				p.SetState(273)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(276)
+			p.Match(SimParserRPAREN)
+		}
+
+	case 7:
+		localctx = NewVariableExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(277)
+			p.Match(SimParserIDENTIFIER)
+		}
+
+	case 8:
+		localctx = NewArrayExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(278)
+			p.Match(SimParserLBRACKET)
+		}
+		p.SetState(287)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFN)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
+			{
+				p.SetState(279)
+				p.expression(0)
+			}
+			p.SetState(284)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == SimParserCOMMA {
+				{
+					p.SetState(280)
+					p.Match(SimParserCOMMA)
+				}
+				{
+					p.SetState(281)
+					p.expression(0)
+				}
+
+				p.SetState(286)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(289)
+			p.Match(SimParserRBRACKET)
+		}
+
+	case 9:
+		localctx = NewMapExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(290)
+			p.Match(SimParserLBRACE)
+		}
+		p.SetState(299)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFN)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
+			{
+				p.SetState(291)
+				p.MapEntry()
+			}
+			p.SetState(296)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == SimParserCOMMA {
+				{
+					p.SetState(292)
+					p.Match(SimParserCOMMA)
+				}
+				{
+					p.SetState(293)
+					p.MapEntry()
+				}
+
+				p.SetState(298)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(301)
+			p.Match(SimParserRBRACE)
+		}
+
+	case 10:
+		localctx = NewLiteralExpressionContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(302)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SimParserTRUE || _la == SimParserFALSE || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70)))) != 0)) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(368)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(366)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewIndexExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*IndexExpressionContext).value = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(305)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 23)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 23)", ""))
+				}
+				{
+					p.SetState(306)
+					p.Match(SimParserLBRACKET)
+				}
+				{
+					p.SetState(307)
+
+					var _x = p.expression(0)
+
+					localctx.(*IndexExpressionContext).index = _x
+				}
+				{
+					p.SetState(308)
+					p.Match(SimParserRBRACKET)
+				}
+
+			case 2:
+				localctx = NewSliceExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*SliceExpressionContext).value = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(310)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 22)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 22)", ""))
+				}
+				{
+					p.SetState(311)
+					p.Match(SimParserLBRACKET)
+				}
+				p.SetState(313)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFN)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
+					{
+						p.SetState(312)
+
+						var _x = p.expression(0)
+
+						localctx.(*SliceExpressionContext).low = _x
+					}
+
+				}
+				{
+					p.SetState(315)
+					p.Match(SimParserCOLON)
+				}
+				p.SetState(317)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFN)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
+					{
+						p.SetState(316)
+
+						var _x = p.expression(0)
+
+						localctx.(*SliceExpressionContext).high = _x
+					}
+
+				}
+				{
+					p.SetState(319)
+					p.Match(SimParserRBRACKET)
+				}
+
+			case 3:
+				localctx = NewFieldExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*FieldExpressionContext).value = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(320)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
+				}
+				{
+					p.SetState(321)
+					p.Match(SimParserDOT)
+				}
+				{
+					p.SetState(322)
+
+					var _m = p.Match(SimParserIDENTIFIER)
+
+					localctx.(*FieldExpressionContext).fieldName = _m
+				}
+
+			case 4:
+				localctx = NewInvokeExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*InvokeExpressionContext).callee = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(323)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
+				}
+				{
+					p.SetState(324)
+					p.Match(SimParserLPAREN)
+				}
+				p.SetState(333)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFN)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
+					{
+						p.SetState(325)
+						p.expression(0)
+					}
+					p.SetState(330)
+					p.GetErrorHandler().Sync(p)
+					_la = p.GetTokenStream().LA(1)
+
+					for _la == SimParserCOMMA {
+						{
+							p.SetState(326)
+							p.Match(SimParserCOMMA)
+						}
+						{
+							p.SetState(327)
+							p.expression(0)
+						}
+
+						p.SetState(332)
+						p.GetErrorHandler().Sync(p)
+						_la = p.GetTokenStream().LA(1)
+					}
+
+				}
+				{
+					p.SetState(335)
+					p.Match(SimParserRPAREN)
+				}
+
+			case 5:
+				localctx = NewMulDivModExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*MulDivModExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(336)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				}
+				{
+					p.SetState(337)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*MulDivModExpressionContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(((_la-30)&-(0x1f+1)) == 0 && ((1<<uint((_la-30)))&((1<<(SimParserMULTIPLY-30))|(1<<(SimParserDIVIDE-30))|(1<<(SimParserMODULO-30)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*MulDivModExpressionContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(338)
+
+					var _x = p.expression(17)
+
+					localctx.(*MulDivModExpressionContext).right = _x
+				}
+
+			case 6:
+				localctx = NewAddSubExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*AddSubExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(339)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
+				}
+				{
+					p.SetState(340)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*AddSubExpressionContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == SimParserADD || _la == SimParserSUBTRACT) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*AddSubExpressionContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(341)
+
+					var _x = p.expression(16)
+
+					localctx.(*AddSubExpressionContext).right = _x
+				}
+
+			case 7:
+				localctx = NewShiftExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*ShiftExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(342)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
+				}
+				{
+					p.SetState(343)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*ShiftExpressionContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == SimParserLEFT_SHIFT || _la == SimParserRIGHT_SHIFT) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*ShiftExpressionContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(344)
+
+					var _x = p.expression(15)
+
+					localctx.(*ShiftExpressionContext).right = _x
+				}
+
+			case 8:
+				localctx = NewBitwiseAndExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*BitwiseAndExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(345)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
+				}
+				{
+					p.SetState(346)
+
+					var _m = p.Match(SimParserBITWISE_AND)
+
+					localctx.(*BitwiseAndExpressionContext).op = _m
+				}
+				{
+					p.SetState(347)
+
+					var _x = p.expression(14)
+
+					localctx.(*BitwiseAndExpressionContext).right = _x
+				}
+
+			case 9:
+				localctx = NewBitwiseXorExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*BitwiseXorExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(348)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
+				}
+				{
+					p.SetState(349)
+
+					var _m = p.Match(SimParserBITWISE_XOR)
+
+					localctx.(*BitwiseXorExpressionContext).op = _m
+				}
+				{
+					p.SetState(350)
+
+					var _x = p.expression(13)
+
+					localctx.(*BitwiseXorExpressionContext).right = _x
+				}
+
+			case 10:
+				localctx = NewBitwiseOrExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*BitwiseOrExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(351)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+				}
+				{
+					p.SetState(352)
+
+					var _m = p.Match(SimParserPIPE)
+
+					localctx.(*BitwiseOrExpressionContext).op = _m
+				}
+				{
+					p.SetState(353)
+
+					var _x = p.expression(12)
+
+					localctx.(*BitwiseOrExpressionContext).right = _x
+				}
+
+			case 11:
+				localctx = NewInequalityExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*InequalityExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(354)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+				}
+				{
+					p.SetState(355)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*InequalityExpressionContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(((_la-54)&-(0x1f+1)) == 0 && ((1<<uint((_la-54)))&((1<<(SimParserGREATER-54))|(1<<(SimParserLESSER-54))|(1<<(SimParserGREATER_OR_EQUAL-54))|(1<<(SimParserLESSER_OR_EQUAL-54)))) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*InequalityExpressionContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(356)
+
+					var _x = p.expression(11)
+
+					localctx.(*InequalityExpressionContext).right = _x
+				}
+
+			case 12:
+				localctx = NewEqualityExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*EqualityExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(357)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+				}
+				{
+					p.SetState(358)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*EqualityExpressionContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == SimParserEQUALS || _la == SimParserNOT_EQUALS) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*EqualityExpressionContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(359)
+
+					var _x = p.expression(10)
+
+					localctx.(*EqualityExpressionContext).right = _x
+				}
+
+			case 13:
+				localctx = NewAndExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*AndExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(360)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+				}
+				{
+					p.SetState(361)
+					p.Match(SimParserAND)
+				}
+				{
+					p.SetState(362)
+
+					var _x = p.expression(9)
+
+					localctx.(*AndExpressionContext).right = _x
+				}
+
+			case 14:
+				localctx = NewOrExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
+				localctx.(*OrExpressionContext).left = _prevctx
+
+				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
+				p.SetState(363)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				}
+				{
+					p.SetState(364)
+					p.Match(SimParserOR)
+				}
+				{
+					p.SetState(365)
+
+					var _x = p.expression(8)
+
+					localctx.(*OrExpressionContext).right = _x
+				}
+
+			}
+
+		}
+		p.SetState(370)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// ITypeSpecContext is an interface to support dynamic dispatch.
+type ITypeSpecContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetKeyType returns the keyType rule contexts.
+	GetKeyType() ITypeSpecContext
+
+	// GetValueType returns the valueType rule contexts.
+	GetValueType() ITypeSpecContext
+
+	// GetReturnType returns the returnType rule contexts.
+	GetReturnType() ITypeSpecContext
+
+	// SetKeyType sets the keyType rule contexts.
+	SetKeyType(ITypeSpecContext)
+
+	// SetValueType sets the valueType rule contexts.
+	SetValueType(ITypeSpecContext)
+
+	// SetReturnType sets the returnType rule contexts.
+	SetReturnType(ITypeSpecContext)
+
+	// IsTypeSpecContext differentiates from other interfaces.
+	IsTypeSpecContext()
+}
+
+type TypeSpecContext struct {
+	*antlr.BaseParserRuleContext
+	parser     antlr.Parser
+	keyType    ITypeSpecContext
+	valueType  ITypeSpecContext
+	returnType ITypeSpecContext
+}
+
+func NewEmptyTypeSpecContext() *TypeSpecContext {
+	var p = new(TypeSpecContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_typeSpec
+	return p
+}
+
+func (*TypeSpecContext) IsTypeSpecContext() {}
+
+func NewTypeSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSpecContext {
+	var p = new(TypeSpecContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimParserRULE_typeSpec
+
+	return p
+}
+
+func (s *TypeSpecContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeSpecContext) GetKeyType() ITypeSpecContext { return s.keyType }
+
+func (s *TypeSpecContext) GetValueType() ITypeSpecContext { return s.valueType }
+
+func (s *TypeSpecContext) GetReturnType() ITypeSpecContext { return s.returnType }
+
+func (s *TypeSpecContext) SetKeyType(v ITypeSpecContext) { s.keyType = v }
+
+func (s *TypeSpecContext) SetValueType(v ITypeSpecContext) { s.valueType = v }
+
+func (s *TypeSpecContext) SetReturnType(v ITypeSpecContext) { s.returnType = v }
+
+func (s *TypeSpecContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
+
+func (s *TypeSpecContext) AllLBRACKET() []antlr.TerminalNode {
+	return s.GetTokens(SimParserLBRACKET)
+}
+
+func (s *TypeSpecContext) LBRACKET(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserLBRACKET, i)
+}
+
+func (s *TypeSpecContext) AllRBRACKET() []antlr.TerminalNode {
+	return s.GetTokens(SimParserRBRACKET)
+}
+
+func (s *TypeSpecContext) RBRACKET(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserRBRACKET, i)
+}
+
+func (s *TypeSpecContext) AllTypeSpec() []ITypeSpecContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem())
+	var tst = make([]ITypeSpecContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITypeSpecContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *TypeSpecContext) TypeSpec(i int) ITypeSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSpecContext)
+}
+
+func (s *TypeSpecContext) AllNUMBER() []antlr.TerminalNode {
+	return s.GetTokens(SimParserNUMBER)
+}
+
+func (s *TypeSpecContext) NUMBER(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserNUMBER, i)
+}
+
+func (s *TypeSpecContext) FN() antlr.TerminalNode {
+	return s.GetToken(SimParserFN, 0)
+}
+
+func (s *TypeSpecContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
+}
+
+func (s *TypeSpecContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
+}
+
+func (s *TypeSpecContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
+}
+
+func (s *TypeSpecContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *TypeSpecContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *TypeSpecContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterTypeSpec(s)
+	}
+}
+
+func (s *TypeSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitTypeSpec(s)
+	}
+}
+
+func (s *TypeSpecContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitTypeSpec(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimParser) TypeSpec() (localctx ITypeSpecContext) {
+	localctx = NewTypeSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, SimParserRULE_typeSpec)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(404)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SimParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(371)
+			p.Match(SimParserIDENTIFIER)
+		}
+		p.SetState(387)
+		p.GetErrorHandler().Sync(p)
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(372)
+				p.Match(SimParserLBRACKET)
+			}
+			{
+				p.SetState(373)
+
+				var _x = p.TypeSpec()
+
+				localctx.(*TypeSpecContext).keyType = _x
+			}
+			{
+				p.SetState(374)
+				p.Match(SimParserRBRACKET)
+			}
+			{
+				p.SetState(375)
+
+				var _x = p.TypeSpec()
+
+				localctx.(*TypeSpecContext).valueType = _x
+			}
+
+		case 2:
+			p.SetState(384)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(377)
+						p.Match(SimParserLBRACKET)
+					}
+					p.SetState(379)
+					p.GetErrorHandler().Sync(p)
+					_la = p.GetTokenStream().LA(1)
 
-			var _x = p.expression(0)
+					if _la == SimParserNUMBER {
+						{
+							p.SetState(378)
+							p.Match(SimParserNUMBER)
+						}
 
-			localctx.(*LoopStatementContext).min = _x
-		}
-		{
-			p.SetState(40)
-			p.Match(SimParserTO)
-		}
-		{
-			p.SetState(41)
+					}
+					{
+						p.SetState(381)
+						p.Match(SimParserRBRACKET)
+					}
 
-			var _x = p.expression(0)
+				}
+				p.SetState(386)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
+			}
 
-			localctx.(*LoopStatementContext).max = _x
-		}
-		{
-			p.SetState(42)
-			p.Statement()
 		}
 
-	case 6:
-		localctx = NewFunctionStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(44)
-			p.Match(SimParserFUNCTION)
-		}
+	case SimParserFN:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(45)
-			p.Match(SimParserIDENTIFIER)
+			p.SetState(389)
+			p.Match(SimParserFN)
 		}
 		{
-			p.SetState(46)
+			p.SetState(390)
 			p.Match(SimParserLPAREN)
 		}
-		p.SetState(55)
+		p.SetState(399)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if ((_la-8)&-(0x1f+1)) == 0 && ((1<<uint((_la-8)))&((1<<(SimParserTRUE-8))|(1<<(SimParserFALSE-8))|(1<<(SimParserNOT-8))|(1<<(SimParserSUBTRACT-8))|(1<<(SimParserLPAREN-8))|(1<<(SimParserNUMBER-8))|(1<<(SimParserIDENTIFIER-8)))) != 0 {
+		if _la == SimParserFN || _la == SimParserIDENTIFIER {
 			{
-				p.SetState(47)
-				p.expression(0)
+				p.SetState(391)
+				p.TypeSpec()
 			}
-			p.SetState(52)
+			p.SetState(396)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for _la == SimParserCOMMA {
 				{
-					p.SetState(48)
+					p.SetState(392)
 					p.Match(SimParserCOMMA)
 				}
 				{
-					p.SetState(49)
-					p.expression(0)
+					p.SetState(393)
+					p.TypeSpec()
 				}
 
-				p.SetState(54)
+				p.SetState(398)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 
 		}
 		{
-			p.SetState(57)
+			p.SetState(401)
 			p.Match(SimParserRPAREN)
 		}
 		{
-			p.SetState(58)
+			p.SetState(402)
 			p.Match(SimParserCOLON)
 		}
 		{
-			p.SetState(59)
-			p.Match(SimParserIDENTIFIER)
-		}
-
-	case 7:
-		localctx = NewDeclarationStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(60)
-
-			var _m = p.Match(SimParserIDENTIFIER)
-
-			localctx.(*DeclarationStatementContext).type_ = _m
-		}
-		{
-			p.SetState(61)
-
-			var _m = p.Match(SimParserIDENTIFIER)
-
-			localctx.(*DeclarationStatementContext).varName = _m
-		}
-		p.SetState(64)
-		p.GetErrorHandler().Sync(p)
-
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext()) == 1 {
-			{
-				p.SetState(62)
-				p.Match(SimParserASSIGNMENT)
-			}
-			{
-				p.SetState(63)
-				p.expression(0)
-			}
-
-		}
-
-	case 8:
-		localctx = NewAssignmentStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(66)
-
-			var _m = p.Match(SimParserIDENTIFIER)
-
-			localctx.(*AssignmentStatementContext).varName = _m
-		}
-		{
-			p.SetState(67)
-			p.Assignment_op()
-		}
-		{
-			p.SetState(68)
-			p.expression(0)
-		}
-
-	case 9:
-		localctx = NewReturnStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 9)
-		{
-			p.SetState(70)
-			p.Match(SimParserRETURN)
-		}
-		{
-			p.SetState(71)
-			p.expression(0)
-		}
-
-	case 10:
-		localctx = NewPrintStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 10)
-		{
-			p.SetState(72)
-			p.Match(SimParserPRINT)
-		}
-		{
-			p.SetState(73)
-			p.Match(SimParserLPAREN)
-		}
-		{
-			p.SetState(74)
-			p.expression(0)
-		}
-		{
-			p.SetState(75)
-			p.Match(SimParserRPAREN)
-		}
-
-	case 11:
-		localctx = NewReturnStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 11)
-		{
-			p.SetState(77)
-			p.Match(SimParserRETURN)
-		}
+			p.SetState(403)
 
-	case 12:
-		localctx = NewBreakStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 12)
-		{
-			p.SetState(78)
-			p.Match(SimParserBREAK)
-		}
+			var _x = p.TypeSpec()
 
-	case 13:
-		localctx = NewContinueStatementContext(p, localctx)
-		p.EnterOuterAlt(localctx, 13)
-		{
-			p.SetState(79)
-			p.Match(SimParserCONTINUE)
+			localctx.(*TypeSpecContext).returnType = _x
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// IParameterContext is an interface to support dynamic dispatch.
+type IParameterContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+	// GetParamName returns the paramName token.
+	GetParamName() antlr.Token
+
+	// SetParamName sets the paramName token.
+	SetParamName(antlr.Token)
+
+	// GetType_ returns the type_ rule contexts.
+	GetType_() ITypeSpecContext
+
+	// SetType_ sets the type_ rule contexts.
+	SetType_(ITypeSpecContext)
+
+	// IsParameterContext differentiates from other interfaces.
+	IsParameterContext()
 }
 
-type ExpressionContext struct {
+type ParameterContext struct {
 	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+	parser    antlr.Parser
+	type_     ITypeSpecContext
+	paramName antlr.Token
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyParameterContext() *ParameterContext {
+	var p = new(ParameterContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimParserRULE_expression
+	p.RuleIndex = SimParserRULE_parameter
 	return p
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*ParameterContext) IsParameterContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewParameterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParameterContext {
+	var p = new(ParameterContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimParserRULE_expression
+	p.RuleIndex = SimParserRULE_parameter
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *ParameterContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionContext) CopyFrom(ctx *ExpressionContext) {
-	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
-}
+func (s *ParameterContext) GetParamName() antlr.Token { return s.paramName }
 
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *ParameterContext) SetParamName(v antlr.Token) { s.paramName = v }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *ParameterContext) GetType_() ITypeSpecContext { return s.type_ }
 
-type NegateExpressionContext struct {
-	*ExpressionContext
-}
+func (s *ParameterContext) SetType_(v ITypeSpecContext) { s.type_ = v }
 
-func NewNegateExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NegateExpressionContext {
-	var p = new(NegateExpressionContext)
+func (s *ParameterContext) TypeSpec() ITypeSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), 0)
 
-	p.ExpressionContext = NewEmptyExpressionContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(ITypeSpecContext)
 }
 
-func (s *NegateExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *ParameterContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *NegateExpressionContext) SUBTRACT() antlr.TerminalNode {
-	return s.GetToken(SimParserSUBTRACT, 0)
+func (s *ParameterContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *NegateExpressionContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
+func (s *ParameterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NegateExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ParameterContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterNegateExpression(s)
+		listenerT.EnterParameter(s)
 	}
 }
 
-func (s *NegateExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ParameterContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitNegateExpression(s)
+		listenerT.ExitParameter(s)
 	}
 }
 
-func (s *NegateExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitNegateExpression(s)
+		return t.VisitParameter(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type AddSubExpressionContext struct {
-	*ExpressionContext
-	left  IExpressionContext
-	op    antlr.Token
-	right IExpressionContext
-}
+func (p *SimParser) Parameter() (localctx IParameterContext) {
+	localctx = NewParameterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, SimParserRULE_parameter)
 
-func NewAddSubExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AddSubExpressionContext {
-	var p = new(AddSubExpressionContext)
+	defer func() {
+		p.ExitRule()
+	}()
 
-	p.ExpressionContext = NewEmptyExpressionContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-	return p
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(406)
+
+		var _x = p.TypeSpec()
+
+		localctx.(*ParameterContext).type_ = _x
+	}
+	{
+		p.SetState(407)
+
+		var _m = p.Match(SimParserIDENTIFIER)
+
+		localctx.(*ParameterContext).paramName = _m
+	}
+
+	return localctx
 }
 
-func (s *AddSubExpressionContext) GetOp() antlr.Token { return s.op }
+// IStructFieldContext is an interface to support dynamic dispatch.
+type IStructFieldContext interface {
+	antlr.ParserRuleContext
 
-func (s *AddSubExpressionContext) SetOp(v antlr.Token) { s.op = v }
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-func (s *AddSubExpressionContext) GetLeft() IExpressionContext { return s.left }
+	// GetFieldName returns the fieldName token.
+	GetFieldName() antlr.Token
 
-func (s *AddSubExpressionContext) GetRight() IExpressionContext { return s.right }
+	// SetFieldName sets the fieldName token.
+	SetFieldName(antlr.Token)
 
-func (s *AddSubExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+	// GetType_ returns the type_ rule contexts.
+	GetType_() ITypeSpecContext
 
-func (s *AddSubExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+	// SetType_ sets the type_ rule contexts.
+	SetType_(ITypeSpecContext)
 
-func (s *AddSubExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+	// IsStructFieldContext differentiates from other interfaces.
+	IsStructFieldContext()
 }
 
-func (s *AddSubExpressionContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+type StructFieldContext struct {
+	*antlr.BaseParserRuleContext
+	parser    antlr.Parser
+	type_     ITypeSpecContext
+	fieldName antlr.Token
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
-		}
-	}
+func NewEmptyStructFieldContext() *StructFieldContext {
+	var p = new(StructFieldContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_structField
+	return p
+}
 
-	return tst
+func (*StructFieldContext) IsStructFieldContext() {}
+
+func NewStructFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StructFieldContext {
+	var p = new(StructFieldContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimParserRULE_structField
+
+	return p
 }
 
-func (s *AddSubExpressionContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *StructFieldContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StructFieldContext) GetFieldName() antlr.Token { return s.fieldName }
+
+func (s *StructFieldContext) SetFieldName(v antlr.Token) { s.fieldName = v }
+
+func (s *StructFieldContext) GetType_() ITypeSpecContext { return s.type_ }
+
+func (s *StructFieldContext) SetType_(v ITypeSpecContext) { s.type_ = v }
+
+func (s *StructFieldContext) TypeSpec() ITypeSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ITypeSpecContext)
 }
 
-func (s *AddSubExpressionContext) ADD() antlr.TerminalNode {
-	return s.GetToken(SimParserADD, 0)
+func (s *StructFieldContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *AddSubExpressionContext) SUBTRACT() antlr.TerminalNode {
-	return s.GetToken(SimParserSUBTRACT, 0)
+func (s *StructFieldContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *AddSubExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StructFieldContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterAddSubExpression(s)
+		listenerT.EnterStructField(s)
 	}
 }
 
-func (s *AddSubExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StructFieldContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitAddSubExpression(s)
+		listenerT.ExitStructField(s)
 	}
 }
 
-func (s *AddSubExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StructFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitAddSubExpression(s)
+		return t.VisitStructField(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type InequalityExpressionContext struct {
-	*ExpressionContext
-	left  IExpressionContext
-	op    antlr.Token
-	right IExpressionContext
-}
+func (p *SimParser) StructField() (localctx IStructFieldContext) {
+	localctx = NewStructFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, SimParserRULE_structField)
 
-func NewInequalityExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *InequalityExpressionContext {
-	var p = new(InequalityExpressionContext)
+	defer func() {
+		p.ExitRule()
+	}()
 
-	p.ExpressionContext = NewEmptyExpressionContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-	return p
-}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(409)
 
-func (s *InequalityExpressionContext) GetOp() antlr.Token { return s.op }
+		var _x = p.TypeSpec()
 
-func (s *InequalityExpressionContext) SetOp(v antlr.Token) { s.op = v }
+		localctx.(*StructFieldContext).type_ = _x
+	}
+	{
+		p.SetState(410)
 
-func (s *InequalityExpressionContext) GetLeft() IExpressionContext { return s.left }
+		var _m = p.Match(SimParserIDENTIFIER)
 
-func (s *InequalityExpressionContext) GetRight() IExpressionContext { return s.right }
+		localctx.(*StructFieldContext).fieldName = _m
+	}
 
-func (s *InequalityExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+	return localctx
+}
 
-func (s *InequalityExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+// IEnumMemberContext is an interface to support dynamic dispatch.
+type IEnumMemberContext interface {
+	antlr.ParserRuleContext
 
-func (s *InequalityExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-func (s *InequalityExpressionContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+	// GetMemberName returns the memberName token.
+	GetMemberName() antlr.Token
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
-		}
-	}
+	// SetMemberName sets the memberName token.
+	SetMemberName(antlr.Token)
 
-	return tst
+	// IsEnumMemberContext differentiates from other interfaces.
+	IsEnumMemberContext()
 }
 
-func (s *InequalityExpressionContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
+type EnumMemberContext struct {
+	*antlr.BaseParserRuleContext
+	parser     antlr.Parser
+	memberName antlr.Token
+}
 
-	return t.(IExpressionContext)
+func NewEmptyEnumMemberContext() *EnumMemberContext {
+	var p = new(EnumMemberContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_enumMember
+	return p
 }
 
-func (s *InequalityExpressionContext) GREATER() antlr.TerminalNode {
-	return s.GetToken(SimParserGREATER, 0)
+func (*EnumMemberContext) IsEnumMemberContext() {}
+
+func NewEnumMemberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumMemberContext {
+	var p = new(EnumMemberContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimParserRULE_enumMember
+
+	return p
 }
 
-func (s *InequalityExpressionContext) LESSER() antlr.TerminalNode {
-	return s.GetToken(SimParserLESSER, 0)
+func (s *EnumMemberContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EnumMemberContext) GetMemberName() antlr.Token { return s.memberName }
+
+func (s *EnumMemberContext) SetMemberName(v antlr.Token) { s.memberName = v }
+
+func (s *EnumMemberContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *InequalityExpressionContext) GREATER_OR_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SimParserGREATER_OR_EQUAL, 0)
+func (s *EnumMemberContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *InequalityExpressionContext) LESSER_OR_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SimParserLESSER_OR_EQUAL, 0)
+func (s *EnumMemberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InequalityExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EnumMemberContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterInequalityExpression(s)
+		listenerT.EnterEnumMember(s)
 	}
 }
 
-func (s *InequalityExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EnumMemberContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitInequalityExpression(s)
+		listenerT.ExitEnumMember(s)
 	}
 }
 
-func (s *InequalityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *EnumMemberContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitInequalityExpression(s)
+		return t.VisitEnumMember(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type AndExpressionContext struct {
-	*ExpressionContext
-	left  IExpressionContext
-	right IExpressionContext
-}
-
-func NewAndExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AndExpressionContext {
-	var p = new(AndExpressionContext)
+func (p *SimParser) EnumMember() (localctx IEnumMemberContext) {
+	localctx = NewEnumMemberContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, SimParserRULE_enumMember)
 
-	p.ExpressionContext = NewEmptyExpressionContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
-
-	return p
-}
-
-func (s *AndExpressionContext) GetLeft() IExpressionContext { return s.left }
+	defer func() {
+		p.ExitRule()
+	}()
 
-func (s *AndExpressionContext) GetRight() IExpressionContext { return s.right }
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-func (s *AndExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(412)
 
-func (s *AndExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+		var _m = p.Match(SimParserIDENTIFIER)
 
-func (s *AndExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+		localctx.(*EnumMemberContext).memberName = _m
+	}
 
-func (s *AndExpressionContext) AND() antlr.TerminalNode {
-	return s.GetToken(SimParserAND, 0)
+	return localctx
 }
 
-func (s *AndExpressionContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
-		}
-	}
+// IUnionVariantContext is an interface to support dynamic dispatch.
+type IUnionVariantContext interface {
+	antlr.ParserRuleContext
 
-	return tst
-}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-func (s *AndExpressionContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+	// GetVariantName returns the variantName token.
+	GetVariantName() antlr.Token
 
-	if t == nil {
-		return nil
-	}
+	// SetVariantName sets the variantName token.
+	SetVariantName(antlr.Token)
 
-	return t.(IExpressionContext)
+	// IsUnionVariantContext differentiates from other interfaces.
+	IsUnionVariantContext()
 }
 
-func (s *AndExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterAndExpression(s)
-	}
+type UnionVariantContext struct {
+	*antlr.BaseParserRuleContext
+	parser      antlr.Parser
+	variantName antlr.Token
 }
 
-func (s *AndExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitAndExpression(s)
-	}
+func NewEmptyUnionVariantContext() *UnionVariantContext {
+	var p = new(UnionVariantContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_unionVariant
+	return p
 }
 
-func (s *AndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SimParserVisitor:
-		return t.VisitAndExpression(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
+func (*UnionVariantContext) IsUnionVariantContext() {}
 
-type LiteralExpressionContext struct {
-	*ExpressionContext
-}
+func NewUnionVariantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionVariantContext {
+	var p = new(UnionVariantContext)
 
-func NewLiteralExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LiteralExpressionContext {
-	var p = new(LiteralExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.ExpressionContext = NewEmptyExpressionContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	p.RuleIndex = SimParserRULE_unionVariant
 
 	return p
 }
 
-func (s *LiteralExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *UnionVariantContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LiteralExpressionContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(SimParserNUMBER, 0)
-}
+func (s *UnionVariantContext) GetVariantName() antlr.Token { return s.variantName }
 
-func (s *LiteralExpressionContext) TRUE() antlr.TerminalNode {
-	return s.GetToken(SimParserTRUE, 0)
-}
+func (s *UnionVariantContext) SetVariantName(v antlr.Token) { s.variantName = v }
 
-func (s *LiteralExpressionContext) FALSE() antlr.TerminalNode {
-	return s.GetToken(SimParserFALSE, 0)
+func (s *UnionVariantContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *LiteralExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterLiteralExpression(s)
-	}
+func (s *UnionVariantContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
 }
 
-func (s *LiteralExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitLiteralExpression(s)
-	}
+func (s *UnionVariantContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
 }
 
-func (s *LiteralExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SimParserVisitor:
-		return t.VisitLiteralExpression(s)
+func (s *UnionVariantContext) AllStructField() []IStructFieldContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStructFieldContext)(nil)).Elem())
+	var tst = make([]IStructFieldContext, len(ts))
 
-	default:
-		return t.VisitChildren(s)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IStructFieldContext)
+		}
 	}
-}
 
-type NotExpressionContext struct {
-	*ExpressionContext
+	return tst
 }
 
-func NewNotExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NotExpressionContext {
-	var p = new(NotExpressionContext)
-
-	p.ExpressionContext = NewEmptyExpressionContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+func (s *UnionVariantContext) StructField(i int) IStructFieldContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStructFieldContext)(nil)).Elem(), i)
 
-	return p
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *NotExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IStructFieldContext)
 }
 
-func (s *NotExpressionContext) NOT() antlr.TerminalNode {
-	return s.GetToken(SimParserNOT, 0)
+func (s *UnionVariantContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
 }
 
-func (s *NotExpressionContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *UnionVariantContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *UnionVariantContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return t.(IExpressionContext)
+func (s *UnionVariantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NotExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnionVariantContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterNotExpression(s)
+		listenerT.EnterUnionVariant(s)
 	}
 }
 
-func (s *NotExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnionVariantContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitNotExpression(s)
+		listenerT.ExitUnionVariant(s)
 	}
 }
 
-func (s *NotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UnionVariantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitNotExpression(s)
+		return t.VisitUnionVariant(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type VariableExpressionContext struct {
-	*ExpressionContext
-}
-
-func NewVariableExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VariableExpressionContext {
-	var p = new(VariableExpressionContext)
+func (p *SimParser) UnionVariant() (localctx IUnionVariantContext) {
+	localctx = NewUnionVariantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, SimParserRULE_unionVariant)
+	var _la int
 
-	p.ExpressionContext = NewEmptyExpressionContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	defer func() {
+		p.ExitRule()
+	}()
 
-	return p
-}
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-func (s *VariableExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(414)
 
-func (s *VariableExpressionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SimParserIDENTIFIER, 0)
-}
+		var _m = p.Match(SimParserIDENTIFIER)
 
-func (s *VariableExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterVariableExpression(s)
+		localctx.(*UnionVariantContext).variantName = _m
 	}
-}
+	p.SetState(427)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(415)
+			p.Match(SimParserLPAREN)
+		}
+		p.SetState(424)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimParserFN || _la == SimParserIDENTIFIER {
+			{
+				p.SetState(416)
+				p.StructField()
+			}
+			p.SetState(421)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == SimParserCOMMA {
+				{
+					p.SetState(417)
+					p.Match(SimParserCOMMA)
+				}
+				{
+					p.SetState(418)
+					p.StructField()
+				}
+
+				p.SetState(423)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
+		{
+			p.SetState(426)
+			p.Match(SimParserRPAREN)
+		}
 
-func (s *VariableExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitVariableExpression(s)
 	}
+
+	return localctx
 }
 
-func (s *VariableExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SimParserVisitor:
-		return t.VisitVariableExpression(s)
+// IMatchCaseContext is an interface to support dynamic dispatch.
+type IMatchCaseContext interface {
+	antlr.ParserRuleContext
 
-	default:
-		return t.VisitChildren(s)
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetCaseName returns the caseName token.
+	GetCaseName() antlr.Token
+
+	// SetCaseName sets the caseName token.
+	SetCaseName(antlr.Token)
+
+	// GetBody returns the body rule contexts.
+	GetBody() IStatementContext
+
+	// SetBody sets the body rule contexts.
+	SetBody(IStatementContext)
+
+	// IsMatchCaseContext differentiates from other interfaces.
+	IsMatchCaseContext()
 }
 
-type EqualityExpressionContext struct {
-	*ExpressionContext
-	left  IExpressionContext
-	op    antlr.Token
-	right IExpressionContext
+type MatchCaseContext struct {
+	*antlr.BaseParserRuleContext
+	parser   antlr.Parser
+	caseName antlr.Token
+	body     IStatementContext
 }
 
-func NewEqualityExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityExpressionContext {
-	var p = new(EqualityExpressionContext)
+func NewEmptyMatchCaseContext() *MatchCaseContext {
+	var p = new(MatchCaseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_matchCase
+	return p
+}
+
+func (*MatchCaseContext) IsMatchCaseContext() {}
+
+func NewMatchCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchCaseContext {
+	var p = new(MatchCaseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.ExpressionContext = NewEmptyExpressionContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	p.RuleIndex = SimParserRULE_matchCase
 
 	return p
 }
 
-func (s *EqualityExpressionContext) GetOp() antlr.Token { return s.op }
+func (s *MatchCaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *EqualityExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func (s *MatchCaseContext) GetCaseName() antlr.Token { return s.caseName }
 
-func (s *EqualityExpressionContext) GetLeft() IExpressionContext { return s.left }
+func (s *MatchCaseContext) SetCaseName(v antlr.Token) { s.caseName = v }
 
-func (s *EqualityExpressionContext) GetRight() IExpressionContext { return s.right }
+func (s *MatchCaseContext) GetBody() IStatementContext { return s.body }
 
-func (s *EqualityExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+func (s *MatchCaseContext) SetBody(v IStatementContext) { s.body = v }
 
-func (s *EqualityExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+func (s *MatchCaseContext) ARROW() antlr.TerminalNode {
+	return s.GetToken(SimParserARROW, 0)
+}
 
-func (s *EqualityExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *MatchCaseContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
 }
 
-func (s *EqualityExpressionContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+func (s *MatchCaseContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *MatchCaseContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserLPAREN, 0)
+}
+
+func (s *MatchCaseContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SimParserRPAREN, 0)
+}
+
+func (s *MatchCaseContext) AllMatchBinding() []IMatchBindingContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMatchBindingContext)(nil)).Elem())
+	var tst = make([]IMatchBindingContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExpressionContext)
+			tst[i] = t.(IMatchBindingContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *EqualityExpressionContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *MatchCaseContext) MatchBinding(i int) IMatchBindingContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchBindingContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IMatchBindingContext)
 }
 
-func (s *EqualityExpressionContext) EQUALS() antlr.TerminalNode {
-	return s.GetToken(SimParserEQUALS, 0)
+func (s *MatchCaseContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
 }
 
-func (s *EqualityExpressionContext) NOT_EQUALS() antlr.TerminalNode {
-	return s.GetToken(SimParserNOT_EQUALS, 0)
+func (s *MatchCaseContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
 }
 
-func (s *EqualityExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchCaseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MatchCaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterEqualityExpression(s)
+		listenerT.EnterMatchCase(s)
 	}
 }
 
-func (s *EqualityExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchCaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitEqualityExpression(s)
+		listenerT.ExitMatchCase(s)
 	}
 }
 
-func (s *EqualityExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MatchCaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitEqualityExpression(s)
+		return t.VisitMatchCase(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type OrExpressionContext struct {
-	*ExpressionContext
-	left  IExpressionContext
-	right IExpressionContext
-}
-
-func NewOrExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *OrExpressionContext {
-	var p = new(OrExpressionContext)
+func (p *SimParser) MatchCase() (localctx IMatchCaseContext) {
+	localctx = NewMatchCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, SimParserRULE_matchCase)
+	var _la int
 
-	p.ExpressionContext = NewEmptyExpressionContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	defer func() {
+		p.ExitRule()
+	}()
 
-	return p
-}
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-func (s *OrExpressionContext) GetLeft() IExpressionContext { return s.left }
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(429)
 
-func (s *OrExpressionContext) GetRight() IExpressionContext { return s.right }
+		var _m = p.Match(SimParserIDENTIFIER)
 
-func (s *OrExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+		localctx.(*MatchCaseContext).caseName = _m
+	}
+	p.SetState(442)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func (s *OrExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+	if _la == SimParserLPAREN {
+		{
+			p.SetState(430)
+			p.Match(SimParserLPAREN)
+		}
+		p.SetState(439)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-func (s *OrExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+		if _la == SimParserIDENTIFIER {
+			{
+				p.SetState(431)
+				p.MatchBinding()
+			}
+			p.SetState(436)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
 
-func (s *OrExpressionContext) OR() antlr.TerminalNode {
-	return s.GetToken(SimParserOR, 0)
-}
+			for _la == SimParserCOMMA {
+				{
+					p.SetState(432)
+					p.Match(SimParserCOMMA)
+				}
+				{
+					p.SetState(433)
+					p.MatchBinding()
+				}
 
-func (s *OrExpressionContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+				p.SetState(438)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
 		}
+		{
+			p.SetState(441)
+			p.Match(SimParserRPAREN)
+		}
+
+	}
+	{
+		p.SetState(444)
+		p.Match(SimParserARROW)
 	}
+	{
+		p.SetState(445)
 
-	return tst
+		var _x = p.Statement()
+
+		localctx.(*MatchCaseContext).body = _x
+	}
+
+	return localctx
 }
 
-func (s *OrExpressionContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+// IMatchBindingContext is an interface to support dynamic dispatch.
+type IMatchBindingContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetBindingName returns the bindingName token.
+	GetBindingName() antlr.Token
+
+	// SetBindingName sets the bindingName token.
+	SetBindingName(antlr.Token)
 
-	return t.(IExpressionContext)
+	// IsMatchBindingContext differentiates from other interfaces.
+	IsMatchBindingContext()
 }
 
-func (s *OrExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterOrExpression(s)
-	}
+type MatchBindingContext struct {
+	*antlr.BaseParserRuleContext
+	parser      antlr.Parser
+	bindingName antlr.Token
 }
 
-func (s *OrExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitOrExpression(s)
-	}
+func NewEmptyMatchBindingContext() *MatchBindingContext {
+	var p = new(MatchBindingContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_matchBinding
+	return p
 }
 
-func (s *OrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SimParserVisitor:
-		return t.VisitOrExpression(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
+func (*MatchBindingContext) IsMatchBindingContext() {}
 
-type ParensExpressionContext struct {
-	*ExpressionContext
-}
+func NewMatchBindingContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchBindingContext {
+	var p = new(MatchBindingContext)
 
-func NewParensExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParensExpressionContext {
-	var p = new(ParensExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	p.ExpressionContext = NewEmptyExpressionContext()
 	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	p.RuleIndex = SimParserRULE_matchBinding
 
 	return p
 }
 
-func (s *ParensExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *MatchBindingContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ParensExpressionContext) LPAREN() antlr.TerminalNode {
-	return s.GetToken(SimParserLPAREN, 0)
-}
+func (s *MatchBindingContext) GetBindingName() antlr.Token { return s.bindingName }
 
-func (s *ParensExpressionContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *MatchBindingContext) SetBindingName(v antlr.Token) { s.bindingName = v }
 
-	if t == nil {
-		return nil
-	}
+func (s *MatchBindingContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SimParserIDENTIFIER, 0)
+}
 
-	return t.(IExpressionContext)
+func (s *MatchBindingContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *ParensExpressionContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(SimParserRPAREN, 0)
+func (s *MatchBindingContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ParensExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchBindingContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterParensExpression(s)
+		listenerT.EnterMatchBinding(s)
 	}
 }
 
-func (s *ParensExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchBindingContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitParensExpression(s)
+		listenerT.ExitMatchBinding(s)
 	}
 }
 
-func (s *ParensExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MatchBindingContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitParensExpression(s)
+		return t.VisitMatchBinding(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type MulDivModExpressionContext struct {
-	*ExpressionContext
-	left  IExpressionContext
-	op    antlr.Token
-	right IExpressionContext
+func (p *SimParser) MatchBinding() (localctx IMatchBindingContext) {
+	localctx = NewMatchBindingContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, SimParserRULE_matchBinding)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(447)
+
+		var _m = p.Match(SimParserIDENTIFIER)
+
+		localctx.(*MatchBindingContext).bindingName = _m
+	}
+
+	return localctx
 }
 
-func NewMulDivModExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MulDivModExpressionContext {
-	var p = new(MulDivModExpressionContext)
+// ISwitchCaseContext is an interface to support dynamic dispatch.
+type ISwitchCaseContext interface {
+	antlr.ParserRuleContext
 
-	p.ExpressionContext = NewEmptyExpressionContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExpressionContext))
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSwitchCaseContext differentiates from other interfaces.
+	IsSwitchCaseContext()
+}
+
+type SwitchCaseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
+func NewEmptySwitchCaseContext() *SwitchCaseContext {
+	var p = new(SwitchCaseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_switchCase
 	return p
 }
 
-func (s *MulDivModExpressionContext) GetOp() antlr.Token { return s.op }
+func (*SwitchCaseContext) IsSwitchCaseContext() {}
 
-func (s *MulDivModExpressionContext) SetOp(v antlr.Token) { s.op = v }
+func NewSwitchCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchCaseContext {
+	var p = new(SwitchCaseContext)
 
-func (s *MulDivModExpressionContext) GetLeft() IExpressionContext { return s.left }
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-func (s *MulDivModExpressionContext) GetRight() IExpressionContext { return s.right }
+	p.parser = parser
+	p.RuleIndex = SimParserRULE_switchCase
 
-func (s *MulDivModExpressionContext) SetLeft(v IExpressionContext) { s.left = v }
+	return p
+}
 
-func (s *MulDivModExpressionContext) SetRight(v IExpressionContext) { s.right = v }
+func (s *SwitchCaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MulDivModExpressionContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *SwitchCaseContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
 }
 
-func (s *MulDivModExpressionContext) AllExpression() []IExpressionContext {
+func (s *SwitchCaseContext) CASE() antlr.TerminalNode {
+	return s.GetToken(SimParserCASE, 0)
+}
+
+func (s *SwitchCaseContext) AllExpression() []IExpressionContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
 	var tst = make([]IExpressionContext, len(ts))
 
@@ -2258,7 +7255,7 @@ func (s *MulDivModExpressionContext) AllExpression() []IExpressionContext {
 	return tst
 }
 
-func (s *MulDivModExpressionContext) Expression(i int) IExpressionContext {
+func (s *SwitchCaseContext) Expression(i int) IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -2268,56 +7265,78 @@ func (s *MulDivModExpressionContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *MulDivModExpressionContext) MULTIPLY() antlr.TerminalNode {
-	return s.GetToken(SimParserMULTIPLY, 0)
+func (s *SwitchCaseContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(SimParserDEFAULT, 0)
 }
 
-func (s *MulDivModExpressionContext) DIVIDE() antlr.TerminalNode {
-	return s.GetToken(SimParserDIVIDE, 0)
+func (s *SwitchCaseContext) AllStatement() []IStatementContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementContext)(nil)).Elem())
+	var tst = make([]IStatementContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IStatementContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *MulDivModExpressionContext) MODULO() antlr.TerminalNode {
-	return s.GetToken(SimParserMODULO, 0)
+func (s *SwitchCaseContext) Statement(i int) IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
 }
 
-func (s *MulDivModExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SwitchCaseContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimParserCOMMA)
+}
+
+func (s *SwitchCaseContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimParserCOMMA, i)
+}
+
+func (s *SwitchCaseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SwitchCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SwitchCaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.EnterMulDivModExpression(s)
+		listenerT.EnterSwitchCase(s)
 	}
 }
 
-func (s *MulDivModExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SwitchCaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SimParserListener); ok {
-		listenerT.ExitMulDivModExpression(s)
+		listenerT.ExitSwitchCase(s)
 	}
 }
 
-func (s *MulDivModExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SwitchCaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimParserVisitor:
-		return t.VisitMulDivModExpression(s)
+		return t.VisitSwitchCase(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimParser) Expression() (localctx IExpressionContext) {
-	return p.expression(0)
-}
-
-func (p *SimParser) expression(_p int) (localctx IExpressionContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-	_parentState := p.GetState()
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExpressionContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 4
-	p.EnterRecursionRule(localctx, 4, SimParserRULE_expression, _p)
+func (p *SimParser) SwitchCase() (localctx ISwitchCaseContext) {
+	localctx = NewSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, SimParserRULE_switchCase)
 	var _la int
 
 	defer func() {
-		p.UnrollRecursionContexts(_parentctx)
+		p.ExitRule()
 	}()
 
 	defer func() {
@@ -2328,297 +7347,229 @@ func (p *SimParser) expression(_p int) (localctx IExpressionContext) {
 				p.GetErrorHandler().Recover(p, v)
 			} else {
 				panic(err)
-			}
-		}
-	}()
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(93)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SimParserLPAREN:
-		localctx = NewParensExpressionContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-
-		{
-			p.SetState(83)
-			p.Match(SimParserLPAREN)
-		}
-		{
-			p.SetState(84)
-			p.expression(0)
-		}
-		{
-			p.SetState(85)
-			p.Match(SimParserRPAREN)
-		}
-
-	case SimParserSUBTRACT:
-		localctx = NewNegateExpressionContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(87)
-			p.Match(SimParserSUBTRACT)
-		}
-		{
-			p.SetState(88)
-			p.expression(10)
-		}
-
-	case SimParserNOT:
-		localctx = NewNotExpressionContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(89)
-			p.Match(SimParserNOT)
-		}
-		{
-			p.SetState(90)
-			p.expression(9)
-		}
-
-	case SimParserIDENTIFIER:
-		localctx = NewVariableExpressionContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(91)
-			p.Match(SimParserIDENTIFIER)
-		}
-
-	case SimParserTRUE, SimParserFALSE, SimParserNUMBER:
-		localctx = NewLiteralExpressionContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
-		{
-			p.SetState(92)
-			_la = p.GetTokenStream().LA(1)
-
-			if !(((_la-8)&-(0x1f+1)) == 0 && ((1<<uint((_la-8)))&((1<<(SimParserTRUE-8))|(1<<(SimParserFALSE-8))|(1<<(SimParserNUMBER-8)))) != 0) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(115)
-	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext())
-
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			p.SetState(113)
-			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewMulDivModExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				localctx.(*MulDivModExpressionContext).left = _prevctx
-
-				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
-				p.SetState(95)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
-				}
-				{
-					p.SetState(96)
+			}
+		}
+	}()
 
-					var _lt = p.GetTokenStream().LT(1)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(459)
+	p.GetErrorHandler().Sync(p)
 
-					localctx.(*MulDivModExpressionContext).op = _lt
+	switch p.GetTokenStream().LA(1) {
+	case SimParserCASE:
+		{
+			p.SetState(449)
+			p.Match(SimParserCASE)
+		}
+		{
+			p.SetState(450)
+			p.expression(0)
+		}
+		p.SetState(455)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-					_la = p.GetTokenStream().LA(1)
+		for _la == SimParserCOMMA {
+			{
+				p.SetState(451)
+				p.Match(SimParserCOMMA)
+			}
+			{
+				p.SetState(452)
+				p.expression(0)
+			}
 
-					if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserMULTIPLY)|(1<<SimParserDIVIDE)|(1<<SimParserMODULO))) != 0) {
-						var _ri = p.GetErrorHandler().RecoverInline(p)
+			p.SetState(457)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
 
-						localctx.(*MulDivModExpressionContext).op = _ri
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(97)
+	case SimParserDEFAULT:
+		{
+			p.SetState(458)
+			p.Match(SimParserDEFAULT)
+		}
 
-					var _x = p.expression(9)
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(461)
+		p.Match(SimParserCOLON)
+	}
+	p.SetState(465)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-					localctx.(*MulDivModExpressionContext).right = _x
-				}
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserFUNCTION)|(1<<SimParserFN)|(1<<SimParserTYPE)|(1<<SimParserCONST)|(1<<SimParserVAR)|(1<<SimParserENUM)|(1<<SimParserMATCH)|(1<<SimParserSWITCH)|(1<<SimParserIF)|(1<<SimParserLOOP)|(1<<SimParserRETURN)|(1<<SimParserBREAK)|(1<<SimParserCONTINUE)|(1<<SimParserTRUE)|(1<<SimParserFALSE)|(1<<SimParserNOT)|(1<<SimParserPRINT))) != 0) || (((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(SimParserSUBTRACT-33))|(1<<(SimParserBITWISE_NOT-33))|(1<<(SimParserLPAREN-33))|(1<<(SimParserLBRACE-33))|(1<<(SimParserLBRACKET-33)))) != 0) || (((_la-70)&-(0x1f+1)) == 0 && ((1<<uint((_la-70)))&((1<<(SimParserNUMBER-70))|(1<<(SimParserMULTILINE_STRING-70))|(1<<(SimParserSTRING-70))|(1<<(SimParserRAW_STRING-70))|(1<<(SimParserIDENTIFIER-70)))) != 0) {
+		{
+			p.SetState(462)
+			p.Statement()
+		}
 
-			case 2:
-				localctx = NewAddSubExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				localctx.(*AddSubExpressionContext).left = _prevctx
+		p.SetState(467)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
 
-				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
-				p.SetState(98)
+	return localctx
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
-				}
-				{
-					p.SetState(99)
+// IMapEntryContext is an interface to support dynamic dispatch.
+type IMapEntryContext interface {
+	antlr.ParserRuleContext
 
-					var _lt = p.GetTokenStream().LT(1)
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-					localctx.(*AddSubExpressionContext).op = _lt
+	// GetKey returns the key rule contexts.
+	GetKey() IExpressionContext
 
-					_la = p.GetTokenStream().LA(1)
+	// GetValue returns the value rule contexts.
+	GetValue() IExpressionContext
 
-					if !(_la == SimParserADD || _la == SimParserSUBTRACT) {
-						var _ri = p.GetErrorHandler().RecoverInline(p)
+	// SetKey sets the key rule contexts.
+	SetKey(IExpressionContext)
 
-						localctx.(*AddSubExpressionContext).op = _ri
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(100)
+	// SetValue sets the value rule contexts.
+	SetValue(IExpressionContext)
 
-					var _x = p.expression(8)
+	// IsMapEntryContext differentiates from other interfaces.
+	IsMapEntryContext()
+}
 
-					localctx.(*AddSubExpressionContext).right = _x
-				}
+type MapEntryContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	key    IExpressionContext
+	value  IExpressionContext
+}
 
-			case 3:
-				localctx = NewInequalityExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				localctx.(*InequalityExpressionContext).left = _prevctx
+func NewEmptyMapEntryContext() *MapEntryContext {
+	var p = new(MapEntryContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimParserRULE_mapEntry
+	return p
+}
 
-				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
-				p.SetState(101)
+func (*MapEntryContext) IsMapEntryContext() {}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
-				}
-				{
-					p.SetState(102)
+func NewMapEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapEntryContext {
+	var p = new(MapEntryContext)
 
-					var _lt = p.GetTokenStream().LT(1)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-					localctx.(*InequalityExpressionContext).op = _lt
+	p.parser = parser
+	p.RuleIndex = SimParserRULE_mapEntry
 
-					_la = p.GetTokenStream().LA(1)
+	return p
+}
 
-					if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserGREATER)|(1<<SimParserLESSER)|(1<<SimParserGREATER_OR_EQUAL)|(1<<SimParserLESSER_OR_EQUAL))) != 0) {
-						var _ri = p.GetErrorHandler().RecoverInline(p)
+func (s *MapEntryContext) GetParser() antlr.Parser { return s.parser }
 
-						localctx.(*InequalityExpressionContext).op = _ri
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(103)
+func (s *MapEntryContext) GetKey() IExpressionContext { return s.key }
 
-					var _x = p.expression(7)
+func (s *MapEntryContext) GetValue() IExpressionContext { return s.value }
 
-					localctx.(*InequalityExpressionContext).right = _x
-				}
+func (s *MapEntryContext) SetKey(v IExpressionContext) { s.key = v }
 
-			case 4:
-				localctx = NewEqualityExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				localctx.(*EqualityExpressionContext).left = _prevctx
+func (s *MapEntryContext) SetValue(v IExpressionContext) { s.value = v }
 
-				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
-				p.SetState(104)
+func (s *MapEntryContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SimParserCOLON, 0)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
-				}
-				{
-					p.SetState(105)
+func (s *MapEntryContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
 
-					var _lt = p.GetTokenStream().LT(1)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
 
-					localctx.(*EqualityExpressionContext).op = _lt
+	return tst
+}
 
-					_la = p.GetTokenStream().LA(1)
+func (s *MapEntryContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
 
-					if !(_la == SimParserEQUALS || _la == SimParserNOT_EQUALS) {
-						var _ri = p.GetErrorHandler().RecoverInline(p)
+	if t == nil {
+		return nil
+	}
 
-						localctx.(*EqualityExpressionContext).op = _ri
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(106)
+	return t.(IExpressionContext)
+}
 
-					var _x = p.expression(6)
+func (s *MapEntryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-					localctx.(*EqualityExpressionContext).right = _x
-				}
+func (s *MapEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-			case 5:
-				localctx = NewAndExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				localctx.(*AndExpressionContext).left = _prevctx
+func (s *MapEntryContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.EnterMapEntry(s)
+	}
+}
 
-				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
-				p.SetState(107)
+func (s *MapEntryContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SimParserListener); ok {
+		listenerT.ExitMapEntry(s)
+	}
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-				}
-				{
-					p.SetState(108)
-					p.Match(SimParserAND)
-				}
-				{
-					p.SetState(109)
+func (s *MapEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimParserVisitor:
+		return t.VisitMapEntry(s)
 
-					var _x = p.expression(5)
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
-					localctx.(*AndExpressionContext).right = _x
-				}
+func (p *SimParser) MapEntry() (localctx IMapEntryContext) {
+	localctx = NewMapEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, SimParserRULE_mapEntry)
 
-			case 6:
-				localctx = NewOrExpressionContext(p, NewExpressionContext(p, _parentctx, _parentState))
-				localctx.(*OrExpressionContext).left = _prevctx
+	defer func() {
+		p.ExitRule()
+	}()
 
-				p.PushNewRecursionContext(localctx, _startState, SimParserRULE_expression)
-				p.SetState(110)
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-				}
-				{
-					p.SetState(111)
-					p.Match(SimParserOR)
-				}
-				{
-					p.SetState(112)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(468)
 
-					var _x = p.expression(4)
+		var _x = p.expression(0)
 
-					localctx.(*OrExpressionContext).right = _x
-				}
+		localctx.(*MapEntryContext).key = _x
+	}
+	{
+		p.SetState(469)
+		p.Match(SimParserCOLON)
+	}
+	{
+		p.SetState(470)
 
-			}
+		var _x = p.expression(0)
 
-		}
-		p.SetState(117)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext())
+		localctx.(*MapEntryContext).value = _x
 	}
 
 	return localctx
@@ -2686,6 +7637,26 @@ func (s *Assignment_opContext) MOD_ASSIGNMENT() antlr.TerminalNode {
 	return s.GetToken(SimParserMOD_ASSIGNMENT, 0)
 }
 
+func (s *Assignment_opContext) BITWISE_AND_ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserBITWISE_AND_ASSIGNMENT, 0)
+}
+
+func (s *Assignment_opContext) BITWISE_OR_ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserBITWISE_OR_ASSIGNMENT, 0)
+}
+
+func (s *Assignment_opContext) BITWISE_XOR_ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserBITWISE_XOR_ASSIGNMENT, 0)
+}
+
+func (s *Assignment_opContext) LEFT_SHIFT_ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserLEFT_SHIFT_ASSIGNMENT, 0)
+}
+
+func (s *Assignment_opContext) RIGHT_SHIFT_ASSIGNMENT() antlr.TerminalNode {
+	return s.GetToken(SimParserRIGHT_SHIFT_ASSIGNMENT, 0)
+}
+
 func (s *Assignment_opContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -2718,7 +7689,7 @@ func (s *Assignment_opContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SimParser) Assignment_op() (localctx IAssignment_opContext) {
 	localctx = NewAssignment_opContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, SimParserRULE_assignment_op)
+	p.EnterRule(localctx, 24, SimParserRULE_assignment_op)
 	var _la int
 
 	defer func() {
@@ -2739,10 +7710,10 @@ func (p *SimParser) Assignment_op() (localctx IAssignment_opContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(118)
+		p.SetState(472)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimParserASSIGNMENT)|(1<<SimParserADD_ASSIGNMENT)|(1<<SimParserSUB_ASSIGNMENT)|(1<<SimParserMUL_ASSIGNMENT)|(1<<SimParserDIV_ASSIGNMENT)|(1<<SimParserMOD_ASSIGNMENT))) != 0) {
+		if !(((_la-40)&-(0x1f+1)) == 0 && ((1<<uint((_la-40)))&((1<<(SimParserASSIGNMENT-40))|(1<<(SimParserADD_ASSIGNMENT-40))|(1<<(SimParserSUB_ASSIGNMENT-40))|(1<<(SimParserMUL_ASSIGNMENT-40))|(1<<(SimParserDIV_ASSIGNMENT-40))|(1<<(SimParserMOD_ASSIGNMENT-40))|(1<<(SimParserBITWISE_AND_ASSIGNMENT-40))|(1<<(SimParserBITWISE_OR_ASSIGNMENT-40))|(1<<(SimParserBITWISE_XOR_ASSIGNMENT-40))|(1<<(SimParserLEFT_SHIFT_ASSIGNMENT-40))|(1<<(SimParserRIGHT_SHIFT_ASSIGNMENT-40)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -2827,7 +7798,7 @@ func (s *EosContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SimParser) Eos() (localctx IEosContext) {
 	localctx = NewEosContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, SimParserRULE_eos)
+	p.EnterRule(localctx, 26, SimParserRULE_eos)
 
 	defer func() {
 		p.ExitRule()
@@ -2845,19 +7816,19 @@ func (p *SimParser) Eos() (localctx IEosContext) {
 		}
 	}()
 
-	p.SetState(123)
+	p.SetState(477)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(120)
+			p.SetState(474)
 			p.Match(SimParserEOF)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(121)
+		p.SetState(475)
 
 		if !(lineTerminatorAhead(p)) {
 			panic(antlr.NewFailedPredicateException(p, "lineTerminatorAhead(p)", ""))
@@ -2865,7 +7836,7 @@ func (p *SimParser) Eos() (localctx IEosContext) {
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(122)
+		p.SetState(476)
 
 		if !(checkPreviousTokenText(p, "}")) {
 			panic(antlr.NewFailedPredicateException(p, "checkPreviousTokenText(p, \"}\")", ""))
@@ -2878,6 +7849,13 @@ func (p *SimParser) Eos() (localctx IEosContext) {
 
 func (p *SimParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
+	case 1:
+		var t *StatementContext = nil
+		if localctx != nil {
+			t = localctx.(*StatementContext)
+		}
+		return p.Statement_Sempred(t, predIndex)
+
 	case 2:
 		var t *ExpressionContext = nil
 		if localctx != nil {
@@ -2885,7 +7863,7 @@ func (p *SimParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int
 		}
 		return p.Expression_Sempred(t, predIndex)
 
-	case 4:
+	case 13:
 		var t *EosContext = nil
 		if localctx != nil {
 			t = localctx.(*EosContext)
@@ -2897,25 +7875,62 @@ func (p *SimParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int
 	}
 }
 
-func (p *SimParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+func (p *SimParser) Statement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 8)
+		return !lineTerminatorAhead(p)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 7)
+		return !lineTerminatorAhead(p)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
 
+func (p *SimParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 6)
+		return p.Precpred(p.GetParserRuleContext(), 23)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 5)
+		return p.Precpred(p.GetParserRuleContext(), 22)
 
 	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 21)
 
 	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 3)
+		return p.Precpred(p.GetParserRuleContext(), 20)
+
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 16)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 15)
+
+	case 8:
+		return p.Precpred(p.GetParserRuleContext(), 14)
+
+	case 9:
+		return p.Precpred(p.GetParserRuleContext(), 13)
+
+	case 10:
+		return p.Precpred(p.GetParserRuleContext(), 12)
+
+	case 11:
+		return p.Precpred(p.GetParserRuleContext(), 11)
+
+	case 12:
+		return p.Precpred(p.GetParserRuleContext(), 10)
+
+	case 13:
+		return p.Precpred(p.GetParserRuleContext(), 9)
+
+	case 14:
+		return p.Precpred(p.GetParserRuleContext(), 8)
+
+	case 15:
+		return p.Precpred(p.GetParserRuleContext(), 7)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -2924,10 +7939,10 @@ func (p *SimParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int
 
 func (p *SimParser) Eos_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 6:
+	case 16:
 		return lineTerminatorAhead(p)
 
-	case 7:
+	case 17:
 		return checkPreviousTokenText(p, "}")
 
 	default:
@@ -56,18 +56,68 @@ func (s *BaseSimParserListener) EnterLoopStatement(ctx *LoopStatementContext) {}
 // ExitLoopStatement is called when production LoopStatement is exited.
 func (s *BaseSimParserListener) ExitLoopStatement(ctx *LoopStatementContext) {}
 
+// EnterForEachStatement is called when production ForEachStatement is entered.
+func (s *BaseSimParserListener) EnterForEachStatement(ctx *ForEachStatementContext) {}
+
+// ExitForEachStatement is called when production ForEachStatement is exited.
+func (s *BaseSimParserListener) ExitForEachStatement(ctx *ForEachStatementContext) {}
+
 // EnterFunctionStatement is called when production FunctionStatement is entered.
 func (s *BaseSimParserListener) EnterFunctionStatement(ctx *FunctionStatementContext) {}
 
 // ExitFunctionStatement is called when production FunctionStatement is exited.
 func (s *BaseSimParserListener) ExitFunctionStatement(ctx *FunctionStatementContext) {}
 
+// EnterStructStatement is called when production StructStatement is entered.
+func (s *BaseSimParserListener) EnterStructStatement(ctx *StructStatementContext) {}
+
+// ExitStructStatement is called when production StructStatement is exited.
+func (s *BaseSimParserListener) ExitStructStatement(ctx *StructStatementContext) {}
+
+// EnterEnumStatement is called when production EnumStatement is entered.
+func (s *BaseSimParserListener) EnterEnumStatement(ctx *EnumStatementContext) {}
+
+// ExitEnumStatement is called when production EnumStatement is exited.
+func (s *BaseSimParserListener) ExitEnumStatement(ctx *EnumStatementContext) {}
+
+// EnterUnionStatement is called when production UnionStatement is entered.
+func (s *BaseSimParserListener) EnterUnionStatement(ctx *UnionStatementContext) {}
+
+// ExitUnionStatement is called when production UnionStatement is exited.
+func (s *BaseSimParserListener) ExitUnionStatement(ctx *UnionStatementContext) {}
+
+// EnterMatchStatement is called when production MatchStatement is entered.
+func (s *BaseSimParserListener) EnterMatchStatement(ctx *MatchStatementContext) {}
+
+// ExitMatchStatement is called when production MatchStatement is exited.
+func (s *BaseSimParserListener) ExitMatchStatement(ctx *MatchStatementContext) {}
+
+// EnterSwitchStatement is called when production SwitchStatement is entered.
+func (s *BaseSimParserListener) EnterSwitchStatement(ctx *SwitchStatementContext) {}
+
+// ExitSwitchStatement is called when production SwitchStatement is exited.
+func (s *BaseSimParserListener) ExitSwitchStatement(ctx *SwitchStatementContext) {}
+
 // EnterDeclarationStatement is called when production DeclarationStatement is entered.
 func (s *BaseSimParserListener) EnterDeclarationStatement(ctx *DeclarationStatementContext) {}
 
 // ExitDeclarationStatement is called when production DeclarationStatement is exited.
 func (s *BaseSimParserListener) ExitDeclarationStatement(ctx *DeclarationStatementContext) {}
 
+// EnterConstStatement is called when production ConstStatement is entered.
+func (s *BaseSimParserListener) EnterConstStatement(ctx *ConstStatementContext) {}
+
+// ExitConstStatement is called when production ConstStatement is exited.
+func (s *BaseSimParserListener) ExitConstStatement(ctx *ConstStatementContext) {}
+
+// EnterInferredDeclarationStatement is called when production InferredDeclarationStatement is entered.
+func (s *BaseSimParserListener) EnterInferredDeclarationStatement(ctx *InferredDeclarationStatementContext) {
+}
+
+// ExitInferredDeclarationStatement is called when production InferredDeclarationStatement is exited.
+func (s *BaseSimParserListener) ExitInferredDeclarationStatement(ctx *InferredDeclarationStatementContext) {
+}
+
 // EnterAssignmentStatement is called when production AssignmentStatement is entered.
 func (s *BaseSimParserListener) EnterAssignmentStatement(ctx *AssignmentStatementContext) {}
 
@@ -98,29 +148,23 @@ func (s *BaseSimParserListener) EnterContinueStatement(ctx *ContinueStatementCon
 // ExitContinueStatement is called when production ContinueStatement is exited.
 func (s *BaseSimParserListener) ExitContinueStatement(ctx *ContinueStatementContext) {}
 
-// EnterNegateExpression is called when production NegateExpression is entered.
-func (s *BaseSimParserListener) EnterNegateExpression(ctx *NegateExpressionContext) {}
+// EnterMapExpression is called when production MapExpression is entered.
+func (s *BaseSimParserListener) EnterMapExpression(ctx *MapExpressionContext) {}
 
-// ExitNegateExpression is called when production NegateExpression is exited.
-func (s *BaseSimParserListener) ExitNegateExpression(ctx *NegateExpressionContext) {}
-
-// EnterAddSubExpression is called when production AddSubExpression is entered.
-func (s *BaseSimParserListener) EnterAddSubExpression(ctx *AddSubExpressionContext) {}
-
-// ExitAddSubExpression is called when production AddSubExpression is exited.
-func (s *BaseSimParserListener) ExitAddSubExpression(ctx *AddSubExpressionContext) {}
+// ExitMapExpression is called when production MapExpression is exited.
+func (s *BaseSimParserListener) ExitMapExpression(ctx *MapExpressionContext) {}
 
-// EnterInequalityExpression is called when production InequalityExpression is entered.
-func (s *BaseSimParserListener) EnterInequalityExpression(ctx *InequalityExpressionContext) {}
+// EnterInvokeExpression is called when production InvokeExpression is entered.
+func (s *BaseSimParserListener) EnterInvokeExpression(ctx *InvokeExpressionContext) {}
 
-// ExitInequalityExpression is called when production InequalityExpression is exited.
-func (s *BaseSimParserListener) ExitInequalityExpression(ctx *InequalityExpressionContext) {}
+// ExitInvokeExpression is called when production InvokeExpression is exited.
+func (s *BaseSimParserListener) ExitInvokeExpression(ctx *InvokeExpressionContext) {}
 
-// EnterAndExpression is called when production AndExpression is entered.
-func (s *BaseSimParserListener) EnterAndExpression(ctx *AndExpressionContext) {}
+// EnterBitwiseXorExpression is called when production BitwiseXorExpression is entered.
+func (s *BaseSimParserListener) EnterBitwiseXorExpression(ctx *BitwiseXorExpressionContext) {}
 
-// ExitAndExpression is called when production AndExpression is exited.
-func (s *BaseSimParserListener) ExitAndExpression(ctx *AndExpressionContext) {}
+// ExitBitwiseXorExpression is called when production BitwiseXorExpression is exited.
+func (s *BaseSimParserListener) ExitBitwiseXorExpression(ctx *BitwiseXorExpressionContext) {}
 
 // EnterLiteralExpression is called when production LiteralExpression is entered.
 func (s *BaseSimParserListener) EnterLiteralExpression(ctx *LiteralExpressionContext) {}
@@ -128,6 +172,24 @@ func (s *BaseSimParserListener) EnterLiteralExpression(ctx *LiteralExpressionCon
 // ExitLiteralExpression is called when production LiteralExpression is exited.
 func (s *BaseSimParserListener) ExitLiteralExpression(ctx *LiteralExpressionContext) {}
 
+// EnterSliceExpression is called when production SliceExpression is entered.
+func (s *BaseSimParserListener) EnterSliceExpression(ctx *SliceExpressionContext) {}
+
+// ExitSliceExpression is called when production SliceExpression is exited.
+func (s *BaseSimParserListener) ExitSliceExpression(ctx *SliceExpressionContext) {}
+
+// EnterBitwiseNotExpression is called when production BitwiseNotExpression is entered.
+func (s *BaseSimParserListener) EnterBitwiseNotExpression(ctx *BitwiseNotExpressionContext) {}
+
+// ExitBitwiseNotExpression is called when production BitwiseNotExpression is exited.
+func (s *BaseSimParserListener) ExitBitwiseNotExpression(ctx *BitwiseNotExpressionContext) {}
+
+// EnterIndexExpression is called when production IndexExpression is entered.
+func (s *BaseSimParserListener) EnterIndexExpression(ctx *IndexExpressionContext) {}
+
+// ExitIndexExpression is called when production IndexExpression is exited.
+func (s *BaseSimParserListener) ExitIndexExpression(ctx *IndexExpressionContext) {}
+
 // EnterNotExpression is called when production NotExpression is entered.
 func (s *BaseSimParserListener) EnterNotExpression(ctx *NotExpressionContext) {}
 
@@ -140,11 +202,11 @@ func (s *BaseSimParserListener) EnterVariableExpression(ctx *VariableExpressionC
 // ExitVariableExpression is called when production VariableExpression is exited.
 func (s *BaseSimParserListener) ExitVariableExpression(ctx *VariableExpressionContext) {}
 
-// EnterEqualityExpression is called when production EqualityExpression is entered.
-func (s *BaseSimParserListener) EnterEqualityExpression(ctx *EqualityExpressionContext) {}
+// EnterShiftExpression is called when production ShiftExpression is entered.
+func (s *BaseSimParserListener) EnterShiftExpression(ctx *ShiftExpressionContext) {}
 
-// ExitEqualityExpression is called when production EqualityExpression is exited.
-func (s *BaseSimParserListener) ExitEqualityExpression(ctx *EqualityExpressionContext) {}
+// ExitShiftExpression is called when production ShiftExpression is exited.
+func (s *BaseSimParserListener) ExitShiftExpression(ctx *ShiftExpressionContext) {}
 
 // EnterOrExpression is called when production OrExpression is entered.
 func (s *BaseSimParserListener) EnterOrExpression(ctx *OrExpressionContext) {}
@@ -164,6 +226,126 @@ func (s *BaseSimParserListener) EnterMulDivModExpression(ctx *MulDivModExpressio
 // ExitMulDivModExpression is called when production MulDivModExpression is exited.
 func (s *BaseSimParserListener) ExitMulDivModExpression(ctx *MulDivModExpressionContext) {}
 
+// EnterFieldExpression is called when production FieldExpression is entered.
+func (s *BaseSimParserListener) EnterFieldExpression(ctx *FieldExpressionContext) {}
+
+// ExitFieldExpression is called when production FieldExpression is exited.
+func (s *BaseSimParserListener) ExitFieldExpression(ctx *FieldExpressionContext) {}
+
+// EnterNegateExpression is called when production NegateExpression is entered.
+func (s *BaseSimParserListener) EnterNegateExpression(ctx *NegateExpressionContext) {}
+
+// ExitNegateExpression is called when production NegateExpression is exited.
+func (s *BaseSimParserListener) ExitNegateExpression(ctx *NegateExpressionContext) {}
+
+// EnterAddSubExpression is called when production AddSubExpression is entered.
+func (s *BaseSimParserListener) EnterAddSubExpression(ctx *AddSubExpressionContext) {}
+
+// ExitAddSubExpression is called when production AddSubExpression is exited.
+func (s *BaseSimParserListener) ExitAddSubExpression(ctx *AddSubExpressionContext) {}
+
+// EnterBitwiseAndExpression is called when production BitwiseAndExpression is entered.
+func (s *BaseSimParserListener) EnterBitwiseAndExpression(ctx *BitwiseAndExpressionContext) {}
+
+// ExitBitwiseAndExpression is called when production BitwiseAndExpression is exited.
+func (s *BaseSimParserListener) ExitBitwiseAndExpression(ctx *BitwiseAndExpressionContext) {}
+
+// EnterInequalityExpression is called when production InequalityExpression is entered.
+func (s *BaseSimParserListener) EnterInequalityExpression(ctx *InequalityExpressionContext) {}
+
+// ExitInequalityExpression is called when production InequalityExpression is exited.
+func (s *BaseSimParserListener) ExitInequalityExpression(ctx *InequalityExpressionContext) {}
+
+// EnterFunctionExpression is called when production FunctionExpression is entered.
+func (s *BaseSimParserListener) EnterFunctionExpression(ctx *FunctionExpressionContext) {}
+
+// ExitFunctionExpression is called when production FunctionExpression is exited.
+func (s *BaseSimParserListener) ExitFunctionExpression(ctx *FunctionExpressionContext) {}
+
+// EnterAndExpression is called when production AndExpression is entered.
+func (s *BaseSimParserListener) EnterAndExpression(ctx *AndExpressionContext) {}
+
+// ExitAndExpression is called when production AndExpression is exited.
+func (s *BaseSimParserListener) ExitAndExpression(ctx *AndExpressionContext) {}
+
+// EnterArrayExpression is called when production ArrayExpression is entered.
+func (s *BaseSimParserListener) EnterArrayExpression(ctx *ArrayExpressionContext) {}
+
+// ExitArrayExpression is called when production ArrayExpression is exited.
+func (s *BaseSimParserListener) ExitArrayExpression(ctx *ArrayExpressionContext) {}
+
+// EnterEqualityExpression is called when production EqualityExpression is entered.
+func (s *BaseSimParserListener) EnterEqualityExpression(ctx *EqualityExpressionContext) {}
+
+// ExitEqualityExpression is called when production EqualityExpression is exited.
+func (s *BaseSimParserListener) ExitEqualityExpression(ctx *EqualityExpressionContext) {}
+
+// EnterCallExpression is called when production CallExpression is entered.
+func (s *BaseSimParserListener) EnterCallExpression(ctx *CallExpressionContext) {}
+
+// ExitCallExpression is called when production CallExpression is exited.
+func (s *BaseSimParserListener) ExitCallExpression(ctx *CallExpressionContext) {}
+
+// EnterBitwiseOrExpression is called when production BitwiseOrExpression is entered.
+func (s *BaseSimParserListener) EnterBitwiseOrExpression(ctx *BitwiseOrExpressionContext) {}
+
+// ExitBitwiseOrExpression is called when production BitwiseOrExpression is exited.
+func (s *BaseSimParserListener) ExitBitwiseOrExpression(ctx *BitwiseOrExpressionContext) {}
+
+// EnterTypeSpec is called when production typeSpec is entered.
+func (s *BaseSimParserListener) EnterTypeSpec(ctx *TypeSpecContext) {}
+
+// ExitTypeSpec is called when production typeSpec is exited.
+func (s *BaseSimParserListener) ExitTypeSpec(ctx *TypeSpecContext) {}
+
+// EnterParameter is called when production parameter is entered.
+func (s *BaseSimParserListener) EnterParameter(ctx *ParameterContext) {}
+
+// ExitParameter is called when production parameter is exited.
+func (s *BaseSimParserListener) ExitParameter(ctx *ParameterContext) {}
+
+// EnterStructField is called when production structField is entered.
+func (s *BaseSimParserListener) EnterStructField(ctx *StructFieldContext) {}
+
+// ExitStructField is called when production structField is exited.
+func (s *BaseSimParserListener) ExitStructField(ctx *StructFieldContext) {}
+
+// EnterEnumMember is called when production enumMember is entered.
+func (s *BaseSimParserListener) EnterEnumMember(ctx *EnumMemberContext) {}
+
+// ExitEnumMember is called when production enumMember is exited.
+func (s *BaseSimParserListener) ExitEnumMember(ctx *EnumMemberContext) {}
+
+// EnterUnionVariant is called when production unionVariant is entered.
+func (s *BaseSimParserListener) EnterUnionVariant(ctx *UnionVariantContext) {}
+
+// ExitUnionVariant is called when production unionVariant is exited.
+func (s *BaseSimParserListener) ExitUnionVariant(ctx *UnionVariantContext) {}
+
+// EnterMatchCase is called when production matchCase is entered.
+func (s *BaseSimParserListener) EnterMatchCase(ctx *MatchCaseContext) {}
+
+// ExitMatchCase is called when production matchCase is exited.
+func (s *BaseSimParserListener) ExitMatchCase(ctx *MatchCaseContext) {}
+
+// EnterMatchBinding is called when production matchBinding is entered.
+func (s *BaseSimParserListener) EnterMatchBinding(ctx *MatchBindingContext) {}
+
+// ExitMatchBinding is called when production matchBinding is exited.
+func (s *BaseSimParserListener) ExitMatchBinding(ctx *MatchBindingContext) {}
+
+// EnterSwitchCase is called when production switchCase is entered.
+func (s *BaseSimParserListener) EnterSwitchCase(ctx *SwitchCaseContext) {}
+
+// ExitSwitchCase is called when production switchCase is exited.
+func (s *BaseSimParserListener) ExitSwitchCase(ctx *SwitchCaseContext) {}
+
+// EnterMapEntry is called when production mapEntry is entered.
+func (s *BaseSimParserListener) EnterMapEntry(ctx *MapEntryContext) {}
+
+// ExitMapEntry is called when production mapEntry is exited.
+func (s *BaseSimParserListener) ExitMapEntry(ctx *MapEntryContext) {}
+
 // EnterAssignment_op is called when production assignment_op is entered.
 func (s *BaseSimParserListener) EnterAssignment_op(ctx *Assignment_opContext) {}
 
@@ -31,14 +31,46 @@ func (v *BaseSimParserVisitor) VisitLoopStatement(ctx *LoopStatementContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimParserVisitor) VisitForEachStatement(ctx *ForEachStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimParserVisitor) VisitFunctionStatement(ctx *FunctionStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimParserVisitor) VisitStructStatement(ctx *StructStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitEnumStatement(ctx *EnumStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitUnionStatement(ctx *UnionStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitMatchStatement(ctx *MatchStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitSwitchStatement(ctx *SwitchStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimParserVisitor) VisitDeclarationStatement(ctx *DeclarationStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimParserVisitor) VisitConstStatement(ctx *ConstStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitInferredDeclarationStatement(ctx *InferredDeclarationStatementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimParserVisitor) VisitAssignmentStatement(ctx *AssignmentStatementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -59,23 +91,31 @@ func (v *BaseSimParserVisitor) VisitContinueStatement(ctx *ContinueStatementCont
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSimParserVisitor) VisitNegateExpression(ctx *NegateExpressionContext) interface{} {
+func (v *BaseSimParserVisitor) VisitMapExpression(ctx *MapExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSimParserVisitor) VisitAddSubExpression(ctx *AddSubExpressionContext) interface{} {
+func (v *BaseSimParserVisitor) VisitInvokeExpression(ctx *InvokeExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSimParserVisitor) VisitInequalityExpression(ctx *InequalityExpressionContext) interface{} {
+func (v *BaseSimParserVisitor) VisitBitwiseXorExpression(ctx *BitwiseXorExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSimParserVisitor) VisitAndExpression(ctx *AndExpressionContext) interface{} {
+func (v *BaseSimParserVisitor) VisitLiteralExpression(ctx *LiteralExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSimParserVisitor) VisitLiteralExpression(ctx *LiteralExpressionContext) interface{} {
+func (v *BaseSimParserVisitor) VisitSliceExpression(ctx *SliceExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitBitwiseNotExpression(ctx *BitwiseNotExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitIndexExpression(ctx *IndexExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -87,7 +127,7 @@ func (v *BaseSimParserVisitor) VisitVariableExpression(ctx *VariableExpressionCo
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSimParserVisitor) VisitEqualityExpression(ctx *EqualityExpressionContext) interface{} {
+func (v *BaseSimParserVisitor) VisitShiftExpression(ctx *ShiftExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -103,6 +143,86 @@ func (v *BaseSimParserVisitor) VisitMulDivModExpression(ctx *MulDivModExpression
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimParserVisitor) VisitFieldExpression(ctx *FieldExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitNegateExpression(ctx *NegateExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitAddSubExpression(ctx *AddSubExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitBitwiseAndExpression(ctx *BitwiseAndExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitInequalityExpression(ctx *InequalityExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitFunctionExpression(ctx *FunctionExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitAndExpression(ctx *AndExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitArrayExpression(ctx *ArrayExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitEqualityExpression(ctx *EqualityExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitCallExpression(ctx *CallExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitBitwiseOrExpression(ctx *BitwiseOrExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitTypeSpec(ctx *TypeSpecContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitParameter(ctx *ParameterContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitStructField(ctx *StructFieldContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitEnumMember(ctx *EnumMemberContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitUnionVariant(ctx *UnionVariantContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitMatchCase(ctx *MatchCaseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitMatchBinding(ctx *MatchBindingContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitSwitchCase(ctx *SwitchCaseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimParserVisitor) VisitMapEntry(ctx *MapEntryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimParserVisitor) VisitAssignment_op(ctx *Assignment_opContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -25,12 +25,36 @@ type SimParserListener interface {
 	// EnterLoopStatement is called when entering the LoopStatement production.
 	EnterLoopStatement(c *LoopStatementContext)
 
+	// EnterForEachStatement is called when entering the ForEachStatement production.
+	EnterForEachStatement(c *ForEachStatementContext)
+
 	// EnterFunctionStatement is called when entering the FunctionStatement production.
 	EnterFunctionStatement(c *FunctionStatementContext)
 
+	// EnterStructStatement is called when entering the StructStatement production.
+	EnterStructStatement(c *StructStatementContext)
+
+	// EnterEnumStatement is called when entering the EnumStatement production.
+	EnterEnumStatement(c *EnumStatementContext)
+
+	// EnterUnionStatement is called when entering the UnionStatement production.
+	EnterUnionStatement(c *UnionStatementContext)
+
+	// EnterMatchStatement is called when entering the MatchStatement production.
+	EnterMatchStatement(c *MatchStatementContext)
+
+	// EnterSwitchStatement is called when entering the SwitchStatement production.
+	EnterSwitchStatement(c *SwitchStatementContext)
+
 	// EnterDeclarationStatement is called when entering the DeclarationStatement production.
 	EnterDeclarationStatement(c *DeclarationStatementContext)
 
+	// EnterConstStatement is called when entering the ConstStatement production.
+	EnterConstStatement(c *ConstStatementContext)
+
+	// EnterInferredDeclarationStatement is called when entering the InferredDeclarationStatement production.
+	EnterInferredDeclarationStatement(c *InferredDeclarationStatementContext)
+
 	// EnterAssignmentStatement is called when entering the AssignmentStatement production.
 	EnterAssignmentStatement(c *AssignmentStatementContext)
 
@@ -46,29 +70,35 @@ type SimParserListener interface {
 	// EnterContinueStatement is called when entering the ContinueStatement production.
 	EnterContinueStatement(c *ContinueStatementContext)
 
-	// EnterNegateExpression is called when entering the NegateExpression production.
-	EnterNegateExpression(c *NegateExpressionContext)
-
-	// EnterAddSubExpression is called when entering the AddSubExpression production.
-	EnterAddSubExpression(c *AddSubExpressionContext)
+	// EnterMapExpression is called when entering the MapExpression production.
+	EnterMapExpression(c *MapExpressionContext)
 
-	// EnterInequalityExpression is called when entering the InequalityExpression production.
-	EnterInequalityExpression(c *InequalityExpressionContext)
+	// EnterInvokeExpression is called when entering the InvokeExpression production.
+	EnterInvokeExpression(c *InvokeExpressionContext)
 
-	// EnterAndExpression is called when entering the AndExpression production.
-	EnterAndExpression(c *AndExpressionContext)
+	// EnterBitwiseXorExpression is called when entering the BitwiseXorExpression production.
+	EnterBitwiseXorExpression(c *BitwiseXorExpressionContext)
 
 	// EnterLiteralExpression is called when entering the LiteralExpression production.
 	EnterLiteralExpression(c *LiteralExpressionContext)
 
+	// EnterSliceExpression is called when entering the SliceExpression production.
+	EnterSliceExpression(c *SliceExpressionContext)
+
+	// EnterBitwiseNotExpression is called when entering the BitwiseNotExpression production.
+	EnterBitwiseNotExpression(c *BitwiseNotExpressionContext)
+
+	// EnterIndexExpression is called when entering the IndexExpression production.
+	EnterIndexExpression(c *IndexExpressionContext)
+
 	// EnterNotExpression is called when entering the NotExpression production.
 	EnterNotExpression(c *NotExpressionContext)
 
 	// EnterVariableExpression is called when entering the VariableExpression production.
 	EnterVariableExpression(c *VariableExpressionContext)
 
-	// EnterEqualityExpression is called when entering the EqualityExpression production.
-	EnterEqualityExpression(c *EqualityExpressionContext)
+	// EnterShiftExpression is called when entering the ShiftExpression production.
+	EnterShiftExpression(c *ShiftExpressionContext)
 
 	// EnterOrExpression is called when entering the OrExpression production.
 	EnterOrExpression(c *OrExpressionContext)
@@ -79,6 +109,66 @@ type SimParserListener interface {
 	// EnterMulDivModExpression is called when entering the MulDivModExpression production.
 	EnterMulDivModExpression(c *MulDivModExpressionContext)
 
+	// EnterFieldExpression is called when entering the FieldExpression production.
+	EnterFieldExpression(c *FieldExpressionContext)
+
+	// EnterNegateExpression is called when entering the NegateExpression production.
+	EnterNegateExpression(c *NegateExpressionContext)
+
+	// EnterAddSubExpression is called when entering the AddSubExpression production.
+	EnterAddSubExpression(c *AddSubExpressionContext)
+
+	// EnterBitwiseAndExpression is called when entering the BitwiseAndExpression production.
+	EnterBitwiseAndExpression(c *BitwiseAndExpressionContext)
+
+	// EnterInequalityExpression is called when entering the InequalityExpression production.
+	EnterInequalityExpression(c *InequalityExpressionContext)
+
+	// EnterFunctionExpression is called when entering the FunctionExpression production.
+	EnterFunctionExpression(c *FunctionExpressionContext)
+
+	// EnterAndExpression is called when entering the AndExpression production.
+	EnterAndExpression(c *AndExpressionContext)
+
+	// EnterArrayExpression is called when entering the ArrayExpression production.
+	EnterArrayExpression(c *ArrayExpressionContext)
+
+	// EnterEqualityExpression is called when entering the EqualityExpression production.
+	EnterEqualityExpression(c *EqualityExpressionContext)
+
+	// EnterCallExpression is called when entering the CallExpression production.
+	EnterCallExpression(c *CallExpressionContext)
+
+	// EnterBitwiseOrExpression is called when entering the BitwiseOrExpression production.
+	EnterBitwiseOrExpression(c *BitwiseOrExpressionContext)
+
+	// EnterTypeSpec is called when entering the typeSpec production.
+	EnterTypeSpec(c *TypeSpecContext)
+
+	// EnterParameter is called when entering the parameter production.
+	EnterParameter(c *ParameterContext)
+
+	// EnterStructField is called when entering the structField production.
+	EnterStructField(c *StructFieldContext)
+
+	// EnterEnumMember is called when entering the enumMember production.
+	EnterEnumMember(c *EnumMemberContext)
+
+	// EnterUnionVariant is called when entering the unionVariant production.
+	EnterUnionVariant(c *UnionVariantContext)
+
+	// EnterMatchCase is called when entering the matchCase production.
+	EnterMatchCase(c *MatchCaseContext)
+
+	// EnterMatchBinding is called when entering the matchBinding production.
+	EnterMatchBinding(c *MatchBindingContext)
+
+	// EnterSwitchCase is called when entering the switchCase production.
+	EnterSwitchCase(c *SwitchCaseContext)
+
+	// EnterMapEntry is called when entering the mapEntry production.
+	EnterMapEntry(c *MapEntryContext)
+
 	// EnterAssignment_op is called when entering the assignment_op production.
 	EnterAssignment_op(c *Assignment_opContext)
 
@@ -103,12 +193,36 @@ type SimParserListener interface {
 	// ExitLoopStatement is called when exiting the LoopStatement production.
 	ExitLoopStatement(c *LoopStatementContext)
 
+	// ExitForEachStatement is called when exiting the ForEachStatement production.
+	ExitForEachStatement(c *ForEachStatementContext)
+
 	// ExitFunctionStatement is called when exiting the FunctionStatement production.
 	ExitFunctionStatement(c *FunctionStatementContext)
 
+	// ExitStructStatement is called when exiting the StructStatement production.
+	ExitStructStatement(c *StructStatementContext)
+
+	// ExitEnumStatement is called when exiting the EnumStatement production.
+	ExitEnumStatement(c *EnumStatementContext)
+
+	// ExitUnionStatement is called when exiting the UnionStatement production.
+	ExitUnionStatement(c *UnionStatementContext)
+
+	// ExitMatchStatement is called when exiting the MatchStatement production.
+	ExitMatchStatement(c *MatchStatementContext)
+
+	// ExitSwitchStatement is called when exiting the SwitchStatement production.
+	ExitSwitchStatement(c *SwitchStatementContext)
+
 	// ExitDeclarationStatement is called when exiting the DeclarationStatement production.
 	ExitDeclarationStatement(c *DeclarationStatementContext)
 
+	// ExitConstStatement is called when exiting the ConstStatement production.
+	ExitConstStatement(c *ConstStatementContext)
+
+	// ExitInferredDeclarationStatement is called when exiting the InferredDeclarationStatement production.
+	ExitInferredDeclarationStatement(c *InferredDeclarationStatementContext)
+
 	// ExitAssignmentStatement is called when exiting the AssignmentStatement production.
 	ExitAssignmentStatement(c *AssignmentStatementContext)
 
@@ -124,29 +238,35 @@ type SimParserListener interface {
 	// ExitContinueStatement is called when exiting the ContinueStatement production.
 	ExitContinueStatement(c *ContinueStatementContext)
 
-	// ExitNegateExpression is called when exiting the NegateExpression production.
-	ExitNegateExpression(c *NegateExpressionContext)
-
-	// ExitAddSubExpression is called when exiting the AddSubExpression production.
-	ExitAddSubExpression(c *AddSubExpressionContext)
+	// ExitMapExpression is called when exiting the MapExpression production.
+	ExitMapExpression(c *MapExpressionContext)
 
-	// ExitInequalityExpression is called when exiting the InequalityExpression production.
-	ExitInequalityExpression(c *InequalityExpressionContext)
+	// ExitInvokeExpression is called when exiting the InvokeExpression production.
+	ExitInvokeExpression(c *InvokeExpressionContext)
 
-	// ExitAndExpression is called when exiting the AndExpression production.
-	ExitAndExpression(c *AndExpressionContext)
+	// ExitBitwiseXorExpression is called when exiting the BitwiseXorExpression production.
+	ExitBitwiseXorExpression(c *BitwiseXorExpressionContext)
 
 	// ExitLiteralExpression is called when exiting the LiteralExpression production.
 	ExitLiteralExpression(c *LiteralExpressionContext)
 
+	// ExitSliceExpression is called when exiting the SliceExpression production.
+	ExitSliceExpression(c *SliceExpressionContext)
+
+	// ExitBitwiseNotExpression is called when exiting the BitwiseNotExpression production.
+	ExitBitwiseNotExpression(c *BitwiseNotExpressionContext)
+
+	// ExitIndexExpression is called when exiting the IndexExpression production.
+	ExitIndexExpression(c *IndexExpressionContext)
+
 	// ExitNotExpression is called when exiting the NotExpression production.
 	ExitNotExpression(c *NotExpressionContext)
 
 	// ExitVariableExpression is called when exiting the VariableExpression production.
 	ExitVariableExpression(c *VariableExpressionContext)
 
-	// ExitEqualityExpression is called when exiting the EqualityExpression production.
-	ExitEqualityExpression(c *EqualityExpressionContext)
+	// ExitShiftExpression is called when exiting the ShiftExpression production.
+	ExitShiftExpression(c *ShiftExpressionContext)
 
 	// ExitOrExpression is called when exiting the OrExpression production.
 	ExitOrExpression(c *OrExpressionContext)
@@ -157,6 +277,66 @@ type SimParserListener interface {
 	// ExitMulDivModExpression is called when exiting the MulDivModExpression production.
 	ExitMulDivModExpression(c *MulDivModExpressionContext)
 
+	// ExitFieldExpression is called when exiting the FieldExpression production.
+	ExitFieldExpression(c *FieldExpressionContext)
+
+	// ExitNegateExpression is called when exiting the NegateExpression production.
+	ExitNegateExpression(c *NegateExpressionContext)
+
+	// ExitAddSubExpression is called when exiting the AddSubExpression production.
+	ExitAddSubExpression(c *AddSubExpressionContext)
+
+	// ExitBitwiseAndExpression is called when exiting the BitwiseAndExpression production.
+	ExitBitwiseAndExpression(c *BitwiseAndExpressionContext)
+
+	// ExitInequalityExpression is called when exiting the InequalityExpression production.
+	ExitInequalityExpression(c *InequalityExpressionContext)
+
+	// ExitFunctionExpression is called when exiting the FunctionExpression production.
+	ExitFunctionExpression(c *FunctionExpressionContext)
+
+	// ExitAndExpression is called when exiting the AndExpression production.
+	ExitAndExpression(c *AndExpressionContext)
+
+	// ExitArrayExpression is called when exiting the ArrayExpression production.
+	ExitArrayExpression(c *ArrayExpressionContext)
+
+	// ExitEqualityExpression is called when exiting the EqualityExpression production.
+	ExitEqualityExpression(c *EqualityExpressionContext)
+
+	// ExitCallExpression is called when exiting the CallExpression production.
+	ExitCallExpression(c *CallExpressionContext)
+
+	// ExitBitwiseOrExpression is called when exiting the BitwiseOrExpression production.
+	ExitBitwiseOrExpression(c *BitwiseOrExpressionContext)
+
+	// ExitTypeSpec is called when exiting the typeSpec production.
+	ExitTypeSpec(c *TypeSpecContext)
+
+	// ExitParameter is called when exiting the parameter production.
+	ExitParameter(c *ParameterContext)
+
+	// ExitStructField is called when exiting the structField production.
+	ExitStructField(c *StructFieldContext)
+
+	// ExitEnumMember is called when exiting the enumMember production.
+	ExitEnumMember(c *EnumMemberContext)
+
+	// ExitUnionVariant is called when exiting the unionVariant production.
+	ExitUnionVariant(c *UnionVariantContext)
+
+	// ExitMatchCase is called when exiting the matchCase production.
+	ExitMatchCase(c *MatchCaseContext)
+
+	// ExitMatchBinding is called when exiting the matchBinding production.
+	ExitMatchBinding(c *MatchBindingContext)
+
+	// ExitSwitchCase is called when exiting the switchCase production.
+	ExitSwitchCase(c *SwitchCaseContext)
+
+	// ExitMapEntry is called when exiting the mapEntry production.
+	ExitMapEntry(c *MapEntryContext)
+
 	// ExitAssignment_op is called when exiting the assignment_op production.
 	ExitAssignment_op(c *Assignment_opContext)
 
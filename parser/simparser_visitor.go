@@ -25,12 +25,36 @@ type SimParserVisitor interface {
 	// Visit a parse tree produced by SimParser#LoopStatement.
 	VisitLoopStatement(ctx *LoopStatementContext) interface{}
 
+	// Visit a parse tree produced by SimParser#ForEachStatement.
+	VisitForEachStatement(ctx *ForEachStatementContext) interface{}
+
 	// Visit a parse tree produced by SimParser#FunctionStatement.
 	VisitFunctionStatement(ctx *FunctionStatementContext) interface{}
 
+	// Visit a parse tree produced by SimParser#StructStatement.
+	VisitStructStatement(ctx *StructStatementContext) interface{}
+
+	// Visit a parse tree produced by SimParser#EnumStatement.
+	VisitEnumStatement(ctx *EnumStatementContext) interface{}
+
+	// Visit a parse tree produced by SimParser#UnionStatement.
+	VisitUnionStatement(ctx *UnionStatementContext) interface{}
+
+	// Visit a parse tree produced by SimParser#MatchStatement.
+	VisitMatchStatement(ctx *MatchStatementContext) interface{}
+
+	// Visit a parse tree produced by SimParser#SwitchStatement.
+	VisitSwitchStatement(ctx *SwitchStatementContext) interface{}
+
 	// Visit a parse tree produced by SimParser#DeclarationStatement.
 	VisitDeclarationStatement(ctx *DeclarationStatementContext) interface{}
 
+	// Visit a parse tree produced by SimParser#ConstStatement.
+	VisitConstStatement(ctx *ConstStatementContext) interface{}
+
+	// Visit a parse tree produced by SimParser#InferredDeclarationStatement.
+	VisitInferredDeclarationStatement(ctx *InferredDeclarationStatementContext) interface{}
+
 	// Visit a parse tree produced by SimParser#AssignmentStatement.
 	VisitAssignmentStatement(ctx *AssignmentStatementContext) interface{}
 
@@ -46,29 +70,35 @@ type SimParserVisitor interface {
 	// Visit a parse tree produced by SimParser#ContinueStatement.
 	VisitContinueStatement(ctx *ContinueStatementContext) interface{}
 
-	// Visit a parse tree produced by SimParser#NegateExpression.
-	VisitNegateExpression(ctx *NegateExpressionContext) interface{}
-
-	// Visit a parse tree produced by SimParser#AddSubExpression.
-	VisitAddSubExpression(ctx *AddSubExpressionContext) interface{}
+	// Visit a parse tree produced by SimParser#MapExpression.
+	VisitMapExpression(ctx *MapExpressionContext) interface{}
 
-	// Visit a parse tree produced by SimParser#InequalityExpression.
-	VisitInequalityExpression(ctx *InequalityExpressionContext) interface{}
+	// Visit a parse tree produced by SimParser#InvokeExpression.
+	VisitInvokeExpression(ctx *InvokeExpressionContext) interface{}
 
-	// Visit a parse tree produced by SimParser#AndExpression.
-	VisitAndExpression(ctx *AndExpressionContext) interface{}
+	// Visit a parse tree produced by SimParser#BitwiseXorExpression.
+	VisitBitwiseXorExpression(ctx *BitwiseXorExpressionContext) interface{}
 
 	// Visit a parse tree produced by SimParser#LiteralExpression.
 	VisitLiteralExpression(ctx *LiteralExpressionContext) interface{}
 
+	// Visit a parse tree produced by SimParser#SliceExpression.
+	VisitSliceExpression(ctx *SliceExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#BitwiseNotExpression.
+	VisitBitwiseNotExpression(ctx *BitwiseNotExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#IndexExpression.
+	VisitIndexExpression(ctx *IndexExpressionContext) interface{}
+
 	// Visit a parse tree produced by SimParser#NotExpression.
 	VisitNotExpression(ctx *NotExpressionContext) interface{}
 
 	// Visit a parse tree produced by SimParser#VariableExpression.
 	VisitVariableExpression(ctx *VariableExpressionContext) interface{}
 
-	// Visit a parse tree produced by SimParser#EqualityExpression.
-	VisitEqualityExpression(ctx *EqualityExpressionContext) interface{}
+	// Visit a parse tree produced by SimParser#ShiftExpression.
+	VisitShiftExpression(ctx *ShiftExpressionContext) interface{}
 
 	// Visit a parse tree produced by SimParser#OrExpression.
 	VisitOrExpression(ctx *OrExpressionContext) interface{}
@@ -79,6 +109,66 @@ type SimParserVisitor interface {
 	// Visit a parse tree produced by SimParser#MulDivModExpression.
 	VisitMulDivModExpression(ctx *MulDivModExpressionContext) interface{}
 
+	// Visit a parse tree produced by SimParser#FieldExpression.
+	VisitFieldExpression(ctx *FieldExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#NegateExpression.
+	VisitNegateExpression(ctx *NegateExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#AddSubExpression.
+	VisitAddSubExpression(ctx *AddSubExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#BitwiseAndExpression.
+	VisitBitwiseAndExpression(ctx *BitwiseAndExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#InequalityExpression.
+	VisitInequalityExpression(ctx *InequalityExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#FunctionExpression.
+	VisitFunctionExpression(ctx *FunctionExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#AndExpression.
+	VisitAndExpression(ctx *AndExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#ArrayExpression.
+	VisitArrayExpression(ctx *ArrayExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#EqualityExpression.
+	VisitEqualityExpression(ctx *EqualityExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#CallExpression.
+	VisitCallExpression(ctx *CallExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#BitwiseOrExpression.
+	VisitBitwiseOrExpression(ctx *BitwiseOrExpressionContext) interface{}
+
+	// Visit a parse tree produced by SimParser#typeSpec.
+	VisitTypeSpec(ctx *TypeSpecContext) interface{}
+
+	// Visit a parse tree produced by SimParser#parameter.
+	VisitParameter(ctx *ParameterContext) interface{}
+
+	// Visit a parse tree produced by SimParser#structField.
+	VisitStructField(ctx *StructFieldContext) interface{}
+
+	// Visit a parse tree produced by SimParser#enumMember.
+	VisitEnumMember(ctx *EnumMemberContext) interface{}
+
+	// Visit a parse tree produced by SimParser#unionVariant.
+	VisitUnionVariant(ctx *UnionVariantContext) interface{}
+
+	// Visit a parse tree produced by SimParser#matchCase.
+	VisitMatchCase(ctx *MatchCaseContext) interface{}
+
+	// Visit a parse tree produced by SimParser#matchBinding.
+	VisitMatchBinding(ctx *MatchBindingContext) interface{}
+
+	// Visit a parse tree produced by SimParser#switchCase.
+	VisitSwitchCase(ctx *SwitchCaseContext) interface{}
+
+	// Visit a parse tree produced by SimParser#mapEntry.
+	VisitMapEntry(ctx *MapEntryContext) interface{}
+
 	// Visit a parse tree produced by SimParser#assignment_op.
 	VisitAssignment_op(ctx *Assignment_opContext) interface{}
 
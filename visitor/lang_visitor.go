@@ -2,6 +2,8 @@ package visitor
 
 import (
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/rpj5582/sim/interpreter"
@@ -14,6 +16,9 @@ type SimVisitor struct {
 	interpreter         *interpreter.SimInterpreter
 	statementEvaluator  *StatementEvaluator
 	expressionEvaluator *ExpressionEvaluator
+
+	// jumpLabel is the label of the loop that a labeled break or continue is on its way out to
+	jumpLabel string
 }
 
 func NewSimVisitor(interpreter *interpreter.SimInterpreter) *SimVisitor {
@@ -34,23 +39,34 @@ func (v *SimVisitor) Visit(tree antlr.ParseTree) interface{} {
 }
 
 func (v *SimVisitor) VisitStart(ctx *parser.StartContext) interface{} {
-	statements := ctx.AllStatement()
+	// Labels don't depend on anything that happens at runtime, so they are all checked before the program runs
+	if err := v.checkLabels(ctx, nil); err != nil {
+		return err
+	}
 
-	var controlFlow ControlFlow
-	var err error
+	statements := ctx.AllStatement()
 
 	for _, statement := range statements {
-		controlFlow, err = v.statementEvaluator.Evaluate(v, statement)
+		controlFlow, value, err := v.statementEvaluator.Evaluate(v, statement)
 		if err != nil {
 			return err
 		}
 
+		// Returning from the top level ends the program with the returned value, if there is one
+		if controlFlow == ControlFlowReturn {
+			if value.IsEmpty() {
+				return nil
+			}
+
+			return value
+		}
+
 		if controlFlow != ControlFlowNormal {
 			break
 		}
 	}
 
-	return controlFlow
+	return nil
 }
 
 func (v *SimVisitor) VisitBlockStatement(ctx *parser.BlockStatementContext) (result interface{}) {
@@ -66,10 +82,11 @@ func (v *SimVisitor) VisitBlockStatement(ctx *parser.BlockStatementContext) (res
 	}()
 
 	var controlFlow ControlFlow
+	var value interpreter.Value
 	var err error
 
 	for _, statement := range statements {
-		controlFlow, err = v.statementEvaluator.Evaluate(v, statement)
+		controlFlow, value, err = v.statementEvaluator.Evaluate(v, statement)
 		if err != nil {
 			return err
 		}
@@ -79,7 +96,7 @@ func (v *SimVisitor) VisitBlockStatement(ctx *parser.BlockStatementContext) (res
 		}
 	}
 
-	return controlFlow
+	return newStatementResult(controlFlow, value)
 }
 
 func (v *SimVisitor) VisitIfStatement(ctx *parser.IfStatementContext) interface{} {
@@ -91,49 +108,71 @@ func (v *SimVisitor) VisitIfStatement(ctx *parser.IfStatementContext) interface{
 		return err
 	}
 
+	// An else if is an else branch holding another if statement, so only one branch of a chain ever runs
+	branch := ctx.GetBody()
+	if !condition {
+		branch = ctx.GetElseBody()
+	}
+
 	var controlFlow ControlFlow
-	if condition {
-		controlFlow, err = v.statementEvaluator.Evaluate(v, ctx.Statement())
+	var value interpreter.Value
+	if branch != nil {
+		controlFlow, value, err = v.statementEvaluator.Evaluate(v, branch)
 		if err != nil {
 			return err
 		}
 	}
 
-	return controlFlow
+	return newStatementResult(controlFlow, value)
 }
 
 func (v *SimVisitor) VisitInfiniteLoopStatement(ctx *parser.InfiniteLoopStatementContext) interface{} {
 	var controlFlow ControlFlow
+	var value interpreter.Value
 	var err error
 
 	for {
-		controlFlow, err = v.statementEvaluator.Evaluate(v, ctx.Statement())
+		controlFlow, value, err = v.statementEvaluator.Evaluate(v, ctx.Statement())
 
 		if err != nil {
 			return err
 		}
 
-		if controlFlow == ControlFlowBreak || controlFlow == ControlFlowReturn {
+		if v.leavesLoop(ctx.GetLabel(), controlFlow) {
+			return newStatementResult(controlFlow, value)
+		}
+
+		if controlFlow == ControlFlowBreak {
 			break
 		}
 	}
 
-	return controlFlow
+	return nil
 }
 
 func (v *SimVisitor) VisitConditionalLoopStatement(ctx *parser.ConditionalLoopStatementContext) interface{} {
 	expression := ctx.Expression()
 	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
 
-	var iterations uint32
+	var iterations uint64
 	var condition bool
 	var controlFlow ControlFlow
-	var err error
+	var value interpreter.Value
 
 	for {
 		result := v.expressionEvaluator.Evaluate(parseContext, v, expression)
-		condition, err = result.GetBool(parseContext)
+		typeName, err := result.GetType()
 		if err != nil {
+			return err
+		}
+
+		// Decide by the type rather than the data, since a count of 0 or 1 would also read as a bool
+		if typeName == "bool" {
+			condition, err = result.GetBool(parseContext)
+			if err != nil {
+				return err
+			}
+		} else {
 			maxIterations, err := result.GetUint(parseContext)
 			if err != nil {
 				return err
@@ -146,50 +185,99 @@ func (v *SimVisitor) VisitConditionalLoopStatement(ctx *parser.ConditionalLoopSt
 			break
 		}
 
-		controlFlow, err = v.statementEvaluator.Evaluate(v, ctx.Statement())
+		controlFlow, value, err = v.statementEvaluator.Evaluate(v, ctx.Statement())
 		if err != nil {
 			return err
 		}
 
-		if controlFlow == ControlFlowBreak || controlFlow == ControlFlowReturn {
+		if v.leavesLoop(ctx.GetLabel(), controlFlow) {
+			return newStatementResult(controlFlow, value)
+		}
+
+		if controlFlow == ControlFlowBreak {
 			break
 		}
 
 		iterations++
 	}
 
-	return controlFlow
+	return nil
 }
 
 func (v *SimVisitor) VisitLoopStatement(ctx *parser.LoopStatementContext) (result interface{}) {
-	identifier := ctx.IDENTIFIER()
+	identifier := ctx.GetVarName()
 	minExpression := ctx.GetMin()
 	maxExpression := ctx.GetMax()
 
-	identifierContext := interpreter.NewParseContext(identifier.GetSymbol().GetLine(), identifier.GetSymbol().GetColumn())
+	identifierContext := interpreter.NewParseContext(identifier.GetLine(), identifier.GetColumn())
 	minParseContext := interpreter.NewParseContext(minExpression.GetStart().GetLine(), minExpression.GetStart().GetColumn())
 	maxParseContext := interpreter.NewParseContext(maxExpression.GetStart().GetLine(), maxExpression.GetStart().GetColumn())
 
 	varName := identifier.GetText()
 
-	// If the variable name is present, min and max should also be present
 	minVal := v.expressionEvaluator.Evaluate(minParseContext, v, minExpression)
+	maxVal := v.expressionEvaluator.Evaluate(maxParseContext, v, maxExpression)
+
+	typeName, err := v.rangeTypeName(minParseContext, varName, minVal, maxVal)
+	if err != nil {
+		return err
+	}
+
+	typeData, err := v.interpreter.GetTypeData(identifierContext, typeName)
+	if err != nil {
+		return err
+	}
+
+	if !typeData.IsSignedInteger() && !typeData.IsUnsignedInteger() && !typeData.IsFloatingPoint() {
+		return interpreter.InvalidOperationErr{Context: identifierContext, TypeNames: []string{typeName}}
+	}
 
-	minTypeName, err := minVal.GetType()
+	min, err := v.rangeBound(minParseContext, varName, typeName, minVal)
 	if err != nil {
 		return err
 	}
 
-	min, err := minVal.GetUint(minParseContext)
+	max, err := v.rangeBound(maxParseContext, varName, typeName, maxVal)
 	if err != nil {
 		return err
 	}
 
-	max, err := v.expressionEvaluator.Evaluate(maxParseContext, v, maxExpression).GetUint(maxParseContext)
+	// The step is how far the iterator moves each iteration, in whichever direction the range goes
+	step := interpreter.NewValue(typeName, "1")
+	if stepExpression := ctx.GetStep(); stepExpression != nil {
+		stepParseContext := interpreter.NewParseContext(stepExpression.GetStart().GetLine(), stepExpression.GetStart().GetColumn())
+		stepParseContext.TypeData = typeData
+
+		step, err = v.rangeBound(stepParseContext, varName, typeName, v.expressionEvaluator.Evaluate(stepParseContext, v, stepExpression))
+		if err != nil {
+			return err
+		}
+
+		positive, err := v.compare(stepParseContext, stepParseContext, step, interpreter.NewValue(typeName, "0"), ">")
+		if err != nil {
+			return err
+		}
+
+		if !positive {
+			return interpreter.InvalidStepErr{Context: stepParseContext, Step: step.String()}
+		}
+	}
+
+	descending, err := v.compare(minParseContext, maxParseContext, min, max, ">")
 	if err != nil {
 		return err
 	}
 
+	// The iterator stays in the range while it hasn't reached the end, or while it hasn't passed it for inclusive ranges
+	inRange, stepOperator := "<", "+"
+	if descending {
+		inRange, stepOperator = ">", "-"
+	}
+
+	if ctx.GetInclusive() != nil {
+		inRange += "="
+	}
+
 	v.interpreter.PushScope()
 	defer func() {
 		if err := v.interpreter.PopScope(identifierContext); err != nil {
@@ -197,266 +285,1458 @@ func (v *SimVisitor) VisitLoopStatement(ctx *parser.LoopStatementContext) (resul
 		}
 	}()
 
-	// Loop iterators are always unsigned integers
-	if err := v.interpreter.AddVar(minParseContext, interpreter.NewVariable(varName, interpreter.NewValue("uint", fmt.Sprintf("%d", min)))); err != nil {
+	if err := v.interpreter.AddVar(minParseContext, interpreter.NewVariable(varName, min)); err != nil {
 		return err
 	}
 
-	for i := min; i < max; i++ {
-		controlFlow, err := v.statementEvaluator.Evaluate(v, ctx.Statement())
+	// The index counts the iterations from 0, no matter what the range is
+	var indexName string
+	var indexParseContext interpreter.ParseContext
+	if index := ctx.GetIndexName(); index != nil {
+		indexName = index.GetText()
+		indexParseContext = interpreter.NewParseContext(index.GetLine(), index.GetColumn())
+
+		if err := v.interpreter.AddVar(indexParseContext, interpreter.NewVariable(indexName, interpreter.NewValue("int", "0"))); err != nil {
+			return err
+		}
+	}
+
+	for index := 1; ; index++ {
+		variable, err := v.interpreter.GetVar(identifierContext, varName)
+		if err != nil {
+			return err
+		}
+
+		ok, err := v.compare(identifierContext, maxParseContext, variable.Value(), max, inRange)
 		if err != nil {
 			return err
 		}
 
-		if controlFlow == ControlFlowBreak || controlFlow == ControlFlowReturn {
+		if !ok {
 			break
 		}
 
-		variable, err := v.interpreter.GetVar(identifierContext, varName)
+		controlFlow, value, err := v.statementEvaluator.Evaluate(v, ctx.Statement())
 		if err != nil {
 			return err
 		}
 
-		result, err := v.interpreter.ResolveBinaryOperations(identifierContext, minParseContext, variable.Value(), interpreter.NewValue(minTypeName, "1"), "+")
+		if v.leavesLoop(ctx.GetLabel(), controlFlow) {
+			return newStatementResult(controlFlow, value)
+		}
+
+		if controlFlow == ControlFlowBreak {
+			break
+		}
+
+		// The body can change the iterator, so the next value is worked out from its current value
+		variable, err = v.interpreter.GetVar(identifierContext, varName)
+		if err != nil {
+			return err
+		}
+
+		next, ok, err := v.nextInRange(identifierContext, variable.Value(), max, step, inRange, stepOperator)
 		if err != nil {
 			return err
 		}
 
-		if err := v.interpreter.SetVarValue(identifierContext, varName, result); err != nil {
+		if !ok {
+			break
+		}
+
+		if err := v.interpreter.SetVarValue(identifierContext, varName, next); err != nil {
 			return err
 		}
+
+		if indexName != "" {
+			if err := v.interpreter.SetVarValue(indexParseContext, indexName, interpreter.NewValue("int", fmt.Sprintf("%d", index))); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
-func (v *SimVisitor) VisitDeclarationStatement(ctx *parser.DeclarationStatementContext) interface{} {
-	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
-	expression := ctx.Expression()
-	var expressionParseContext interpreter.ParseContext
-	if expression != nil {
-		expressionParseContext = interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
-	}
+func (v *SimVisitor) VisitForEachStatement(ctx *parser.ForEachStatementContext) interface{} {
+	expression := ctx.GetValue()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
 
-	typeName := ctx.GetType_().GetText()
-	typeData, err := v.interpreter.GetTypeData(parseContext, typeName)
+	varName := ctx.GetVarName().GetText()
+
+	// The collection is only evaluated once, so changing it in the body doesn't change what the loop goes over
+	value, err := v.interpreter.InferValue(parseContext, varName, v.expressionEvaluator.Evaluate(parseContext, v, expression))
 	if err != nil {
 		return err
 	}
 
-	parseContext.TypeData = typeData
-	expressionParseContext.TypeData = typeData
-
-	varName := ctx.GetVarName().GetText()
-
-	value := interpreter.NewValue(typeName, "")
-
-	if ctx.ASSIGNMENT() != nil {
-		value = v.expressionEvaluator.Evaluate(expressionParseContext, v, expression)
+	typeData, err := v.valueTypeData(parseContext, value)
+	if err != nil {
+		return err
 	}
 
-	variable := interpreter.NewVariable(varName, value)
+	// Arrays, lists and strings are indexed from 0, and strings are gone over one rune at a time
+	var keys, elements []interpreter.Value
 
-	if err := v.interpreter.AddVar(parseContext, variable); err != nil {
-		return err
-	}
+	switch {
+	case typeData.IsArray() || typeData.IsList():
+		elements, err = value.GetElements()
 
-	return nil
-}
+	case typeData.IsMap():
+		keys, elements, err = value.GetEntries()
 
-func (v *SimVisitor) VisitAssignmentStatement(ctx *parser.AssignmentStatementContext) interface{} {
-	expression := ctx.Expression()
-	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
-	expressionParseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+	case typeData.IsString():
+		elements, err = v.runes(parseContext, value)
 
-	varName := ctx.IDENTIFIER().GetText()
+	default:
+		typeName, _ := value.GetType()
+		return interpreter.InvalidOperationErr{Context: parseContext, TypeNames: []string{typeName}}
+	}
 
-	variable, err := v.interpreter.GetVar(parseContext, varName)
 	if err != nil {
 		return err
 	}
 
-	result := v.expressionEvaluator.Evaluate(expressionParseContext, v, expression)
+	if keys == nil {
+		keys = make([]interpreter.Value, len(elements))
+		for i := range keys {
+			keys[i] = interpreter.NewValue("int", fmt.Sprintf("%d", i))
+		}
+	}
 
-	token := ctx.Assignment_op().GetStart()
+	// With a single name, maps bind their keys, and everything else binds its elements
+	if typeData.IsMap() && ctx.GetIndexName() == nil {
+		elements = keys
+	}
 
-	if token.GetTokenType() != parser.SimParserASSIGNMENT {
-		result, err = v.interpreter.ResolveBinaryOperations(parseContext, expressionParseContext, variable.Value(), result, token.GetText()[:1])
+	for i := range elements {
+		controlFlow, value, err := v.forEachIteration(ctx, keys[i], elements[i])
 		if err != nil {
 			return err
 		}
-	}
 
-	if err := v.interpreter.SetVarValue(parseContext, varName, result); err != nil {
-		return err
+		if v.leavesLoop(ctx.GetLabel(), controlFlow) {
+			return newStatementResult(controlFlow, value)
+		}
+
+		if controlFlow == ControlFlowBreak {
+			break
+		}
 	}
 
 	return nil
 }
 
-func (v *SimVisitor) VisitPrintStatement(ctx *parser.PrintStatementContext) interface{} {
-	expression := ctx.Expression()
-	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
-
-	result, err := v.expressionEvaluator.Evaluate(parseContext, v, expression).GetRawData()
+// runes returns each rune of a string value as a string value of its own.
+func (v *SimVisitor) runes(context interpreter.ParseContext, value interpreter.Value) ([]interpreter.Value, error) {
+	s, err := value.GetString(context)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	v.interpreter.PrintLine(result)
-	return nil
-}
-
-func (v *SimVisitor) VisitReturnStatement(ctx *parser.ReturnStatementContext) interface{} {
-	return ControlFlowReturn
-}
-
-func (v *SimVisitor) VisitBreakStatement(ctx *parser.BreakStatementContext) interface{} {
-	return ControlFlowBreak
-}
+	runes := make([]interpreter.Value, utf8.RuneCountInString(s))
+	for i := range runes {
+		runes[i], err = interpreter.IndexString(context, value, int64(i))
+		if err != nil {
+			return nil, err
+		}
+	}
 
-func (v *SimVisitor) VisitContinueStatement(ctx *parser.ContinueStatementContext) interface{} {
-	return ControlFlowContinue
+	return runes, nil
 }
 
-func (v *SimVisitor) VisitParensExpression(ctx *parser.ParensExpressionContext) interface{} {
-	expression := ctx.Expression()
-	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
-	return v.expressionEvaluator.Evaluate(parseContext, v, expression)
-}
+// forEachIteration runs the body of a for-each loop once, with its variables declared in a scope of their own.
+// Each iteration gets fresh variables, so closures made in the body hold on to the values of their own iteration.
+func (v *SimVisitor) forEachIteration(ctx *parser.ForEachStatementContext, key interpreter.Value, element interpreter.Value) (controlFlow ControlFlow, value interpreter.Value, err error) {
+	identifier := ctx.GetVarName()
+	identifierContext := interpreter.NewParseContext(identifier.GetLine(), identifier.GetColumn())
 
-func (v *SimVisitor) VisitNegateExpression(ctx *parser.NegateExpressionContext) interface{} {
-	expression := ctx.Expression()
-	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+	v.interpreter.PushScope()
+	defer func() {
+		if popErr := v.interpreter.PopScope(identifierContext); popErr != nil && err == nil {
+			err = popErr
+		}
+	}()
 
-	val := v.expressionEvaluator.Evaluate(parseContext, v, expression)
+	if index := ctx.GetIndexName(); index != nil {
+		if err := v.interpreter.AddVar(interpreter.NewParseContext(index.GetLine(), index.GetColumn()), interpreter.NewVariable(index.GetText(), key)); err != nil {
+			return ControlFlowNormal, interpreter.Value{}, err
+		}
+	}
 
-	result, err := v.interpreter.ResolveUnaryOperations(parseContext, val, ctx.SUBTRACT().GetText())
-	if err != nil {
-		return err
+	if err := v.interpreter.AddVar(identifierContext, interpreter.NewVariable(identifier.GetText(), element)); err != nil {
+		return ControlFlowNormal, interpreter.Value{}, err
 	}
 
-	return result
+	return v.statementEvaluator.Evaluate(v, ctx.Statement())
 }
 
-func (v *SimVisitor) VisitNotExpression(ctx *parser.NotExpressionContext) interface{} {
-	expression := ctx.Expression()
-	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
-
-	result, err := v.expressionEvaluator.Evaluate(parseContext, v, expression).GetBool(parseContext)
+// rangeTypeName returns the type of a range loop's iterator, which is the type of its bounds.
+// An untyped bound takes on the type of the other bound, and if both are untyped, the iterator has the type that they default to.
+func (v *SimVisitor) rangeTypeName(context interpreter.ParseContext, varName string, min interpreter.Value, max interpreter.Value) (string, error) {
+	minTypeName, err := min.GetType()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return fmt.Sprintf("%t", !result)
-}
+	maxTypeName, err := max.GetType()
+	if err != nil {
+		return "", err
+	}
 
-func (v *SimVisitor) VisitMulDivModExpression(ctx *parser.MulDivModExpressionContext) interface{} {
-	leftExpression := ctx.GetLeft()
-	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+	if !strings.HasPrefix(minTypeName, "untyped ") {
+		return minTypeName, nil
+	}
 
-	rightExpression := ctx.GetRight()
-	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+	if !strings.HasPrefix(maxTypeName, "untyped ") {
+		return maxTypeName, nil
+	}
 
-	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
-	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+	// An untyped float bound makes the iterator a float, even if the other bound is a whole number
+	bound := min
+	if maxTypeName == "untyped float" {
+		bound = max
+	}
 
-	result, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, ctx.GetOp().GetText())
+	value, err := v.interpreter.InferValue(context, varName, bound)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return result
+	return value.GetType()
 }
 
-func (v *SimVisitor) VisitAddSubExpression(ctx *parser.AddSubExpressionContext) interface{} {
-	leftExpression := ctx.GetLeft()
-	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+// rangeBound returns a bound or step of a range loop as the type of the loop's iterator.
+func (v *SimVisitor) rangeBound(context interpreter.ParseContext, varName string, typeName string, value interpreter.Value) (interpreter.Value, error) {
+	data, err := v.interpreter.FormatValue(context, value)
+	if err != nil {
+		return interpreter.NewErrorValue(err), err
+	}
 
-	rightExpression := ctx.GetRight()
-	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+	result, ok := v.interpreter.ImplicitlyCast(context, value, typeName)
+	if !ok {
+		err := interpreter.MismatchedTypeAssignErr{Context: context, Var: interpreter.NewVariable(varName, interpreter.NewValue(typeName, data))}
+		return interpreter.NewErrorValue(err), err
+	}
 
-	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
-	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+	return result, nil
+}
 
-	result, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, ctx.GetOp().GetText())
-	if err != nil {
-		return err
+// nextInRange returns the value a step away from the iterator's current value, or false if that would take it out of the range.
+// The distance left to the end of the range is checked before stepping, so that stepping never overflows the iterator's type.
+func (v *SimVisitor) nextInRange(context interpreter.ParseContext, current interpreter.Value, max interpreter.Value, step interpreter.Value, inRange string, stepOperator string) (interpreter.Value, bool, error) {
+	ok, err := v.compare(context, context, current, max, inRange)
+	if err != nil || !ok {
+		return current, false, err
 	}
 
-	return result
-}
+	remaining, err := v.interpreter.ResolveBinaryOperations(context, context, max, current, "-")
+	if stepOperator == "-" {
+		remaining, err = v.interpreter.ResolveBinaryOperations(context, context, current, max, "-")
+	}
 
-func (v *SimVisitor) VisitInequalityExpression(ctx *parser.InequalityExpressionContext) interface{} {
-	leftExpression := ctx.GetLeft()
-	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+	if err != nil {
+		return current, false, err
+	}
 
-	rightExpression := ctx.GetRight()
-	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+	// An exclusive range has to have room for the step without reaching the end
+	fits := ">="
+	if !strings.HasSuffix(inRange, "=") {
+		fits = ">"
+	}
 
-	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
-	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+	ok, err = v.compare(context, context, remaining, step, fits)
+	if err != nil || !ok {
+		return current, false, err
+	}
 
-	compare, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, ctx.GetOp().GetText())
+	next, err := v.interpreter.ResolveBinaryOperations(context, context, current, step, stepOperator)
 	if err != nil {
-		return err
+		return current, false, err
 	}
 
-	return compare
+	return next, true, nil
 }
 
-func (v *SimVisitor) VisitEqualityExpression(ctx *parser.EqualityExpressionContext) interface{} {
-	leftExpression := ctx.GetLeft()
-	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+func (v *SimVisitor) VisitFunctionStatement(ctx *parser.FunctionStatementContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
 
-	rightExpression := ctx.GetRight()
-	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+	parameters := ctx.AllParameter()
+	params := make([]interpreter.Parameter, len(parameters))
 
-	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
-	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+	for i, parameter := range parameters {
+		params[i] = interpreter.NewParameter(parameter.GetParamName().GetText(), parameter.GetType_().GetText())
+	}
 
-	compare, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, ctx.GetOp().GetText())
-	if err != nil {
+	function := interpreter.NewFunction(ctx.GetFuncName().GetText(), params, ctx.GetReturnType().GetText(), ctx.GetBody())
+
+	if err := v.interpreter.AddFunction(parseContext, function); err != nil {
 		return err
 	}
 
-	return compare
+	return nil
 }
 
-func (v *SimVisitor) VisitAndExpression(ctx *parser.AndExpressionContext) interface{} {
-	leftExpression := ctx.GetLeft()
-	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+func (v *SimVisitor) VisitStructStatement(ctx *parser.StructStatementContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
 
-	left, err := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression).GetBool(leftParseContext)
-	if err != nil {
-		return err
-	}
+	structFields := ctx.AllStructField()
+	fields := make([]interpreter.Field, len(structFields))
 
-	if !left {
-		return "false"
+	for i, structField := range structFields {
+		fields[i] = interpreter.NewField(structField.GetFieldName().GetText(), structField.GetType_().GetText())
 	}
 
-	rightExpression := ctx.GetRight()
-	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
-
-	right, err := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression).GetBool(rightParseContext)
-	if err != nil {
+	if err := v.interpreter.AddStructType(parseContext, ctx.GetTypeName().GetText(), fields); err != nil {
 		return err
 	}
 
-	if !right {
-		return "false"
+	if compareName := ctx.GetCompareName(); compareName != nil {
+		if err := v.interpreter.SetCompareFunction(parseContext, ctx.GetTypeName().GetText(), compareName.GetText()); err != nil {
+			return err
+		}
 	}
 
-	return "true"
+	return nil
 }
 
-func (v *SimVisitor) VisitOrExpression(ctx *parser.OrExpressionContext) interface{} {
-	leftExpression := ctx.GetLeft()
-	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+func (v *SimVisitor) VisitEnumStatement(ctx *parser.EnumStatementContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
 
-	left, err := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression).GetBool(leftParseContext)
-	if err != nil {
+	enumMembers := ctx.AllEnumMember()
+	members := make([]string, len(enumMembers))
+
+	for i, enumMember := range enumMembers {
+		members[i] = enumMember.GetMemberName().GetText()
+	}
+
+	if err := v.interpreter.AddEnumType(parseContext, ctx.GetTypeName().GetText(), members); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *SimVisitor) VisitUnionStatement(ctx *parser.UnionStatementContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+
+	unionVariants := ctx.AllUnionVariant()
+	variants := make([]interpreter.Variant, len(unionVariants))
+
+	for i, unionVariant := range unionVariants {
+		structFields := unionVariant.(*parser.UnionVariantContext).AllStructField()
+		fields := make([]interpreter.Field, len(structFields))
+
+		for j, structField := range structFields {
+			fields[j] = interpreter.NewField(structField.GetFieldName().GetText(), structField.GetType_().GetText())
+		}
+
+		variants[i] = interpreter.NewVariant(unionVariant.GetVariantName().GetText(), fields)
+	}
+
+	if err := v.interpreter.AddUnionType(parseContext, ctx.GetTypeName().GetText(), variants); err != nil {
+		return err
+	}
+
+	if compareName := ctx.GetCompareName(); compareName != nil {
+		if err := v.interpreter.SetCompareFunction(parseContext, ctx.GetTypeName().GetText(), compareName.GetText()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *SimVisitor) VisitMatchStatement(ctx *parser.MatchStatementContext) (result interface{}) {
+	expression := ctx.GetValue()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	value := v.expressionEvaluator.Evaluate(parseContext, v, expression)
+
+	typeName, err := value.GetType()
+	if err != nil {
+		return err
+	}
+
+	typeData, err := v.interpreter.GetTypeData(parseContext, typeName)
+	if err != nil || (!typeData.IsEnum() && !typeData.IsUnion()) {
+		return interpreter.InvalidOperationErr{Context: parseContext, TypeNames: []string{typeName}}
+	}
+
+	// Every case is checked before any of them run, so a match that can't handle every value never runs at all
+	matchCases := ctx.AllMatchCase()
+	caseNames := make([]string, 0, len(matchCases))
+	handled := make(map[string]struct{})
+	wildcard := false
+
+	for _, matchCase := range matchCases {
+		caseParseContext := interpreter.NewParseContext(matchCase.GetStart().GetLine(), matchCase.GetStart().GetColumn())
+		caseName := matchCase.GetCaseName().GetText()
+
+		if _, ok := handled[caseName]; ok || wildcard {
+			return interpreter.DuplicateCaseErr{Context: caseParseContext, CaseName: caseName}
+		}
+
+		handled[caseName] = struct{}{}
+
+		// The _ case matches any value, so it must come last
+		if caseName == "_" {
+			wildcard = true
+			continue
+		}
+
+		bindings := matchCase.(*parser.MatchCaseContext).AllMatchBinding()
+		if err := v.interpreter.CheckPattern(caseParseContext, typeName, caseName, len(bindings)); err != nil {
+			return err
+		}
+
+		caseNames = append(caseNames, caseName)
+	}
+
+	if !wildcard {
+		if err := v.interpreter.CheckExhaustive(parseContext, typeName, caseNames); err != nil {
+			return err
+		}
+	}
+
+	// Enum values hold the name of their member and union values hold the name of their variant
+	data, err := value.GetRawData()
+	if err != nil {
+		return err
+	}
+
+	for _, matchCase := range matchCases {
+		caseName := matchCase.GetCaseName().GetText()
+		if caseName != data && caseName != "_" {
+			continue
+		}
+
+		caseParseContext := interpreter.NewParseContext(matchCase.GetStart().GetLine(), matchCase.GetStart().GetColumn())
+
+		v.interpreter.PushScope()
+		defer func() {
+			if err := v.interpreter.PopScope(caseParseContext); err != nil {
+				result = err
+			}
+		}()
+
+		// Bindings hold the variant's fields in declaration order, and _ skips a field
+		fields, err := value.GetFields()
+		if err != nil {
+			return err
+		}
+
+		for i, matchBinding := range matchCase.(*parser.MatchCaseContext).AllMatchBinding() {
+			bindingName := matchBinding.GetBindingName().GetText()
+			if bindingName == "_" {
+				continue
+			}
+
+			bindingParseContext := interpreter.NewParseContext(matchBinding.GetStart().GetLine(), matchBinding.GetStart().GetColumn())
+
+			if err := v.interpreter.AddVar(bindingParseContext, interpreter.NewVariable(bindingName, fields[i])); err != nil {
+				return err
+			}
+		}
+
+		controlFlow, value, err := v.statementEvaluator.Evaluate(v, matchCase.GetBody())
+		if err != nil {
+			return err
+		}
+
+		return newStatementResult(controlFlow, value)
+	}
+
+	return nil
+}
+
+func (v *SimVisitor) VisitSwitchStatement(ctx *parser.SwitchStatementContext) interface{} {
+	expression := ctx.GetValue()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	// The value is only evaluated once, no matter how many labels it is compared with
+	value := v.expressionEvaluator.Evaluate(parseContext, v, expression)
+	if _, err := value.GetType(); err != nil {
+		return err
+	}
+
+	// Let literal labels take on the type of the value they are compared with
+	var typeData interpreter.TypeData
+	if valueTypeData, err := v.valueTypeData(parseContext, value); err == nil {
+		typeData = valueTypeData
+	}
+
+	switchCases := ctx.AllSwitchCase()
+
+	// Constant labels are checked before any case runs, so a switch that handles the same value twice never runs at all
+	var labels []interpreter.Value
+	var defaultCase *parser.SwitchCaseContext
+
+	for _, switchCase := range switchCases {
+		switchCase := switchCase.(*parser.SwitchCaseContext)
+		caseParseContext := interpreter.NewParseContext(switchCase.GetStart().GetLine(), switchCase.GetStart().GetColumn())
+
+		if switchCase.DEFAULT() != nil {
+			if defaultCase != nil {
+				return interpreter.DuplicateCaseErr{Context: caseParseContext, CaseName: switchCase.DEFAULT().GetText()}
+			}
+
+			defaultCase = switchCase
+			continue
+		}
+
+		for _, labelExpression := range switchCase.AllExpression() {
+			if !v.isConstantExpression(parseContext, labelExpression) {
+				continue
+			}
+
+			labelParseContext := interpreter.NewParseContext(labelExpression.GetStart().GetLine(), labelExpression.GetStart().GetColumn())
+			labelParseContext.TypeData = typeData
+
+			label := v.expressionEvaluator.Evaluate(labelParseContext, v, labelExpression)
+
+			for _, other := range labels {
+				equal, err := v.compare(labelParseContext, labelParseContext, label, other, "==")
+				if err != nil {
+					return err
+				}
+
+				if equal {
+					return interpreter.DuplicateCaseErr{Context: labelParseContext, CaseName: labelExpression.GetText()}
+				}
+			}
+
+			labels = append(labels, label)
+		}
+	}
+
+	// Without a default case, a switch over an enum or union must handle every member or variant, the same as a match
+	if defaultCase == nil && (typeData.IsEnum() || typeData.IsUnion()) {
+		memberNames := make([]string, 0, len(labels))
+		for _, label := range labels {
+			if labelTypeName, err := label.GetType(); err != nil || labelTypeName != typeData.GetTypeName() {
+				continue
+			}
+
+			memberName, err := label.GetRawData()
+			if err != nil {
+				return err
+			}
+
+			memberNames = append(memberNames, memberName)
+		}
+
+		if err := v.interpreter.CheckExhaustive(parseContext, typeData.GetTypeName(), memberNames); err != nil {
+			return err
+		}
+	}
+
+	// Labels are compared in order, and the default case only runs if none of them are equal to the value
+	for _, switchCase := range switchCases {
+		switchCase := switchCase.(*parser.SwitchCaseContext)
+
+		for _, labelExpression := range switchCase.AllExpression() {
+			labelParseContext := interpreter.NewParseContext(labelExpression.GetStart().GetLine(), labelExpression.GetStart().GetColumn())
+			labelParseContext.TypeData = typeData
+
+			equal, err := v.compare(parseContext, labelParseContext, value, v.expressionEvaluator.Evaluate(labelParseContext, v, labelExpression), "==")
+			if err != nil {
+				return err
+			}
+
+			if equal {
+				return v.switchCase(switchCase)
+			}
+		}
+	}
+
+	if defaultCase != nil {
+		return v.switchCase(defaultCase)
+	}
+
+	return nil
+}
+
+// compare returns the result of comparing the values with the given comparison operator.
+func (v *SimVisitor) compare(leftContext interpreter.ParseContext, rightContext interpreter.ParseContext, left interpreter.Value, right interpreter.Value, operator string) (bool, error) {
+	result, err := v.interpreter.ResolveBinaryOperations(leftContext, rightContext, left, right, operator)
+	if err != nil {
+		return false, err
+	}
+
+	return result.GetBool(rightContext)
+}
+
+// switchCase runs the statements of a switch case in a scope of their own.
+// Cases don't fall through, so a break or continue is passed on to the enclosing loop.
+func (v *SimVisitor) switchCase(ctx *parser.SwitchCaseContext) (result interface{}) {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+
+	v.interpreter.PushScope()
+	defer func() {
+		if err := v.interpreter.PopScope(parseContext); err != nil {
+			result = err
+		}
+	}()
+
+	var controlFlow ControlFlow
+	var value interpreter.Value
+	var err error
+
+	for _, statement := range ctx.AllStatement() {
+		controlFlow, value, err = v.statementEvaluator.Evaluate(v, statement)
+		if err != nil {
+			return err
+		}
+
+		if controlFlow != ControlFlowNormal {
+			break
+		}
+	}
+
+	return newStatementResult(controlFlow, value)
+}
+
+func (v *SimVisitor) VisitDeclarationStatement(ctx *parser.DeclarationStatementContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+	varName := ctx.GetVarName().GetText()
+
+	value, err := v.declaredValue(parseContext, ctx.GetType_().GetText(), varName, ctx.Expression())
+	if err != nil {
+		return err
+	}
+
+	variable := interpreter.NewVariable(varName, value)
+
+	if err := v.interpreter.AddVar(parseContext, variable); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *SimVisitor) VisitInferredDeclarationStatement(ctx *parser.InferredDeclarationStatementContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+	expression := ctx.GetValue()
+	expressionParseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+	varName := ctx.GetVarName().GetText()
+
+	// There is no declared type for literals to take on, so the variable takes its type from the value instead
+	value, err := v.interpreter.InferValue(expressionParseContext, varName, v.expressionEvaluator.Evaluate(expressionParseContext, v, expression))
+	if err != nil {
+		return err
+	}
+
+	variable := interpreter.NewVariable(varName, value)
+
+	if err := v.interpreter.AddVar(parseContext, variable); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *SimVisitor) VisitConstStatement(ctx *parser.ConstStatementContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+	expression := ctx.GetValue()
+	varName := ctx.GetVarName().GetText()
+
+	// Constants can only be built from values that are known before the program runs
+	if !v.isConstantExpression(parseContext, expression) {
+		return interpreter.NonConstantExprErr{Context: interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn()), VarName: varName}
+	}
+
+	value, err := v.declaredValue(parseContext, ctx.GetType_().GetText(), varName, expression)
+	if err != nil {
+		return err
+	}
+
+	constant := interpreter.NewConstant(varName, value, parseContext)
+
+	if err := v.interpreter.AddVar(parseContext, constant); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// declaredValue returns the value that a variable of the given type is declared with.
+// Without an initializer, the value is empty so that the variable starts with its type's zero value.
+func (v *SimVisitor) declaredValue(context interpreter.ParseContext, typeName string, varName string, expression parser.IExpressionContext) (interpreter.Value, error) {
+	typeData, err := v.interpreter.GetTypeData(context, typeName)
+	if err != nil {
+		return interpreter.NewErrorValue(err), err
+	}
+
+	if expression == nil {
+		return interpreter.NewValue(typeName, ""), nil
+	}
+
+	expressionParseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+	expressionParseContext.TypeData = typeData
+
+	value := v.expressionEvaluator.Evaluate(expressionParseContext, v, expression)
+
+	data, err := v.interpreter.FormatValue(expressionParseContext, value)
+	if err != nil {
+		return interpreter.NewErrorValue(err), err
+	}
+
+	// The variable always has its declared type, so the value must be usable as that type
+	result, ok := v.interpreter.ImplicitlyCast(expressionParseContext, value, typeName)
+	if !ok {
+		err := interpreter.MismatchedTypeAssignErr{Context: expressionParseContext, Var: interpreter.NewVariable(varName, interpreter.NewValue(typeName, data))}
+		return interpreter.NewErrorValue(err), err
+	}
+
+	return result, nil
+}
+
+// isConstantExpression returns true if the expression only uses literals, constants, enum members and type conversions,
+// combined with operators, so its value is always the same.
+func (v *SimVisitor) isConstantExpression(context interpreter.ParseContext, expression parser.IExpressionContext) bool {
+	switch expression := expression.(type) {
+	case *parser.LiteralExpressionContext:
+		return true
+
+	case *parser.VariableExpressionContext:
+		variable, err := v.interpreter.GetVar(context, expression.GetText())
+		if err != nil {
+			// Variants without fields always have the same value
+			_, variant, ok := v.interpreter.GetVariant(expression.GetText())
+			return ok && len(variant.Fields()) == 0
+		}
+
+		return variable.IsConstant()
+
+	case *parser.FieldExpressionContext:
+		_, ok := v.enumTypeName(expression.GetValue())
+		return ok
+
+	case *parser.CallExpressionContext:
+		// Only conversions to types that aren't structs are constant, since they only depend on their argument
+		typeData, err := v.interpreter.GetTypeData(context, expression.IDENTIFIER().GetText())
+		if err != nil || typeData.IsStruct() {
+			return false
+		}
+
+	case *parser.ParensExpressionContext,
+		*parser.NegateExpressionContext,
+		*parser.NotExpressionContext,
+		*parser.BitwiseNotExpressionContext,
+		*parser.MulDivModExpressionContext,
+		*parser.AddSubExpressionContext,
+		*parser.ShiftExpressionContext,
+		*parser.BitwiseAndExpressionContext,
+		*parser.BitwiseXorExpressionContext,
+		*parser.BitwiseOrExpressionContext,
+		*parser.InequalityExpressionContext,
+		*parser.EqualityExpressionContext,
+		*parser.AndExpressionContext,
+		*parser.OrExpressionContext,
+		*parser.ArrayExpressionContext:
+
+	default:
+		return false
+	}
+
+	for _, child := range expression.GetChildren() {
+		if child, ok := child.(parser.IExpressionContext); ok && !v.isConstantExpression(context, child) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *SimVisitor) VisitAssignmentStatement(ctx *parser.AssignmentStatementContext) interface{} {
+	target := ctx.GetTarget()
+	expression := ctx.GetValue()
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+	expressionParseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	// Let literals take on the type of what they are assigned to
+	typeData, err := v.targetTypeData(parseContext, target)
+	if err != nil {
+		return err
+	}
+
+	expressionParseContext.TypeData = typeData
+
+	result := v.expressionEvaluator.Evaluate(expressionParseContext, v, expression)
+
+	token := ctx.Assignment_op().GetStart()
+
+	if token.GetTokenType() != parser.SimParserASSIGNMENT {
+		current := v.expressionEvaluator.Evaluate(parseContext, v, target)
+
+		result, err = v.interpreter.ResolveBinaryOperations(parseContext, expressionParseContext, current, result, strings.TrimSuffix(token.GetText(), "="))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := v.assign(parseContext, target, result); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// targetTypeData returns the type data of the value that the target of an assignment refers to.
+// Assigning to a map key that isn't in the map adds it, so a map entry's type comes from the map rather than from looking up the key.
+func (v *SimVisitor) targetTypeData(context interpreter.ParseContext, target parser.IExpressionContext) (interpreter.TypeData, error) {
+	if target, ok := target.(*parser.IndexExpressionContext); ok {
+		parent := target.GetValue()
+		parentParseContext := interpreter.NewParseContext(parent.GetStart().GetLine(), parent.GetStart().GetColumn())
+
+		parentTypeData, err := v.valueTypeData(parentParseContext, v.expressionEvaluator.Evaluate(parentParseContext, v, parent))
+		if err != nil {
+			return interpreter.TypeData{}, err
+		}
+
+		if parentTypeData.IsMap() {
+			return v.interpreter.GetTypeData(context, parentTypeData.ElementTypeName())
+		}
+	}
+
+	return v.valueTypeData(context, v.expressionEvaluator.Evaluate(context, v, target))
+}
+
+// valueTypeData returns the type data of a value's type, or the value's error.
+func (v *SimVisitor) valueTypeData(context interpreter.ParseContext, value interpreter.Value) (interpreter.TypeData, error) {
+	typeName, err := value.GetType()
+	if err != nil {
+		return interpreter.TypeData{}, err
+	}
+
+	return v.interpreter.GetTypeData(context, typeName)
+}
+
+// assign stores the value in the variable, struct field, array element or map entry that the target expression refers to.
+// Assigning to a field, element or entry replaces the whole value it belongs to, all the way up to the variable holding it.
+func (v *SimVisitor) assign(context interpreter.ParseContext, target parser.IExpressionContext, value interpreter.Value) error {
+	switch target := target.(type) {
+	case *parser.VariableExpressionContext:
+		return v.interpreter.SetVarValue(context, target.GetText(), value)
+
+	case *parser.FieldExpressionContext:
+		parent := target.GetValue()
+
+		// Enum members are constants
+		if _, ok := v.enumTypeName(parent); ok {
+			break
+		}
+
+		parentParseContext := interpreter.NewParseContext(parent.GetStart().GetLine(), parent.GetStart().GetColumn())
+
+		parentValue := v.expressionEvaluator.Evaluate(parentParseContext, v, parent)
+
+		result, err := v.interpreter.SetField(context, parentValue, target.GetFieldName().GetText(), value)
+		if err != nil {
+			return err
+		}
+
+		return v.assign(context, parent, result)
+
+	case *parser.IndexExpressionContext:
+		parent := target.GetValue()
+		indexExpression := target.GetIndex()
+		parentParseContext := interpreter.NewParseContext(parent.GetStart().GetLine(), parent.GetStart().GetColumn())
+		indexParseContext := interpreter.NewParseContext(indexExpression.GetStart().GetLine(), indexExpression.GetStart().GetColumn())
+
+		parentValue := v.expressionEvaluator.Evaluate(parentParseContext, v, parent)
+
+		parentTypeData, err := v.valueTypeData(parentParseContext, parentValue)
+		if err != nil {
+			return err
+		}
+
+		if parentTypeData.IsMap() {
+			key, err := v.evaluateKey(indexParseContext, parentTypeData, indexExpression)
+			if err != nil {
+				return err
+			}
+
+			result, err := v.interpreter.SetMapValue(indexParseContext, parentValue, key, value)
+			if err != nil {
+				return err
+			}
+
+			return v.assign(context, parent, result)
+		}
+
+		index, err := v.expressionEvaluator.Evaluate(indexParseContext, v, indexExpression).GetInt(indexParseContext)
+		if err != nil {
+			return err
+		}
+
+		result, err := v.interpreter.SetElement(indexParseContext, parentValue, index, value)
+		if err != nil {
+			return err
+		}
+
+		return v.assign(context, parent, result)
+
+	case *parser.ParensExpressionContext:
+		return v.assign(context, target.Expression(), value)
+	}
+
+	return interpreter.InvalidAssignmentErr{Context: context, Target: target.GetText()}
+}
+
+func (v *SimVisitor) VisitPrintStatement(ctx *parser.PrintStatementContext) interface{} {
+	expression := ctx.Expression()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	value := v.expressionEvaluator.Evaluate(parseContext, v, expression)
+
+	typeName, err := value.GetType()
+	if err != nil {
+		return err
+	}
+
+	// Strings are printed without their quotes
+	var result string
+	if typeName == "string" {
+		result, err = value.GetString(parseContext)
+	} else {
+		result, err = v.interpreter.FormatValue(parseContext, value)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	v.interpreter.PrintLine(result)
+	return nil
+}
+
+func (v *SimVisitor) VisitReturnStatement(ctx *parser.ReturnStatementContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+
+	expression := ctx.Expression()
+
+	function, ok := v.interpreter.CurrentFunction()
+	if !ok {
+		// Returning from the global frame stops the program, passing the value up if there is one
+		if expression == nil {
+			return ControlFlowReturn
+		}
+
+		expressionParseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+		value := v.expressionEvaluator.Evaluate(expressionParseContext, v, expression)
+		if _, err := value.GetType(); err != nil {
+			return err
+		}
+
+		return Return{Value: value}
+	}
+
+	if expression == nil {
+		return interpreter.MissingReturnErr{Context: parseContext, FuncName: function.Name(), TypeName: function.ReturnTypeName()}
+	}
+
+	expressionParseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	typeData, err := v.interpreter.GetTypeData(parseContext, function.ReturnTypeName())
+	if err != nil {
+		return err
+	}
+
+	expressionParseContext.TypeData = typeData
+
+	value := v.expressionEvaluator.Evaluate(expressionParseContext, v, expression)
+
+	typeName, err := value.GetType()
+	if err != nil {
+		return err
+	}
+
+	result, ok := v.interpreter.ImplicitlyCast(expressionParseContext, value, function.ReturnTypeName())
+	if !ok {
+		return interpreter.MismatchedReturnTypeErr{Context: expressionParseContext, FuncName: function.Name(), TypeName: function.ReturnTypeName(), ValueTypeName: typeName}
+	}
+
+	return Return{Value: result}
+}
+
+func (v *SimVisitor) VisitBreakStatement(ctx *parser.BreakStatementContext) interface{} {
+	v.jumpTo(ctx.GetLabel())
+
+	return ControlFlowBreak
+}
+
+func (v *SimVisitor) VisitContinueStatement(ctx *parser.ContinueStatementContext) interface{} {
+	v.jumpTo(ctx.GetLabel())
+
+	return ControlFlowContinue
+}
+
+// jumpTo sets the loop that a break or continue jumps out to, which is the innermost loop if there is no label.
+// Labels are checked by checkLabels before the program runs, so the label always belongs to an enclosing loop.
+func (v *SimVisitor) jumpTo(label antlr.Token) {
+	if label != nil {
+		v.jumpLabel = label.GetText()
+	}
+}
+
+// checkLabels returns an error if a break or continue in the tree uses a label that isn't declared on a loop enclosing it,
+// or if a loop uses the same label as a loop enclosing it. The labels are those of the loops enclosing the tree.
+func (v *SimVisitor) checkLabels(tree antlr.Tree, labels []string) error {
+	var label antlr.Token
+
+	switch tree := tree.(type) {
+	case *parser.InfiniteLoopStatementContext:
+		label = tree.GetLabel()
+	case *parser.ConditionalLoopStatementContext:
+		label = tree.GetLabel()
+	case *parser.LoopStatementContext:
+		label = tree.GetLabel()
+	case *parser.ForEachStatementContext:
+		label = tree.GetLabel()
+
+	case *parser.BreakStatementContext:
+		return checkJumpLabel(tree.GetLabel(), labels)
+	case *parser.ContinueStatementContext:
+		return checkJumpLabel(tree.GetLabel(), labels)
+
+	// Loops outside of a function can't be jumped to from inside it
+	case *parser.FunctionStatementContext, *parser.FunctionExpressionContext:
+		labels = nil
+	}
+
+	if label != nil {
+		if containsLabel(labels, label.GetText()) {
+			return interpreter.DuplicateLabelErr{Context: interpreter.NewParseContext(label.GetLine(), label.GetColumn()), Label: label.GetText()}
+		}
+
+		labels = append(labels[:len(labels):len(labels)], label.GetText())
+	}
+
+	for _, child := range tree.GetChildren() {
+		if err := v.checkLabels(child, labels); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkJumpLabel returns an error if a break or continue uses a label that isn't one of the labels of the loops enclosing it.
+func checkJumpLabel(label antlr.Token, labels []string) error {
+	if label != nil && !containsLabel(labels, label.GetText()) {
+		return interpreter.UnknownLabelErr{Context: interpreter.NewParseContext(label.GetLine(), label.GetColumn()), Label: label.GetText()}
+	}
+
+	return nil
+}
+
+// containsLabel returns true if the label is one of the given labels.
+func containsLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+
+	return false
+}
+
+// leavesLoop returns true if the control flow coming out of a loop's body leaves the loop altogether.
+// That is the case when returning, or when breaking or continuing a labeled loop further out.
+// A break or continue that targets the loop is handled by the loop itself.
+func (v *SimVisitor) leavesLoop(label antlr.Token, controlFlow ControlFlow) bool {
+	switch controlFlow {
+	case ControlFlowReturn:
+		return true
+
+	case ControlFlowBreak, ControlFlowContinue:
+		if v.jumpLabel != "" && (label == nil || label.GetText() != v.jumpLabel) {
+			return true
+		}
+
+		v.jumpLabel = ""
+	}
+
+	return false
+}
+
+func (v *SimVisitor) VisitParensExpression(ctx *parser.ParensExpressionContext) interface{} {
+	expression := ctx.Expression()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+	return v.expressionEvaluator.Evaluate(parseContext, v, expression)
+}
+
+func (v *SimVisitor) VisitIndexExpression(ctx *parser.IndexExpressionContext) interface{} {
+	valueExpression := ctx.GetValue()
+	indexExpression := ctx.GetIndex()
+	valueParseContext := interpreter.NewParseContext(valueExpression.GetStart().GetLine(), valueExpression.GetStart().GetColumn())
+	indexParseContext := interpreter.NewParseContext(indexExpression.GetStart().GetLine(), indexExpression.GetStart().GetColumn())
+
+	value := v.expressionEvaluator.Evaluate(valueParseContext, v, valueExpression)
+
+	typeName, err := value.GetType()
+	if err != nil {
+		return err
+	}
+
+	if typeData, err := v.interpreter.GetTypeData(valueParseContext, typeName); err == nil && typeData.IsMap() {
+		key, err := v.evaluateKey(indexParseContext, typeData, indexExpression)
+		if err != nil {
+			return err
+		}
+
+		result, err := v.interpreter.GetMapValue(indexParseContext, value, key)
+		if err != nil {
+			return err
+		}
+
+		return result
+	}
+
+	index, err := v.expressionEvaluator.Evaluate(indexParseContext, v, indexExpression).GetInt(indexParseContext)
+	if err != nil {
+		return err
+	}
+
+	if typeName == "string" {
+		result, err := interpreter.IndexString(indexParseContext, value, index)
+		if err != nil {
+			return err
+		}
+
+		return result
+	}
+
+	if !v.isSequence(valueParseContext, typeName) {
+		return interpreter.InvalidOperationErr{Context: valueParseContext, TypeNames: []string{typeName}}
+	}
+
+	result, err := v.interpreter.IndexArray(indexParseContext, value, index)
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+// evaluateKey evaluates the expression used to look up a value in a map, letting literals take on the map's key type.
+func (v *SimVisitor) evaluateKey(context interpreter.ParseContext, typeData interpreter.TypeData, expression parser.IExpressionContext) (interpreter.Value, error) {
+	keyTypeData, err := v.interpreter.GetTypeData(context, typeData.KeyTypeName())
+	if err != nil {
+		return interpreter.NewErrorValue(err), err
+	}
+
+	context.TypeData = keyTypeData
+
+	key := v.expressionEvaluator.Evaluate(context, v, expression)
+	if _, err := key.GetType(); err != nil {
+		return key, err
+	}
+
+	return key, nil
+}
+
+// enumTypeName returns the name of the enum type that an expression names, or false if it doesn't name an enum type.
+func (v *SimVisitor) enumTypeName(expression parser.IExpressionContext) (string, bool) {
+	if _, ok := expression.(*parser.VariableExpressionContext); !ok {
+		return "", false
+	}
+
+	typeName := expression.GetText()
+
+	typeData, err := v.interpreter.GetTypeData(interpreter.ParseContext{}, typeName)
+	return typeName, err == nil && typeData.IsEnum()
+}
+
+// isSequence returns true if the type is an array or list type, or the type of an array literal.
+func (v *SimVisitor) isSequence(context interpreter.ParseContext, typeName string) bool {
+	if typeName == "untyped array" {
+		return true
+	}
+
+	typeData, err := v.interpreter.GetTypeData(context, typeName)
+	return err == nil && (typeData.IsArray() || typeData.IsList())
+}
+
+func (v *SimVisitor) VisitSliceExpression(ctx *parser.SliceExpressionContext) interface{} {
+	valueExpression := ctx.GetValue()
+	valueParseContext := interpreter.NewParseContext(valueExpression.GetStart().GetLine(), valueExpression.GetStart().GetColumn())
+
+	value := v.expressionEvaluator.Evaluate(valueParseContext, v, valueExpression)
+
+	typeName, err := value.GetType()
+	if err != nil {
+		return err
+	}
+
+	if !v.isSequence(valueParseContext, typeName) {
+		return interpreter.InvalidOperationErr{Context: valueParseContext, TypeNames: []string{typeName}}
+	}
+
+	elements, err := value.GetElements()
+	if err != nil {
+		return err
+	}
+
+	// A missing low index starts the slice at the beginning, and a missing high index ends it at the end
+	low, high := int64(0), int64(len(elements))
+	lowParseContext, highParseContext := valueParseContext, valueParseContext
+
+	if lowExpression := ctx.GetLow(); lowExpression != nil {
+		lowParseContext = interpreter.NewParseContext(lowExpression.GetStart().GetLine(), lowExpression.GetStart().GetColumn())
+
+		low, err = v.expressionEvaluator.Evaluate(lowParseContext, v, lowExpression).GetInt(lowParseContext)
+		if err != nil {
+			return err
+		}
+	}
+
+	if highExpression := ctx.GetHigh(); highExpression != nil {
+		highParseContext = interpreter.NewParseContext(highExpression.GetStart().GetLine(), highExpression.GetStart().GetColumn())
+
+		high, err = v.expressionEvaluator.Evaluate(highParseContext, v, highExpression).GetInt(highParseContext)
+		if err != nil {
+			return err
+		}
+	}
+
+	result, err := v.interpreter.SliceValue(lowParseContext, highParseContext, value, low, high)
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+func (v *SimVisitor) VisitFieldExpression(ctx *parser.FieldExpressionContext) interface{} {
+	expression := ctx.GetValue()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+	fieldParseContext := interpreter.NewParseContext(ctx.GetFieldName().GetLine(), ctx.GetFieldName().GetColumn())
+
+	// Enum members are accessed through their type's name, such as Color.Red
+	if typeName, ok := v.enumTypeName(expression); ok {
+		result, err := v.interpreter.GetEnumMember(fieldParseContext, typeName, ctx.GetFieldName().GetText())
+		if err != nil {
+			return err
+		}
+
+		return result
+	}
+
+	value := v.expressionEvaluator.Evaluate(parseContext, v, expression)
+
+	result, err := v.interpreter.GetField(fieldParseContext, value, ctx.GetFieldName().GetText())
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+func (v *SimVisitor) VisitNegateExpression(ctx *parser.NegateExpressionContext) interface{} {
+	expression := ctx.Expression()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	val := v.expressionEvaluator.Evaluate(parseContext, v, expression)
+
+	result, err := v.interpreter.ResolveUnaryOperations(parseContext, val, ctx.SUBTRACT().GetText())
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+func (v *SimVisitor) VisitNotExpression(ctx *parser.NotExpressionContext) interface{} {
+	expression := ctx.Expression()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	result, err := v.expressionEvaluator.Evaluate(parseContext, v, expression).GetBool(parseContext)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Sprintf("%t", !result)
+}
+
+func (v *SimVisitor) VisitBitwiseNotExpression(ctx *parser.BitwiseNotExpressionContext) interface{} {
+	expression := ctx.Expression()
+	parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	val := v.expressionEvaluator.Evaluate(parseContext, v, expression)
+
+	result, err := v.interpreter.ResolveUnaryOperations(parseContext, val, ctx.BITWISE_NOT().GetText())
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+func (v *SimVisitor) VisitMulDivModExpression(ctx *parser.MulDivModExpressionContext) interface{} {
+	leftExpression := ctx.GetLeft()
+	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+
+	rightExpression := ctx.GetRight()
+	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+
+	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
+	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+
+	result, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, ctx.GetOp().GetText())
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+func (v *SimVisitor) VisitAddSubExpression(ctx *parser.AddSubExpressionContext) interface{} {
+	leftExpression := ctx.GetLeft()
+	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+
+	rightExpression := ctx.GetRight()
+	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+
+	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
+	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+
+	result, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, ctx.GetOp().GetText())
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+func (v *SimVisitor) VisitShiftExpression(ctx *parser.ShiftExpressionContext) interface{} {
+	return v.bitwiseExpression(ctx.GetLeft(), ctx.GetRight(), ctx.GetOp().GetText())
+}
+
+func (v *SimVisitor) VisitBitwiseAndExpression(ctx *parser.BitwiseAndExpressionContext) interface{} {
+	return v.bitwiseExpression(ctx.GetLeft(), ctx.GetRight(), ctx.GetOp().GetText())
+}
+
+func (v *SimVisitor) VisitBitwiseXorExpression(ctx *parser.BitwiseXorExpressionContext) interface{} {
+	return v.bitwiseExpression(ctx.GetLeft(), ctx.GetRight(), ctx.GetOp().GetText())
+}
+
+func (v *SimVisitor) VisitBitwiseOrExpression(ctx *parser.BitwiseOrExpressionContext) interface{} {
+	return v.bitwiseExpression(ctx.GetLeft(), ctx.GetRight(), ctx.GetOp().GetText())
+}
+
+// bitwiseExpression resolves a shift or a bitwise and, or or xor of two integer expressions.
+func (v *SimVisitor) bitwiseExpression(leftExpression parser.IExpressionContext, rightExpression parser.IExpressionContext, operator string) interface{} {
+	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+
+	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
+	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+
+	result, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, operator)
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+func (v *SimVisitor) VisitInequalityExpression(ctx *parser.InequalityExpressionContext) interface{} {
+	leftExpression := ctx.GetLeft()
+	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+
+	rightExpression := ctx.GetRight()
+	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+
+	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
+	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+
+	// Types declared with "ordered by" are compared by calling their comparison function,
+	// then comparing its result to zero
+	leftTypeName, leftErr := left.GetType()
+	rightTypeName, rightErr := right.GetType()
+
+	if leftErr == nil && rightErr == nil && leftTypeName == rightTypeName {
+		parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+
+		function, ok, err := v.interpreter.GetCompareFunction(parseContext, leftTypeName)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			result, err := v.callFunction(parseContext, function, []interpreter.Value{left, right}, []interpreter.ParseContext{leftParseContext, rightParseContext})
+			if err != nil {
+				return err
+			}
+
+			left, right = result, interpreter.NewValue("int", "0")
+		}
+	}
+
+	compare, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, ctx.GetOp().GetText())
+	if err != nil {
+		return err
+	}
+
+	return compare
+}
+
+func (v *SimVisitor) VisitEqualityExpression(ctx *parser.EqualityExpressionContext) interface{} {
+	leftExpression := ctx.GetLeft()
+	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+
+	rightExpression := ctx.GetRight()
+	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+
+	left := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression)
+	right := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression)
+
+	compare, err := v.interpreter.ResolveBinaryOperations(leftParseContext, rightParseContext, left, right, ctx.GetOp().GetText())
+	if err != nil {
+		return err
+	}
+
+	return compare
+}
+
+func (v *SimVisitor) VisitAndExpression(ctx *parser.AndExpressionContext) interface{} {
+	leftExpression := ctx.GetLeft()
+	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+
+	left, err := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression).GetBool(leftParseContext)
+	if err != nil {
+		return err
+	}
+
+	if !left {
+		return "false"
+	}
+
+	rightExpression := ctx.GetRight()
+	rightParseContext := interpreter.NewParseContext(rightExpression.GetStart().GetLine(), rightExpression.GetStart().GetColumn())
+
+	right, err := v.expressionEvaluator.Evaluate(rightParseContext, v, rightExpression).GetBool(rightParseContext)
+	if err != nil {
+		return err
+	}
+
+	if !right {
+		return "false"
+	}
+
+	return "true"
+}
+
+func (v *SimVisitor) VisitOrExpression(ctx *parser.OrExpressionContext) interface{} {
+	leftExpression := ctx.GetLeft()
+	leftParseContext := interpreter.NewParseContext(leftExpression.GetStart().GetLine(), leftExpression.GetStart().GetColumn())
+
+	left, err := v.expressionEvaluator.Evaluate(leftParseContext, v, leftExpression).GetBool(leftParseContext)
+	if err != nil {
 		return err
 	}
 
@@ -486,6 +1766,21 @@ func (v *SimVisitor) VisitVariableExpression(ctx *parser.VariableExpressionConte
 
 	variable, err := v.interpreter.GetVar(parseContext, varName)
 	if err != nil {
+		// Variants without fields can be used by name without calling them
+		if _, variant, ok := v.interpreter.GetVariant(varName); ok && len(variant.Fields()) == 0 {
+			return v.variantExpression(parseContext, varName, nil)
+		}
+
+		// Declared functions can be used as function values
+		if _, funcErr := v.interpreter.GetFunction(parseContext, varName); funcErr == nil {
+			value, err := v.interpreter.GetFunctionValue(parseContext, varName)
+			if err != nil {
+				return err
+			}
+
+			return value
+		}
+
 		return err
 	}
 
@@ -493,5 +1788,342 @@ func (v *SimVisitor) VisitVariableExpression(ctx *parser.VariableExpressionConte
 }
 
 func (v *SimVisitor) VisitLiteralExpression(ctx *parser.LiteralExpressionContext) interface{} {
+	switch ctx.GetStart().GetTokenType() {
+	case parser.SimParserSTRING, parser.SimParserMULTILINE_STRING, parser.SimParserRAW_STRING:
+		parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+
+		data, err := interpreter.ParseStringLiteral(parseContext, ctx.GetText())
+		if err != nil {
+			return err
+		}
+
+		return interpreter.NewValue("string", data)
+	}
+
 	return ctx.GetText()
 }
+
+func (v *SimVisitor) VisitArrayExpression(ctx *parser.ArrayExpressionContext) interface{} {
+	expressions := ctx.AllExpression()
+	elements := make([]interpreter.Value, len(expressions))
+
+	// Array literals are untyped until they are used as a specific array type, so their elements stay untyped too
+	for i, expression := range expressions {
+		parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+		elements[i] = v.expressionEvaluator.Evaluate(parseContext, v, expression)
+		if _, err := elements[i].GetType(); err != nil {
+			return err
+		}
+	}
+
+	return interpreter.NewArrayValue("untyped array", elements)
+}
+
+func (v *SimVisitor) VisitMapExpression(ctx *parser.MapExpressionContext) interface{} {
+	entries := make([]interpreter.Value, 0, 2*len(ctx.AllMapEntry()))
+
+	// Map literals are untyped until they are used as a specific map type, so their keys and values stay untyped too
+	for _, entry := range ctx.AllMapEntry() {
+		for _, expression := range []parser.IExpressionContext{entry.GetKey(), entry.GetValue()} {
+			parseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+			value := v.expressionEvaluator.Evaluate(parseContext, v, expression)
+			if _, err := value.GetType(); err != nil {
+				return err
+			}
+
+			entries = append(entries, value)
+		}
+	}
+
+	return interpreter.NewMapValue("untyped map", entries)
+}
+
+func (v *SimVisitor) VisitCallExpression(ctx *parser.CallExpressionContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+
+	name := ctx.IDENTIFIER().GetText()
+
+	// Calling a union variant constructs a value of its union type from the variant's field values
+	if _, _, ok := v.interpreter.GetVariant(name); ok {
+		return v.variantExpression(parseContext, name, ctx.AllExpression())
+	}
+
+	// Calling a struct type constructs it from its field values, and calling any other type converts the argument to that type
+	if typeData, err := v.interpreter.GetTypeData(parseContext, name); err == nil {
+		if typeData.IsStruct() {
+			return v.structExpression(parseContext, name, typeData, ctx.AllExpression())
+		}
+
+		return v.convertExpression(parseContext, name, ctx.AllExpression())
+	}
+
+	// Variables holding function values shadow declared functions with the same name
+	if variable, err := v.interpreter.GetVar(parseContext, name); err == nil && v.isFunction(parseContext, variable.Value()) {
+		return v.invokeExpression(parseContext, variable.Value(), ctx.AllExpression())
+	}
+
+	function, err := v.interpreter.GetFunction(parseContext, name)
+	if err != nil {
+		return err
+	}
+
+	return v.callExpression(parseContext, function, ctx.AllExpression())
+}
+
+func (v *SimVisitor) VisitInvokeExpression(ctx *parser.InvokeExpressionContext) interface{} {
+	callee := ctx.GetCallee()
+	parseContext := interpreter.NewParseContext(callee.GetStart().GetLine(), callee.GetStart().GetColumn())
+
+	value := v.expressionEvaluator.Evaluate(parseContext, v, callee)
+	if _, err := value.GetType(); err != nil {
+		return err
+	}
+
+	// The callee is the first expression, followed by the arguments
+	return v.invokeExpression(parseContext, value, ctx.AllExpression()[1:])
+}
+
+func (v *SimVisitor) VisitFunctionExpression(ctx *parser.FunctionExpressionContext) interface{} {
+	parseContext := interpreter.NewParseContext(ctx.GetStart().GetLine(), ctx.GetStart().GetColumn())
+
+	parameters := ctx.AllParameter()
+	params := make([]interpreter.Parameter, len(parameters))
+
+	for i, parameter := range parameters {
+		params[i] = interpreter.NewParameter(parameter.GetParamName().GetText(), parameter.GetType_().GetText())
+	}
+
+	// Function literals are anonymous, so they are named after the fn keyword in errors
+	function := interpreter.NewFunction(ctx.FN().GetText(), params, ctx.GetReturnType().GetText(), ctx.GetBody())
+
+	value, err := v.interpreter.NewClosure(parseContext, function)
+	if err != nil {
+		return err
+	}
+
+	return value
+}
+
+// isFunction returns true if the value is of a function type.
+func (v *SimVisitor) isFunction(context interpreter.ParseContext, value interpreter.Value) bool {
+	typeName, err := value.GetType()
+	if err != nil {
+		return false
+	}
+
+	typeData, err := v.interpreter.GetTypeData(context, typeName)
+	return err == nil && typeData.IsFunction()
+}
+
+// invokeExpression calls the function held by a function value with the values of the expressions as its arguments.
+func (v *SimVisitor) invokeExpression(context interpreter.ParseContext, value interpreter.Value, expressions []parser.IExpressionContext) interface{} {
+	function, err := v.interpreter.GetCallee(context, value)
+	if err != nil {
+		return err
+	}
+
+	return v.callExpression(context, function, expressions)
+}
+
+// callExpression calls a function with the values of the expressions as its arguments.
+func (v *SimVisitor) callExpression(context interpreter.ParseContext, function interpreter.Function, expressions []parser.IExpressionContext) interface{} {
+	params := function.Params()
+	args := make([]interpreter.Value, len(expressions))
+	argParseContexts := make([]interpreter.ParseContext, len(expressions))
+
+	for i, expression := range expressions {
+		argParseContexts[i] = interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+		// Let literal arguments take on the type of their parameter
+		if i < len(params) && params[i].TypeName() != interpreter.AnyTypeName {
+			typeData, err := v.interpreter.GetTypeData(argParseContexts[i], params[i].TypeName())
+			if err != nil {
+				return err
+			}
+
+			argParseContexts[i].TypeData = typeData
+		}
+
+		args[i] = v.expressionEvaluator.Evaluate(argParseContexts[i], v, expression)
+		if _, err := args[i].GetType(); err != nil {
+			return err
+		}
+	}
+
+	result, err := v.callFunction(context, function, args, argParseContexts)
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+// convertExpression explicitly converts the value of a single expression to the given type.
+func (v *SimVisitor) convertExpression(context interpreter.ParseContext, typeName string, expressions []parser.IExpressionContext) interface{} {
+	if len(expressions) != 1 {
+		return interpreter.MismatchedArgCountErr{Context: context, FuncName: typeName, Expected: 1, Actual: len(expressions)}
+	}
+
+	expression := expressions[0]
+	expressionParseContext := interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+	value := v.expressionEvaluator.Evaluate(expressionParseContext, v, expression)
+
+	result, err := v.interpreter.ConvertValue(expressionParseContext, value, typeName)
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+// structExpression constructs a struct value with its fields set to the values of the expressions in declaration order.
+func (v *SimVisitor) structExpression(context interpreter.ParseContext, typeName string, typeData interpreter.TypeData, expressions []parser.IExpressionContext) interface{} {
+	values, valueParseContexts, err := v.evaluateFields(typeData.Fields(), expressions)
+	if err != nil {
+		return err
+	}
+
+	result, err := v.interpreter.ConstructStruct(context, typeName, values, valueParseContexts)
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+// variantExpression constructs a union value holding the named variant with its fields set to the values of the expressions in declaration order.
+func (v *SimVisitor) variantExpression(context interpreter.ParseContext, variantName string, expressions []parser.IExpressionContext) interface{} {
+	_, variant, _ := v.interpreter.GetVariant(variantName)
+
+	values, valueParseContexts, err := v.evaluateFields(variant.Fields(), expressions)
+	if err != nil {
+		return err
+	}
+
+	result, err := v.interpreter.ConstructVariant(context, variantName, values, valueParseContexts)
+	if err != nil {
+		return err
+	}
+
+	return result
+}
+
+// evaluateFields evaluates the expressions given for a list of fields, letting literal values take on the type of their field.
+func (v *SimVisitor) evaluateFields(fields []interpreter.Field, expressions []parser.IExpressionContext) ([]interpreter.Value, []interpreter.ParseContext, error) {
+	values := make([]interpreter.Value, len(expressions))
+	valueParseContexts := make([]interpreter.ParseContext, len(expressions))
+
+	for i, expression := range expressions {
+		valueParseContexts[i] = interpreter.NewParseContext(expression.GetStart().GetLine(), expression.GetStart().GetColumn())
+
+		// Let literal values take on the type of their field
+		if i < len(fields) {
+			fieldTypeData, err := v.interpreter.GetTypeData(valueParseContexts[i], fields[i].TypeName())
+			if err != nil {
+				return nil, nil, err
+			}
+
+			valueParseContexts[i].TypeData = fieldTypeData
+		}
+
+		values[i] = v.expressionEvaluator.Evaluate(valueParseContexts[i], v, expression)
+		if _, err := values[i].GetType(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return values, valueParseContexts, nil
+}
+
+// CallMain calls the program's main function, which must be declared as main() : int,
+// and returns the int that it returned. It should be called once the top level statements have been visited.
+func (v *SimVisitor) CallMain() (int32, error) {
+	function, err := v.interpreter.GetFunction(interpreter.NewParseContext(0, 0), "main")
+	if err != nil {
+		return 0, interpreter.MissingMainErr{}
+	}
+
+	if len(function.Params()) != 0 || function.ReturnTypeName() != "int" {
+		return 0, interpreter.InvalidMainErr{Function: function}
+	}
+
+	// There is no call site for main, so errors about the call point to main's body instead
+	var parseContext interpreter.ParseContext
+	if body, ok := function.Body().(parser.IStatementContext); ok {
+		parseContext = interpreter.NewParseContext(body.GetStart().GetLine(), body.GetStart().GetColumn())
+	}
+
+	result, err := v.callFunction(parseContext, function, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	// main returns an int, so its result always fits in 32 bits
+	exitCode, err := result.GetInt(parseContext)
+	return int32(exitCode), err
+}
+
+// callFunction executes the function's body in a new call frame with the parameters bound to the given arguments,
+// and returns the value the function returned. Each argument's parse context is used to report a mismatched argument type.
+func (v *SimVisitor) callFunction(context interpreter.ParseContext, function interpreter.Function, args []interpreter.Value, argContexts []interpreter.ParseContext) (result interpreter.Value, resultErr error) {
+	params := function.Params()
+	if len(args) != len(params) {
+		err := interpreter.MismatchedArgCountErr{Context: context, FuncName: function.Name(), Expected: len(params), Actual: len(args)}
+		return interpreter.NewErrorValue(err), err
+	}
+
+	// Cast all arguments before entering the new frame, since the casts are checked in the caller's context
+	castArgs := make([]interpreter.Value, len(args))
+	for i, param := range params {
+		arg, ok := v.interpreter.ImplicitlyCast(argContexts[i], args[i], param.TypeName())
+		if !ok {
+			typeName, _ := args[i].GetType()
+			err := interpreter.MismatchedArgTypeErr{Context: argContexts[i], FuncName: function.Name(), ParamName: param.Name(), TypeName: param.TypeName(), ValueTypeName: typeName}
+			return interpreter.NewErrorValue(err), err
+		}
+
+		castArgs[i] = arg
+	}
+
+	// Built-in functions are run by the interpreter directly, without needing a frame of their own
+	if builtin, ok := function.Body().(interpreter.BuiltinFunc); ok {
+		return builtin(context, castArgs)
+	}
+
+	if err := v.interpreter.PushFrame(context, function); err != nil {
+		return interpreter.NewErrorValue(err), err
+	}
+
+	defer func() {
+		if err := v.interpreter.PopFrame(context); err != nil {
+			result, resultErr = interpreter.NewErrorValue(err), err
+		}
+	}()
+
+	for i, param := range params {
+		if err := v.interpreter.AddVar(context, interpreter.NewVariable(param.Name(), castArgs[i])); err != nil {
+			return interpreter.NewErrorValue(err), err
+		}
+	}
+
+	body, ok := function.Body().(parser.IStatementContext)
+	if !ok {
+		err := interpreter.InvalidControlFlowErr{Context: context, Data: function.Body()}
+		return interpreter.NewErrorValue(err), err
+	}
+
+	controlFlow, value, err := v.statementEvaluator.Evaluate(v, body)
+	if err != nil {
+		return interpreter.NewErrorValue(err), err
+	}
+
+	if controlFlow != ControlFlowReturn {
+		err := interpreter.MissingReturnErr{Context: context, FuncName: function.Name(), TypeName: function.ReturnTypeName()}
+		return interpreter.NewErrorValue(err), err
+	}
+
+	return value, nil
+}
@@ -14,6 +14,49 @@ import (
 )
 
 func TestVisitBlockStatement(t *testing.T) {
+	t.Run("var exists", func(t *testing.T) {
+		input := `{
+			int a
+			bool a
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.VarExistsErr{Context: interpreter.NewParseContext(3, 3), VarName: "a"}.Error())
+	})
+
+	t.Run("shadowing", func(t *testing.T) {
+		input := `int a = 1
+		int b
+		{
+			string a = "inner"
+			print(a)
+			{
+				bool a = true
+				print(a)
+			}
+			print(a)
+			b = 2
+		}
+		a = a + b
+		print(a)`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "inner\ntrue\ninner\n3\n", buf.String())
+
+		expectedVars := map[string]interpreter.Variable{
+			"a": interpreter.NewVariable("a", interpreter.NewValue("int", "3")),
+			"b": interpreter.NewVariable("b", interpreter.NewValue("int", "2")),
+		}
+
+		assert.Equal(t, expectedVars, simInterpreter.GetAllVars())
+	})
+
 	input := `{
 		int a = 10
 		int b = a
@@ -36,6 +79,77 @@ func TestVisitBlockStatement(t *testing.T) {
 }
 
 func TestIfStatement(t *testing.T) {
+	t.Run("else", func(t *testing.T) {
+		input := `function sign(int n): string {
+			if n > 0 {
+				return "positive"
+			} else if n < 0 {
+				return "negative"
+			}
+			else
+			{
+				return "zero"
+			}
+		}
+
+		function clamp(int n): int {
+			if n > 10 return 10
+			else if n < 0 return 0
+			return n
+		}
+
+		print(sign(5))
+		print(sign(0 - 5))
+		print(sign(0))
+		print(clamp(20))
+		print(clamp(0 - 1))
+		print(clamp(5))
+
+		if false { print("no") } else { print("yes") }
+
+		// An else belongs to the closest if
+		if true if false print("inner") else print("inner else")
+
+		if 1 > 2 {
+			print("one")
+		}
+		else if 2 > 3 {
+			print("two")
+		}
+		else {
+			print("three")
+		}`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "positive\nnegative\nzero\n10\n0\n5\nyes\ninner else\nthree\n", buf.String())
+	})
+
+	t.Run("else condition is not evaluated", func(t *testing.T) {
+		input := `if true {
+		} else if f() {
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid else condition", func(t *testing.T) {
+		input := `if false {
+		} else if "yes" {
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.Error(t, err)
+	})
+
 	input := `int a
 
 	if 20 > 10 
@@ -91,6 +205,31 @@ func TestInfiniteLoopStatement(t *testing.T) {
 }
 
 func TestConditionalLoopStatement(t *testing.T) {
+	t.Run("count", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    string
+			expected string
+		}{
+			{name: "zero", input: `loop 0 { print("ran") }`, expected: ""},
+			{name: "one", input: `loop 1 { print("ran") }`, expected: "ran\n"},
+			{name: "two", input: `loop 2 { print("ran") }`, expected: "ran\nran\n"},
+			{name: "variable", input: `uint8 n = 1
+			loop n { print("ran") }`, expected: "ran\n"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+				err := walkTree(t, test.input, simInterpreter)
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, buf.String())
+			})
+		}
+	})
+
 	input := `int a
 	loop a < 5
 	{
@@ -120,6 +259,71 @@ func TestConditionalLoopStatement(t *testing.T) {
 }
 
 func TestLoopStatement(t *testing.T) {
+	t.Run("ranges", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    string
+			expected string
+		}{
+			{name: "descending", input: `loop i = 5 to 0 { print(i) }`, expected: "5\n4\n3\n2\n1\n"},
+			{name: "inclusive", input: `loop i = 0 through 4 step 2 { print(i) }`, expected: "0\n2\n4\n"},
+			{name: "exclusive step", input: `loop i = 0 to 4 step 2 { print(i) }`, expected: "0\n2\n"},
+			{name: "empty", input: `loop i = 3 to 3 { print(i) }`, expected: ""},
+			{name: "single", input: `loop i = 3 through 3 { print(i) }`, expected: "3\n"},
+			{name: "negative", input: `int lo = 0 - 2
+			loop i = lo through 2 step 2 { print(i) }`, expected: "-2\n0\n2\n"},
+			{name: "unsigned descending", input: `uint hi = 10
+			loop i = hi to 0 step 3 { print(i) }
+			loop i = hi through 0 step 5 { print(i) }`, expected: "10\n7\n4\n1\n10\n5\n0\n"},
+			{name: "float", input: `loop x = 0 to 1.0 step 0.25 { print(x) }`, expected: "0\n0.25\n0.5\n0.75\n"},
+			{name: "typed", input: `int8 total
+			int8 lo = 1
+			loop i = lo through 3 { total += i }
+			print(total)`, expected: "6\n"},
+			{name: "index", input: `loop n, i = 10 to 0 step 5 { print(string(n) + " " + string(i)) }`, expected: "0 10\n1 5\n"},
+			{name: "change iterator", input: `loop i = 0 to 10 {
+				print(i)
+				i += 4
+			}`, expected: "0\n5\n"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+				err := walkTree(t, test.input, simInterpreter)
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, buf.String())
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			err   error
+		}{
+			{name: "zero step", input: `loop i = 0 to 4 step 0 {}`, err: interpreter.InvalidStepErr{Context: interpreter.NewParseContext(1, 21), Step: "0"}},
+			{name: "negative step", input: `int s = 0 - 1
+			loop i = 4 to 0 step s {}`, err: interpreter.InvalidStepErr{Context: interpreter.NewParseContext(2, 24), Step: "-1"}},
+			{name: "mismatched bounds", input: `int a
+			float b = 2.0
+			loop i = a to b {}`, err: interpreter.MismatchedTypeAssignErr{Context: interpreter.NewParseContext(3, 17), Var: interpreter.NewVariable("i", interpreter.NewValue("int", "2.0"))}},
+			{name: "not a number", input: `loop i = "a" to "b" {}`, err: interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(1, 5), TypeNames: []string{"string"}}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				simInterpreter := interpreter.NewSimInterpreter(nil)
+
+				err := walkTree(t, test.input, simInterpreter)
+				assert.EqualError(t, err, test.err.Error())
+			})
+		}
+	})
+
 	input := `loop a = 0 to 5
 	{
 		if a % 2 == 1 {
@@ -141,202 +345,2251 @@ func TestLoopStatement(t *testing.T) {
 	assert.Empty(t, vars)
 }
 
-func TestVisitDeclarationStatement(t *testing.T) {
-	input := `int a = 10
-	int b = a`
-
-	simInterpreter := interpreter.NewSimInterpreter(nil)
-
-	err := walkTree(t, input, simInterpreter)
-	assert.NoError(t, err)
-
-	expectedVars := map[string]interpreter.Variable{
-		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "10")),
-		"b": interpreter.NewVariable("b", interpreter.NewValue("int", "10")),
-	}
+func TestForEachStatement(t *testing.T) {
+	t.Run("collections", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    string
+			expected string
+		}{
+			{name: "array", input: `int[3] xs = [1, 2, 3]
+			loop x in xs { print(x) }`, expected: "1\n2\n3\n"},
+			{name: "list with index", input: `string[] xs = ["a", "b"]
+			loop i, x in xs { print(string(i) + x) }`, expected: "0a\n1b\n"},
+			{name: "literal", input: `loop x in [1, 2.5] { print(x) }`, expected: "1\n2.5\n"},
+			{name: "map", input: `map[string]int m = {"b": 2, "a": 1}
+			loop k, v in m { print(k + string(v)) }
+			loop k in m { print(k) }`, expected: "b2\na1\nb\na\n"},
+			{name: "string", input: `loop i, r in "hé!" { print(string(i) + r) }`, expected: "0h\n1é\n2!\n"},
+			{name: "empty", input: `int[] xs
+			loop x in xs { print(x) }`, expected: ""},
+			{name: "snapshot", input: `int[] xs = [1, 2, 3]
+			loop x in xs {
+				xs[2] = 0
+				print(x)
+			}
+			print(xs)`, expected: "1\n2\n3\n[1, 2, 0]\n"},
+			{name: "fresh variables", input: `map[int]fn():int fs = {}
+			loop i, x in [10, 20] {
+				fs[i] = fn(): int { return x }
+			}
+			print(fs[0]())
+			print(fs[1]())`, expected: "10\n20\n"},
+			{name: "control flow", input: `function first(int[] xs, int min): int {
+				loop x in xs {
+					if x >= min return x
+				}
 
-	vars := simInterpreter.GetAllVars()
+				return 0
+			}
 
-	assert.Equal(t, expectedVars, vars)
-}
+			print(first([1, 5, 9], 4))
 
-func TestVisitAssignmentStatement(t *testing.T) {
-	input := `int a = 10
-	a = 20
-	a += 10
-	a -= 5
-	a *= 5
-	a /= 25`
+			outer: loop a in "ab" {
+				loop b in "xyz" {
+					if b == "y" continue outer
+					if a == "b" break outer
+					print(a + b)
+				}
+			}`, expected: "5\nax\n"},
+		}
 
-	simInterpreter := interpreter.NewSimInterpreter(nil)
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				simInterpreter := interpreter.NewSimInterpreter(&buf)
 
-	err := walkTree(t, input, simInterpreter)
-	assert.NoError(t, err)
+				err := walkTree(t, test.input, simInterpreter)
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, buf.String())
+			})
+		}
+	})
 
-	expectedVars := map[string]interpreter.Variable{
-		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "5")),
-	}
+	t.Run("not a collection", func(t *testing.T) {
+		input := `loop x in 5 {}`
 
-	vars := simInterpreter.GetAllVars()
+		simInterpreter := interpreter.NewSimInterpreter(nil)
 
-	assert.Equal(t, expectedVars, vars)
-}
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(1, 10), TypeNames: []string{"int"}}.Error())
+	})
 
-func TestVisitPrintStatement(t *testing.T) {
-	input := `print(10)
-	
-	int a = 20
-	print(a)`
+	t.Run("empty literal", func(t *testing.T) {
+		input := `loop x in [] {}`
 
-	var buf bytes.Buffer
-	simInterpreter := interpreter.NewSimInterpreter(&buf)
+		simInterpreter := interpreter.NewSimInterpreter(nil)
 
-	err := walkTree(t, input, simInterpreter)
-	assert.NoError(t, err)
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UninferableTypeErr{Context: interpreter.NewParseContext(1, 10), VarName: "x", Literal: "array"}.Error())
+	})
 
-	expectedVars := map[string]interpreter.Variable{
-		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "20")),
-	}
+	t.Run("duplicate names", func(t *testing.T) {
+		input := `loop x, x in [1] {}`
 
-	vars := simInterpreter.GetAllVars()
+		simInterpreter := interpreter.NewSimInterpreter(nil)
 
-	assert.Equal(t, expectedVars, vars)
-	assert.Equal(t, "10\n20\n", buf.String())
-}
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.VarExistsErr{Context: interpreter.NewParseContext(1, 8), VarName: "x"}.Error())
+	})
 
-func TestVisitParensExpression(t *testing.T) {
-	input := `int a = (10 * 20)`
+	input := `int total
+	loop i, x in [1, 2, 3] {
+		total += i * x
+	}`
 
 	simInterpreter := interpreter.NewSimInterpreter(nil)
 
 	err := walkTree(t, input, simInterpreter)
 	assert.NoError(t, err)
 
+	// The loop's variables are gone once the loop is done
 	expectedVars := map[string]interpreter.Variable{
-		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "200")),
+		"total": interpreter.NewVariable("total", interpreter.NewValue("int", "8")),
 	}
 
-	vars := simInterpreter.GetAllVars()
-
-	assert.Equal(t, expectedVars, vars)
+	assert.Equal(t, expectedVars, simInterpreter.GetAllVars())
 }
 
-func TestVisitNegateExpression(t *testing.T) {
-	input := `int a = 10
-	int b = -a`
-
-	simInterpreter := interpreter.NewSimInterpreter(nil)
+func TestVisitBreakStatement(t *testing.T) {
+	t.Run("unknown label", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			err   error
+		}{
+			{name: "undeclared", input: `loop 2 {
+				break outer
+			}`, err: interpreter.UnknownLabelErr{Context: interpreter.NewParseContext(2, 10), Label: "outer"}},
+			{name: "not enclosing", input: `outer: loop 2 {
+			}
+			loop 2 {
+				continue outer
+			}`, err: interpreter.UnknownLabelErr{Context: interpreter.NewParseContext(4, 13), Label: "outer"}},
+			{name: "outside the function", input: `outer: loop 2 {
+				function f(): int {
+					loop 2 {
+						break outer
+					}
+
+					return 0
+				}
 
-	err := walkTree(t, input, simInterpreter)
-	assert.NoError(t, err)
+				int a = f()
+			}`, err: interpreter.UnknownLabelErr{Context: interpreter.NewParseContext(4, 12), Label: "outer"}},
+			{name: "never runs", input: `print("ran")
+			loop 2 {
+				if false break outer
+			}`, err: interpreter.UnknownLabelErr{Context: interpreter.NewParseContext(3, 19), Label: "outer"}},
+			{name: "function never called", input: `print("ran")
+			function f(): int {
+				continue outer
+			}`, err: interpreter.UnknownLabelErr{Context: interpreter.NewParseContext(3, 13), Label: "outer"}},
+			{name: "outside a loop", input: `print("ran")
+			break outer`, err: interpreter.UnknownLabelErr{Context: interpreter.NewParseContext(2, 9), Label: "outer"}},
+		}
 
-	expectedVars := map[string]interpreter.Variable{
-		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "10")),
-		"b": interpreter.NewVariable("b", interpreter.NewValue("int", "-10")),
-	}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				simInterpreter := interpreter.NewSimInterpreter(&buf)
 
-	vars := simInterpreter.GetAllVars()
+				err := walkTree(t, test.input, simInterpreter)
+				assert.EqualError(t, err, test.err.Error())
+				assert.Empty(t, buf.String())
+			})
+		}
+	})
 
-	assert.Equal(t, expectedVars, vars)
-}
+	t.Run("duplicate label", func(t *testing.T) {
+		input := `print("ran")
+		a: loop {
+			a: loop x in [1] {
+				break a
+			}
+		}`
 
-func TestVisitNotExpression(t *testing.T) {
-	input := `bool a = false
-	bool b = not a`
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
 
-	simInterpreter := interpreter.NewSimInterpreter(nil)
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.DuplicateLabelErr{Context: interpreter.NewParseContext(3, 3), Label: "a"}.Error())
+		assert.Empty(t, buf.String())
+	})
 
-	err := walkTree(t, input, simInterpreter)
-	assert.NoError(t, err)
+	t.Run("label reused", func(t *testing.T) {
+		// Loops that don't enclose each other, or are in different functions, may use the same label
+		input := `function f(): int {
+			a: loop 1 { break a }
+			return 1
+		}
 
-	expectedVars := map[string]interpreter.Variable{
-		"a": interpreter.NewVariable("a", interpreter.NewValue("bool", "false")),
-		"b": interpreter.NewVariable("b", interpreter.NewValue("bool", "true")),
-	}
+		a: loop 2 {
+			int n = f()
+			b: loop 1 { continue a }
+			b: loop 1 { break b }
+		}`
 
-	vars := simInterpreter.GetAllVars()
+		simInterpreter := interpreter.NewSimInterpreter(nil)
 
-	assert.Equal(t, expectedVars, vars)
-}
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+	})
 
-func TestVisitMulDivExpression(t *testing.T) {
-	input := `int a = 10 * 20
-	a = a / 5`
+	t.Run("nested loops", func(t *testing.T) {
+		// An unlabeled break or continue only affects the innermost loop
+		input := `loop i = 0 to 2 {
+			loop {
+				break
+			}
 
-	simInterpreter := interpreter.NewSimInterpreter(nil)
+			int n
+			loop n < 3 {
+				n += 1
+				continue
+			}
 
-	err := walkTree(t, input, simInterpreter)
-	assert.NoError(t, err)
+			print(i)
+		}`
 
-	expectedVars := map[string]interpreter.Variable{
-		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "40")),
-	}
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
 
-	vars := simInterpreter.GetAllVars()
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "0\n1\n", buf.String())
+	})
 
-	assert.Equal(t, expectedVars, vars)
-}
+	t.Run("label on the next line", func(t *testing.T) {
+		input := `int a
+		loop 3 {
+			a += 1
+			break
+			a = 10
+		}`
 
-func TestVisitAddSubExpression(t *testing.T) {
-	input := `int a = 10 + 20
-	a = a - 5`
+		simInterpreter := interpreter.NewSimInterpreter(nil)
 
-	simInterpreter := interpreter.NewSimInterpreter(nil)
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interpreter.Variable{"a": interpreter.NewVariable("a", interpreter.NewValue("int", "1"))}, simInterpreter.GetAllVars())
+	})
 
-	err := walkTree(t, input, simInterpreter)
-	assert.NoError(t, err)
+	input := `function locate(int target): string {
+		search: loop i = 0 to 3 {
+			loop j = 0 to 3 {
+				switch i * j {
+				case target:
+					return string(i) + "," + string(j)
+				case 2:
+					continue search
+				}
+			}
+		}
 
-	expectedVars := map[string]interpreter.Variable{
-		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "25")),
+		return "none"
 	}
 
-	vars := simInterpreter.GetAllVars()
+	print(locate(1))
+	print(locate(4))
+	print(locate(2))
+
+	outer: loop i = 0 to 3 {
+		inner: loop {
+			loop j = 0 to 3 {
+				if i == 1 and j == 1 continue outer
+				if i == 2 break outer
+				if j == 2 break inner
+				print(string(i) + "," + string(j))
+			}
+		}
 
-	assert.Equal(t, expectedVars, vars)
+		print("end " + string(i))
+	}`
+
+	var buf bytes.Buffer
+	simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+	assert.Equal(t, "1,1\nnone\n1,2\n0,0\n0,1\nend 0\n1,0\n", buf.String())
 }
 
-func TestVisitInequalityExpression(t *testing.T) {
-	t.Run("mismatched types", func(t *testing.T) {
-		input := `int a = 10
-		float b = 20
-		
-		bool c = a > b`
+func TestVisitFunctionStatement(t *testing.T) {
+	t.Run("unknown parameter type", func(t *testing.T) {
+		input := `function f(unknown a) : int
+		{
+			return 0
+		}`
 
 		simInterpreter := interpreter.NewSimInterpreter(nil)
 
 		err := walkTree(t, input, simInterpreter)
-		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(4, 11), TypeNames: []string{"int", "float"}}.Error())
+		assert.EqualError(t, err, interpreter.UnknownTypeErr{Context: interpreter.NewParseContext(1, 0), TypeName: "unknown"}.Error())
+	})
 
-		expectedVars := map[string]interpreter.Variable{
-			"a": interpreter.NewVariable("a", interpreter.NewValue("int", "10")),
-			"b": interpreter.NewVariable("b", interpreter.NewValue("float", "20")),
+	t.Run("function exists", func(t *testing.T) {
+		input := `function f() : int
+		{
+			return 0
 		}
 
-		vars := simInterpreter.GetAllVars()
+		function f() : bool
+		{
+			return true
+		}`
 
-		assert.Equal(t, expectedVars, vars)
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.FunctionExistsErr{Context: interpreter.NewParseContext(6, 2), FuncName: "f"}.Error())
 	})
 
-	input := `int a = 10
-	int b = 20
-	int c = 10
-	
-	bool d = a > b
-	bool e = a < b
-	bool f = a >= b
-	bool g = a <= b
+	t.Run("mismatched argument type", func(t *testing.T) {
+		input := `function f(int a) : int
+		{
+			return a
+		}`
 
-	bool h = a > c
-	bool i = a < c
-	bool j = a >= c
-	bool k = a <= c`
+		simInterpreter := interpreter.NewSimInterpreter(nil)
 
-	simInterpreter := interpreter.NewSimInterpreter(nil)
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
 
-	err := walkTree(t, input, simInterpreter)
-	assert.NoError(t, err)
+		value, err := callTestFunction(t, simInterpreter, "f", interpreter.NewValue("bool", "true"))
+		expectedErr := interpreter.MismatchedArgTypeErr{FuncName: "f", ParamName: "a", TypeName: "int", ValueTypeName: "bool"}
+		assert.EqualError(t, err, expectedErr.Error())
+		assert.Equal(t, interpreter.NewErrorValue(expectedErr), value)
+	})
 
-	expectedVars := map[string]interpreter.Variable{
+	t.Run("mismatched return type", func(t *testing.T) {
+		input := `function f() : int
+		{
+			return true
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		_, err = callTestFunction(t, simInterpreter, "f")
+		assert.EqualError(t, err, interpreter.MismatchedReturnTypeErr{Context: interpreter.NewParseContext(3, 10), FuncName: "f", TypeName: "int", ValueTypeName: "bool"}.Error())
+	})
+
+	t.Run("missing return", func(t *testing.T) {
+		input := `function f() : int
+		{
+			int a = 10
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		_, err = callTestFunction(t, simInterpreter, "f")
+		assert.EqualError(t, err, interpreter.MissingReturnErr{FuncName: "f", TypeName: "int"}.Error())
+	})
+
+	t.Run("missing return value", func(t *testing.T) {
+		input := `function f() : int
+		{
+			return
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		_, err = callTestFunction(t, simInterpreter, "f")
+		assert.EqualError(t, err, interpreter.MissingReturnErr{Context: interpreter.NewParseContext(3, 3), FuncName: "f", TypeName: "int"}.Error())
+	})
+
+	t.Run("untyped return value", func(t *testing.T) {
+		input := `function f() : float
+		{
+			return 1
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		value, err := callTestFunction(t, simInterpreter, "f")
+		assert.NoError(t, err)
+		assert.Equal(t, interpreter.NewValue("float", "1"), value)
+	})
+
+	input := `int total = 5
+
+	function add(int a, int b) : int
+	{
+		int sum = a + b + total
+		total = sum
+
+		loop
+		{
+			return sum
+		}
+	}`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	value, err := callTestFunction(t, simInterpreter, "add", interpreter.NewValue("int", "1"), interpreter.NewValue("untyped int", "2"))
+	assert.NoError(t, err)
+	assert.Equal(t, interpreter.NewValue("int", "8"), value)
+
+	// The parameters and locals of the call don't leak into the caller
+	expectedVars := map[string]interpreter.Variable{
+		"total": interpreter.NewVariable("total", interpreter.NewValue("int", "8")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitReturnStatement(t *testing.T) {
+	t.Run("return from top level", func(t *testing.T) {
+		input := `int a = 10
+		return a
+		a = 20`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		result := visitTree(t, input, simInterpreter)
+		assert.Equal(t, interpreter.NewValue("int", "10"), result)
+		assert.Equal(t, interpreter.NewVariable("a", interpreter.NewValue("int", "10")), simInterpreter.GetAllVars()["a"])
+	})
+
+	t.Run("return from top level without value", func(t *testing.T) {
+		input := `return`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		result := visitTree(t, input, simInterpreter)
+		assert.Nil(t, result)
+	})
+
+	t.Run("return from nested loops", func(t *testing.T) {
+		input := `function search(int target) : int
+		{
+			int n = 0
+
+			loop
+			{
+				loop i = 0 to 10
+				{
+					loop 3
+					{
+						if n == target
+						{
+							return n * 2
+						}
+					}
+
+					n += 1
+				}
+
+				return 0
+			}
+		}
+
+		int a = search(4)
+		int b = search(20)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		expectedVars := map[string]interpreter.Variable{
+			"a": interpreter.NewVariable("a", interpreter.NewValue("int", "8")),
+			"b": interpreter.NewVariable("b", interpreter.NewValue("int", "0")),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+}
+
+func TestCallMain(t *testing.T) {
+	t.Run("missing main", func(t *testing.T) {
+		input := `int a = 10`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		_, err = NewSimVisitor(simInterpreter).CallMain()
+		assert.EqualError(t, err, interpreter.MissingMainErr{}.Error())
+	})
+
+	t.Run("invalid main", func(t *testing.T) {
+		input := `function main(int a) : bool
+		{
+			return true
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		function, err := simInterpreter.GetFunction(interpreter.NewParseContext(0, 0), "main")
+		assert.NoError(t, err)
+
+		_, err = NewSimVisitor(simInterpreter).CallMain()
+		assert.EqualError(t, err, interpreter.InvalidMainErr{Function: function}.Error())
+		assert.EqualError(t, err, "function main must be declared as main() : int, not main(int) : bool")
+	})
+
+	t.Run("missing return", func(t *testing.T) {
+		input := `function main() : int
+		{
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		_, err = NewSimVisitor(simInterpreter).CallMain()
+		assert.EqualError(t, err, interpreter.MissingReturnErr{Context: interpreter.NewParseContext(2, 2), FuncName: "main", TypeName: "int"}.Error())
+	})
+
+	input := `int calls = 0
+
+	function main() : int
+	{
+		calls += 1
+		print(calls)
+		return 3
+	}`
+
+	var buf bytes.Buffer
+	simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	exitCode, err := NewSimVisitor(simInterpreter).CallMain()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), exitCode)
+	assert.Equal(t, "1\n", buf.String())
+}
+
+func TestVisitSwitchStatement(t *testing.T) {
+	t.Run("duplicate label", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			err   error
+		}{
+			{name: "same case", input: `switch 1 {
+			case 1, 2, 1:
+				print("ran")
+			}`, err: interpreter.DuplicateCaseErr{Context: interpreter.NewParseContext(2, 14), CaseName: "1"}},
+			{name: "different cases", input: `int n = 1
+			switch n {
+			case 1:
+				print("ran")
+			case 0 + 1:
+			}`, err: interpreter.DuplicateCaseErr{Context: interpreter.NewParseContext(5, 8), CaseName: "0+1"}},
+			{name: "constants", input: `const int N = 3
+			switch 3 {
+			case 3:
+				print("ran")
+			case N:
+			}`, err: interpreter.DuplicateCaseErr{Context: interpreter.NewParseContext(5, 8), CaseName: "N"}},
+			{name: "default", input: `switch 1 {
+			default:
+				print("ran")
+			default:
+			}`, err: interpreter.DuplicateCaseErr{Context: interpreter.NewParseContext(4, 3), CaseName: "default"}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+				err := walkTree(t, test.input, simInterpreter)
+				assert.EqualError(t, err, test.err.Error())
+				assert.Empty(t, buf.String())
+			})
+		}
+	})
+
+	t.Run("missing members", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			err   error
+		}{
+			{name: "enum", input: `enum Color { Red, Green, Blue }
+			Color c = Color.Red
+			switch c {
+			case Color.Red:
+				print("ran")
+			}`, err: interpreter.MissingMembersErr{Context: interpreter.NewParseContext(3, 10), TypeName: "Color", MemberNames: []string{"Green", "Blue"}}},
+			{name: "union", input: `type Shape = Circle(float r) | Empty
+			Shape s = Empty
+			switch s {
+			case Empty:
+				print("ran")
+			}`, err: interpreter.MissingMembersErr{Context: interpreter.NewParseContext(3, 10), TypeName: "Shape", MemberNames: []string{"Circle"}}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+				err := walkTree(t, test.input, simInterpreter)
+				assert.EqualError(t, err, test.err.Error())
+				assert.Empty(t, buf.String())
+			})
+		}
+	})
+
+	t.Run("mismatched label type", func(t *testing.T) {
+		input := `switch 1 {
+		case "one":
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.Error(t, err)
+	})
+
+	t.Run("control flow", func(t *testing.T) {
+		input := `function name(int n): string {
+			switch n {
+			case 1:
+				return "one"
+			case 2, 3:
+				return "few"
+			}
+
+			return "many"
+		}
+
+		print(name(1))
+		print(name(3))
+		print(name(9))
+
+		// Cases don't fall through, so break leaves the enclosing loop
+		loop i = 0 to 5 {
+			switch i {
+			case 1:
+				continue
+			case 3:
+				break
+			}
+
+			print(i)
+		}`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "one\nfew\nmany\n0\n2\n", buf.String())
+	})
+
+	input := `enum Color { Red, Green, Blue }
+
+	int calls
+	function next(): int {
+		calls += 1
+		return calls
+	}
+
+	string a
+	switch next() {
+	case 0:
+		a = "zero"
+	case 2, 1:
+		string b = "one"
+		a = b
+	default:
+		a = "other"
+	}
+
+	float f = 2.5
+	string b = "none"
+	switch f {
+	case 1, 2:
+		b = "whole"
+	case 2.5:
+		b = "half"
+	}
+
+	Color c = Color.Blue
+	string d
+	switch c {
+	case Color.Red:
+		d = "red"
+	default:
+		d = "not red"
+	}
+
+	Color g = Color.Green
+	string h
+	switch g {
+	case Color.Red, Color.Blue:
+		h = "red or blue"
+	case Color.Green:
+		h = "green"
+	}
+
+	string e = "unchanged"
+	switch "x" {
+	case "y":
+		e = "changed"
+	}`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"calls": interpreter.NewVariable("calls", interpreter.NewValue("int", "1")),
+		"a":     interpreter.NewVariable("a", interpreter.NewValue("string", "\"one\"")),
+		"f":     interpreter.NewVariable("f", interpreter.NewValue("float", "2.5")),
+		"b":     interpreter.NewVariable("b", interpreter.NewValue("string", "\"half\"")),
+		"c":     interpreter.NewVariable("c", interpreter.NewValue("Color", "Blue")),
+		"d":     interpreter.NewVariable("d", interpreter.NewValue("string", "\"not red\"")),
+		"e":     interpreter.NewVariable("e", interpreter.NewValue("string", "\"unchanged\"")),
+		"g":     interpreter.NewVariable("g", interpreter.NewValue("Color", "Green")),
+		"h":     interpreter.NewVariable("h", interpreter.NewValue("string", "\"green\"")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitDeclarationStatement(t *testing.T) {
+	t.Run("mismatched type", func(t *testing.T) {
+		input := `int a = true`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedTypeAssignErr{Context: interpreter.NewParseContext(1, 8), Var: interpreter.NewVariable("a", interpreter.NewValue("int", "true"))}.Error())
+	})
+
+	input := `int a = 10
+	int b = a`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "10")),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("int", "10")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitInferredDeclarationStatement(t *testing.T) {
+	t.Run("empty literal", func(t *testing.T) {
+		tests := []struct {
+			input string
+			err   error
+		}{
+			{input: `var a = []`, err: interpreter.UninferableTypeErr{Context: interpreter.NewParseContext(1, 8), VarName: "a", Literal: "array"}},
+			{input: `a := {}`, err: interpreter.UninferableTypeErr{Context: interpreter.NewParseContext(1, 5), VarName: "a", Literal: "map"}},
+			{input: `a := [[], []]`, err: interpreter.UntypedArrayErr{Context: interpreter.NewParseContext(1, 5)}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.input, func(t *testing.T) {
+				simInterpreter := interpreter.NewSimInterpreter(nil)
+
+				err := walkTree(t, test.input, simInterpreter)
+				assert.EqualError(t, err, test.err.Error())
+			})
+		}
+	})
+
+	t.Run("var exists", func(t *testing.T) {
+		input := `a := 1
+		var a = 2`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.VarExistsErr{Context: interpreter.NewParseContext(2, 2), VarName: "a"}.Error())
+	})
+
+	t.Run("unknown function", func(t *testing.T) {
+		input := `a := f()`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownFunctionErr{Context: interpreter.NewParseContext(1, 5), FuncName: "f"}.Error())
+	})
+
+	input := `type vec struct { int x; int y }
+	function half(float f): float { return f / 2.0 }
+
+	var a = 1
+	b := 1.5
+	c := "hi"
+	d := a > 0
+	var e = half(3.0)
+	f := vec(1, 2)
+	g := [1, 2.5]
+	h := {"a": 1}
+	i := half
+	j := a
+	{
+		a := "shadowed"
+		j = 2
+	}`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	half, err := simInterpreter.GetFunction(interpreter.NewParseContext(0, 0), "half")
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "1")),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("float", "1.5")),
+		"c": interpreter.NewVariable("c", interpreter.NewValue("string", "\"hi\"")),
+		"d": interpreter.NewVariable("d", interpreter.NewValue("bool", "true")),
+		"e": interpreter.NewVariable("e", interpreter.NewValue("float", "1.5")),
+		"f": interpreter.NewVariable("f", interpreter.NewStructValue("vec", []interpreter.Value{interpreter.NewValue("int", "1"), interpreter.NewValue("int", "2")})),
+		"g": interpreter.NewVariable("g", interpreter.NewArrayValue("float[2]", []interpreter.Value{interpreter.NewValue("float", "1"), interpreter.NewValue("float", "2.5")})),
+		"h": interpreter.NewVariable("h", interpreter.NewMapValue("map[string]int", []interpreter.Value{interpreter.NewValue("string", "\"a\""), interpreter.NewValue("int", "1")})),
+		"i": interpreter.NewVariable("i", interpreter.NewFunctionValue("fn(float):float", half)),
+		"j": interpreter.NewVariable("j", interpreter.NewValue("int", "2")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitConstStatement(t *testing.T) {
+	t.Run("not constant", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			input   string
+			context interpreter.ParseContext
+		}{
+			{name: "variable", input: `int n = 4
+			const int N = n * 2`, context: interpreter.NewParseContext(2, 17)},
+			{name: "function call", input: `function four(): int { return 4 }
+			const int N = four()`, context: interpreter.NewParseContext(2, 17)},
+			{name: "struct", input: `type vec struct { int x }
+			const vec N = vec(1)`, context: interpreter.NewParseContext(2, 17)},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				simInterpreter := interpreter.NewSimInterpreter(nil)
+
+				err := walkTree(t, test.input, simInterpreter)
+				assert.EqualError(t, err, interpreter.NonConstantExprErr{Context: test.context, VarName: "N"}.Error())
+			})
+		}
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		input := `const int N = true`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedTypeAssignErr{Context: interpreter.NewParseContext(1, 14), Var: interpreter.NewVariable("N", interpreter.NewValue("int", "true"))}.Error())
+	})
+
+	t.Run("assign", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			input   string
+			context interpreter.ParseContext
+		}{
+			{name: "assign", input: `const int N = 10
+			N = 20`, context: interpreter.NewParseContext(2, 3)},
+			{name: "compound assign", input: `const int N = 10
+			N += 1`, context: interpreter.NewParseContext(2, 3)},
+			{name: "element", input: `const int[2] N = [1, 2]
+			N[0] = 3`, context: interpreter.NewParseContext(2, 3)},
+			{name: "in a function", input: `const int N = 10
+			function f(): int {
+				N = 1
+				return N
+			}
+			int n = f()`, context: interpreter.NewParseContext(3, 4)},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				simInterpreter := interpreter.NewSimInterpreter(nil)
+
+				err := walkTree(t, test.input, simInterpreter)
+				assert.EqualError(t, err, interpreter.ConstAssignErr{Context: test.context, VarName: "N", DeclContext: interpreter.NewParseContext(1, 0)}.Error())
+			})
+		}
+	})
+
+	t.Run("shadowed", func(t *testing.T) {
+		input := `const int N = 10
+		{
+			int N = 1
+			N = 2
+		}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+	})
+
+	input := `enum Color { Red, Green }
+	const float PI = 3.14159
+	const int N = 10 * 4
+	const int M = -(N + 2) % 5
+	const float TAU = PI * 2.0
+	const bool BIG = N > 20 and not false
+	const Color C = Color.Green
+	const string S = string(N) + "!"
+	const int[3] A = [1, N, 3]
+	int n = N + 1`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"PI":  interpreter.NewConstant("PI", interpreter.NewValue("float", "3.14159"), interpreter.NewParseContext(2, 1)),
+		"N":   interpreter.NewConstant("N", interpreter.NewValue("int", "40"), interpreter.NewParseContext(3, 1)),
+		"M":   interpreter.NewConstant("M", interpreter.NewValue("int", "-2"), interpreter.NewParseContext(4, 1)),
+		"TAU": interpreter.NewConstant("TAU", interpreter.NewValue("float", "6.28318"), interpreter.NewParseContext(5, 1)),
+		"BIG": interpreter.NewConstant("BIG", interpreter.NewValue("bool", "true"), interpreter.NewParseContext(6, 1)),
+		"C":   interpreter.NewConstant("C", interpreter.NewValue("Color", "Green"), interpreter.NewParseContext(7, 1)),
+		"S":   interpreter.NewConstant("S", interpreter.NewValue("string", "\"40!\""), interpreter.NewParseContext(8, 1)),
+		"A":   interpreter.NewConstant("A", interpreter.NewArrayValue("int[3]", []interpreter.Value{interpreter.NewValue("int", "1"), interpreter.NewValue("int", "40"), interpreter.NewValue("int", "3")}), interpreter.NewParseContext(9, 1)),
+		"n":   interpreter.NewVariable("n", interpreter.NewValue("int", "41")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitStructStatement(t *testing.T) {
+	t.Run("unknown field type", func(t *testing.T) {
+		input := `type Point struct { float x; vec y }`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownTypeErr{Context: interpreter.NewParseContext(1, 0), TypeName: "vec"}.Error())
+	})
+
+	t.Run("field exists", func(t *testing.T) {
+		input := `type Point struct { float x; float x }`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.FieldExistsErr{Context: interpreter.NewParseContext(1, 0), TypeName: "Point", FieldName: "x"}.Error())
+	})
+
+	t.Run("type exists", func(t *testing.T) {
+		input := `type int struct { float x }`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.TypeExistsErr{Context: interpreter.NewParseContext(1, 0), TypeName: "int"}.Error())
+	})
+
+	t.Run("function exists", func(t *testing.T) {
+		input := `type len struct { float x }`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.FunctionExistsErr{Context: interpreter.NewParseContext(1, 0), FuncName: "len"}.Error())
+	})
+
+	input := `type Point struct {
+		float x
+		float y
+	}
+
+	type Line struct { Point start; Point end; string name }
+
+	Point origin
+	Line line = Line(origin, Point(1, 2.5), "diagonal")
+	Line empty = Line()`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	origin := interpreter.NewStructValue("Point", []interpreter.Value{interpreter.NewValue("float", "0.0"), interpreter.NewValue("float", "0.0")})
+	end := interpreter.NewStructValue("Point", []interpreter.Value{interpreter.NewValue("float", "1"), interpreter.NewValue("float", "2.5")})
+
+	expectedVars := map[string]interpreter.Variable{
+		"origin": interpreter.NewVariable("origin", origin),
+		"line":   interpreter.NewVariable("line", interpreter.NewStructValue("Line", []interpreter.Value{origin, end, interpreter.NewValue("string", `"diagonal"`)})),
+		"empty":  interpreter.NewVariable("empty", interpreter.NewStructValue("Line", []interpreter.Value{origin, origin, interpreter.NewValue("string", `""`)})),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitEnumStatement(t *testing.T) {
+	t.Run("member exists", func(t *testing.T) {
+		input := `enum Color { Red, Green, Red }`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MemberExistsErr{Context: interpreter.NewParseContext(1, 0), TypeName: "Color", MemberName: "Red"}.Error())
+	})
+
+	t.Run("type exists", func(t *testing.T) {
+		input := `type Color struct { int r }
+		enum Color { Red }`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.TypeExistsErr{Context: interpreter.NewParseContext(2, 2), TypeName: "Color"}.Error())
+	})
+
+	t.Run("unknown member", func(t *testing.T) {
+		input := `enum Color { Red }
+		Color c = Color.Purple`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownMemberErr{Context: interpreter.NewParseContext(2, 18), TypeName: "Color", MemberName: "Purple"}.Error())
+	})
+
+	t.Run("assign to a member", func(t *testing.T) {
+		input := `enum Color { Red, Green }
+		Color.Red = Color.Green`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidAssignmentErr{Context: interpreter.NewParseContext(2, 2), Target: "Color.Red"}.Error())
+	})
+
+	t.Run("print", func(t *testing.T) {
+		input := `enum Color { Red, Green }
+		type Pixel struct { Color color; int x }
+		print(Color.Green)
+		print(Pixel(Color.Red, 1))
+		print(string(Color.Green) + "!")`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "Green\nPixel{color: Red, x: 1}\nGreen!\n", buf.String())
+	})
+
+	input := `enum Color {
+		Red,
+		Green,
+		Blue,
+	}
+
+	Color a
+	Color b = Color.Blue
+	b = Color.Green
+	bool c = a == Color.Red
+	bool d = a != b
+	map[Color]int e = {Color.Blue: 1}`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("Color", "Red")),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("Color", "Green")),
+		"c": interpreter.NewVariable("c", interpreter.NewValue("bool", "true")),
+		"d": interpreter.NewVariable("d", interpreter.NewValue("bool", "true")),
+		"e": interpreter.NewVariable("e", interpreter.NewMapValue("map[Color]int", []interpreter.Value{interpreter.NewValue("Color", "Blue"), interpreter.NewValue("int", "1")})),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitUnionStatement(t *testing.T) {
+	t.Run("variant exists", func(t *testing.T) {
+		input := `type Shape = Circle(float r) | Empty
+		type Solid = Sphere(float r) | Empty`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.VariantExistsErr{Context: interpreter.NewParseContext(2, 2), VariantName: "Empty"}.Error())
+	})
+
+	t.Run("mismatched arg count", func(t *testing.T) {
+		input := `type Shape = Rect(float w, float h) | Empty
+		Shape s = Rect(1)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedArgCountErr{Context: interpreter.NewParseContext(2, 12), FuncName: "Rect", Expected: 2, Actual: 1}.Error())
+	})
+
+	t.Run("print", func(t *testing.T) {
+		input := `type Tree = Leaf | Node(Tree left, int value, Tree right)
+		print(Node(Leaf, 1, Node(Leaf, 2, Leaf)))
+		print(Leaf)`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "Node{left: Leaf, value: 1, right: Node{left: Leaf, value: 2, right: Leaf}}\nLeaf\n", buf.String())
+	})
+
+	t.Run("no spaces", func(t *testing.T) {
+		input := `type Shape = Circle(float r)|Rect(float w, float h)|Empty
+		Shape s = Rect(1, 2)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		expectedVars := map[string]interpreter.Variable{
+			"s": interpreter.NewVariable("s", interpreter.NewUnionValue("Shape", "Rect", []interpreter.Value{interpreter.NewValue("float", "1"), interpreter.NewValue("float", "2")})),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+
+	input := `type Shape = Circle(float r) | Rect(float w, float h) | Empty
+
+	Shape a
+	Shape b = Rect(2, 3)
+	Shape c = Empty
+	bool d = b == Rect(2.0, 3.0)
+	bool e = a != Circle(0)`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewUnionValue("Shape", "Circle", []interpreter.Value{interpreter.NewValue("float", "0.0")})),
+		"b": interpreter.NewVariable("b", interpreter.NewUnionValue("Shape", "Rect", []interpreter.Value{interpreter.NewValue("float", "2"), interpreter.NewValue("float", "3")})),
+		"c": interpreter.NewVariable("c", interpreter.NewUnionValue("Shape", "Empty", nil)),
+		"d": interpreter.NewVariable("d", interpreter.NewValue("bool", "true")),
+		"e": interpreter.NewVariable("e", interpreter.NewValue("bool", "false")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitMatchStatement(t *testing.T) {
+	union := `type Shape = Circle(float r) | Rect(float w, float h) | Empty
+	`
+
+	errTests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{
+			name:  "not exhaustive",
+			input: union + `match Empty { Circle(r) => print(r) Empty => print(0) }`,
+			err:   interpreter.MissingMembersErr{Context: interpreter.NewParseContext(2, 7), TypeName: "Shape", MemberNames: []string{"Rect"}},
+		},
+		{
+			name:  "duplicate case",
+			input: union + `match Empty { Empty => print(0) _ => print(1) Empty => print(2) }`,
+			err:   interpreter.DuplicateCaseErr{Context: interpreter.NewParseContext(2, 47), CaseName: "Empty"},
+		},
+		{
+			name:  "unknown variant",
+			input: union + `match Empty { Square => print(0) _ => print(1) }`,
+			err:   interpreter.UnknownVariantErr{Context: interpreter.NewParseContext(2, 15), TypeName: "Shape", VariantName: "Square"},
+		},
+		{
+			name:  "mismatched binding count",
+			input: union + `match Empty { Rect(w) => print(w) _ => print(1) }`,
+			err:   interpreter.MismatchedBindingCountErr{Context: interpreter.NewParseContext(2, 15), CaseName: "Rect", Expected: 2, Actual: 1},
+		},
+		{
+			name: "not a union",
+			input: `bool b
+			match b { _ => print(1) }`,
+			err: interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(2, 9), TypeNames: []string{"bool"}},
+		},
+		{
+			name: "binding out of scope",
+			input: union + `match Circle(1) { Circle(r) => print(r) _ => print(0) }
+			print(r)`,
+			err: interpreter.UnknownVarErr{Context: interpreter.NewParseContext(3, 9), VarName: "r"},
+		},
+	}
+
+	for _, test := range errTests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+			err := walkTree(t, test.input, simInterpreter)
+			assert.EqualError(t, err, test.err.Error())
+		})
+	}
+
+	t.Run("unmatched cases are checked", func(t *testing.T) {
+		// Cases are checked before any of them run, so an error in a later case stops the whole match
+		input := union + `match Empty { Empty => print(0) Circle(r) => print(r) Rect(w, h) => print(w) Square => print(1) }`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.Error(t, err)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("enum", func(t *testing.T) {
+		input := `enum Color { Red, Green, Blue }
+		Color c = Color.Green
+		match c {
+			Red => print("red")
+			Green => print("green")
+			Blue => print("blue")
+		}
+		match c {
+			Red => print("red")
+			_ => print("other")
+		}`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "green\nother\n", buf.String())
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		input := `type Tree = Leaf | Node(Tree left, int value, Tree right)
+		function sum(Tree t) : int {
+			match t {
+				Node(left, value, right) => return sum(left) + value + sum(right)
+				Leaf => return 0
+			}
+			return -1
+		}
+		print(sum(Node(Node(Leaf, 1, Leaf), 2, Node(Leaf, 3, Leaf))))`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "6\n", buf.String())
+	})
+
+	input := union + `float area
+	Shape s = Rect(2, 3)
+	match s {
+		Circle(r) => area = r * r * 3.0
+		Rect(w, h) => {
+			float product = w * h
+			area = product
+		}
+		Empty => area = 0
+	}
+	float width
+	match s {
+		Rect(w, _) => width = w
+		_ => width = -1.0
+	}`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"area":  interpreter.NewVariable("area", interpreter.NewValue("float", "6")),
+		"s":     interpreter.NewVariable("s", interpreter.NewUnionValue("Shape", "Rect", []interpreter.Value{interpreter.NewValue("float", "2"), interpreter.NewValue("float", "3")})),
+		"width": interpreter.NewVariable("width", interpreter.NewValue("float", "2")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitAssignmentStatement(t *testing.T) {
+	t.Run("mismatched field type", func(t *testing.T) {
+		input := `type Point struct { float x; float y }
+		Point p
+		p.x = "one"`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedFieldTypeErr{Context: interpreter.NewParseContext(3, 2), TypeName: "Point", FieldName: "x", FieldTypeName: "float", ValueTypeName: "string"}.Error())
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		input := `int a = 10
+		(a + 1) = 5`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidAssignmentErr{Context: interpreter.NewParseContext(2, 2), Target: "a+1"}.Error())
+	})
+
+	t.Run("array element out of range", func(t *testing.T) {
+		input := `int[3] xs
+		xs[1 + 2] = 5`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.IndexOutOfRangeErr{Context: interpreter.NewParseContext(2, 5), Index: 3, Length: 3}.Error())
+	})
+
+	t.Run("mismatched element type", func(t *testing.T) {
+		input := `int[3] xs
+		xs[0] = true`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedElementTypeErr{Context: interpreter.NewParseContext(2, 5), TypeName: "int[3]", ElementTypeName: "int", ValueTypeName: "bool"}.Error())
+	})
+
+	t.Run("string element", func(t *testing.T) {
+		input := `string s = "abc"
+		s[0] = "d"`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(2, 4), TypeNames: []string{"string"}}.Error())
+	})
+
+	t.Run("array elements", func(t *testing.T) {
+		input := `type Grid struct { int[2][2] cells }
+		int[3] xs
+		int i = 1
+		xs[i] = 4
+		xs[i] += 1
+		xs[i + 1] = xs[i] * 2
+		xs = [xs[2], xs[1], xs[0]]
+		Grid grid
+		grid.cells[1][0] = 9`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		vars := simInterpreter.GetAllVars()
+
+		xs := interpreter.NewArrayValue("int[3]", []interpreter.Value{interpreter.NewValue("int", "10"), interpreter.NewValue("int", "5"), interpreter.NewValue("int", "0")})
+		assert.Equal(t, interpreter.NewVariable("xs", xs), vars["xs"])
+
+		zeroRow := interpreter.NewArrayValue("int[2]", []interpreter.Value{interpreter.NewValue("int", "0"), interpreter.NewValue("int", "0")})
+		row := interpreter.NewArrayValue("int[2]", []interpreter.Value{interpreter.NewValue("int", "9"), interpreter.NewValue("int", "0")})
+		cells := interpreter.NewArrayValue("int[2][2]", []interpreter.Value{zeroRow, row})
+		assert.Equal(t, interpreter.NewVariable("grid", interpreter.NewStructValue("Grid", []interpreter.Value{cells})), vars["grid"])
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		input := `type Point struct { float x; float y }
+		type Line struct { Point start; Point end }
+		Line line
+		line.end.x = 3
+		line.end.y += 1.5
+		(line.start).y = line.end.x * 2
+		Point end = line.end
+		end.x = 0`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		start := interpreter.NewStructValue("Point", []interpreter.Value{interpreter.NewValue("float", "0.0"), interpreter.NewValue("float", "6")})
+		end := interpreter.NewStructValue("Point", []interpreter.Value{interpreter.NewValue("float", "3"), interpreter.NewValue("float", "1.5")})
+
+		expectedVars := map[string]interpreter.Variable{
+			"line": interpreter.NewVariable("line", interpreter.NewStructValue("Line", []interpreter.Value{start, end})),
+			"end":  interpreter.NewVariable("end", interpreter.NewStructValue("Point", []interpreter.Value{interpreter.NewValue("float", "0"), interpreter.NewValue("float", "1.5")})),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+
+	input := `int a = 10
+	a = 20
+	a += 10
+	a -= 5
+	a *= 5
+	a /= 25`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "5")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitPrintStatement(t *testing.T) {
+	input := `print(10)
+	
+	int a = 20
+	print(a)`
+
+	var buf bytes.Buffer
+	simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "20")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+	assert.Equal(t, "10\n20\n", buf.String())
+
+	t.Run("structs", func(t *testing.T) {
+		input := `type Point struct { float x; float y }
+		type Label struct { string text; Point position }
+		print(Label("origin", Point()))`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "Label{text: \"origin\", position: Point{x: 0.0, y: 0.0}}\n", buf.String())
+	})
+}
+
+func TestVisitFieldExpression(t *testing.T) {
+	t.Run("unknown field", func(t *testing.T) {
+		input := `type Point struct { float x; float y }
+		Point p
+		float z = p.z`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownFieldErr{Context: interpreter.NewParseContext(3, 14), TypeName: "Point", FieldName: "z"}.Error())
+	})
+
+	t.Run("field of a non-struct", func(t *testing.T) {
+		input := `int a = 10
+		int b = a.x`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownFieldErr{Context: interpreter.NewParseContext(2, 12), TypeName: "int", FieldName: "x"}.Error())
+	})
+
+	input := `type Point struct { int x; int y }
+	type Line struct { Point start; Point end }
+	Line line = Line(Point(1, 2), Point(3, 4))
+	int a = line.end.x + Point(5, 6).y`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, interpreter.NewVariable("a", interpreter.NewValue("int", "9")), vars["a"])
+}
+
+func TestVisitArrayExpression(t *testing.T) {
+	t.Run("unknown element type", func(t *testing.T) {
+		input := `vec[3] xs`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownTypeErr{Context: interpreter.NewParseContext(1, 0), TypeName: "vec"}.Error())
+	})
+
+	t.Run("mismatched length", func(t *testing.T) {
+		input := `int[2] xs = [1, 2, 3]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedTypeAssignErr{Context: interpreter.NewParseContext(1, 12), Var: interpreter.NewVariable("xs", interpreter.NewValue("int[2]", "[1, 2, 3]"))}.Error())
+	})
+
+	t.Run("mismatched list type", func(t *testing.T) {
+		input := `int[] xs = [1, 2]
+		bool[] ys = xs`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, "line 2:14: cannot assign [1, 2] to ys of type bool[]")
+	})
+
+	t.Run("empty literal", func(t *testing.T) {
+		input := `int[0] xs = []
+		print(len(xs))
+		print([] == xs)
+		print(len([]))`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "0\ntrue\n0\n", buf.String())
+	})
+
+	input := `int[3] a
+	int8[3] b = [1, 2, 3]
+	float[2][2] c = [[1, 2.5], [3, 4]]
+	int[2][2] d = [[1, 2], [3, 4]]
+	bool e = d == [[1, 2], [3, 4]]
+	bool f = len(c) == 2
+
+	type Point struct { int[2] position }
+	Point p = Point([5, 6])
+	int[2] q = [7, 8]`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	ints := func(typeName string, data ...string) interpreter.Value {
+		elements := make([]interpreter.Value, len(data))
+		for i := range data {
+			elements[i] = interpreter.NewValue(typeName, data[i])
+		}
+
+		return interpreter.NewArrayValue(fmt.Sprintf("%s[%d]", typeName, len(data)), elements)
+	}
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", ints("int", "0", "0", "0")),
+		"b": interpreter.NewVariable("b", ints("int8", "1", "2", "3")),
+		"c": interpreter.NewVariable("c", interpreter.NewArrayValue("float[2][2]", []interpreter.Value{ints("float", "1", "2.5"), ints("float", "3", "4")})),
+		"d": interpreter.NewVariable("d", interpreter.NewArrayValue("int[2][2]", []interpreter.Value{ints("int", "1", "2"), ints("int", "3", "4")})),
+		"e": interpreter.NewVariable("e", interpreter.NewValue("bool", "true")),
+		"f": interpreter.NewVariable("f", interpreter.NewValue("bool", "true")),
+		"p": interpreter.NewVariable("p", interpreter.NewStructValue("Point", []interpreter.Value{ints("int", "5", "6")})),
+		"q": interpreter.NewVariable("q", ints("int", "7", "8")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitMapExpression(t *testing.T) {
+	t.Run("invalid key type", func(t *testing.T) {
+		input := `map[int[]]int m`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		input = `map[map[int]int]int n`
+
+		err = walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidKeyTypeErr{Context: interpreter.NewParseContext(1, 0), TypeName: "map[map[int]int]int", KeyTypeName: "map[int]int"}.Error())
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		input := `map[string]int m = {"a": "b"}`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, `line 1:19: cannot assign {"a": "b"} to m of type map[string]int`)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		input := `map[string]int m = {"a": 1}
+		int b = m["b"]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MissingKeyErr{Context: interpreter.NewParseContext(2, 12), TypeName: "map[string]int", Key: `"b"`}.Error())
+	})
+
+	t.Run("compound assignment to a missing key", func(t *testing.T) {
+		input := `map[string]int m
+		m["a"] += 1`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MissingKeyErr{Context: interpreter.NewParseContext(2, 4), TypeName: "map[string]int", Key: `"a"`}.Error())
+	})
+
+	t.Run("print", func(t *testing.T) {
+		input := `map[string]int[] m = {"b": [1, 2]}
+		m["a"] = []
+		print(m)
+		print(keys(m))
+		print({})`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "{\"b\": [1, 2], \"a\": []}\n[\"b\", \"a\"]\n{}\n", buf.String())
+	})
+
+	input := `map[string]int a = {"x": 1, "y": 2}
+	a["z"] = 3
+	a["x"] += 10
+	a = delete(a, "y")
+	bool b = has(a, "z")
+	bool c = has(a, "y")
+	int d = a["x"]
+	map[uint8]string e = {1: "one"}
+	e[2] = "two"
+	map[string]map[string]int f
+	f["outer"] = {"inner": 1}
+	f["outer"]["inner"] += 1
+	int g = len(f["outer"])`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	str := func(s string) interpreter.Value {
+		return interpreter.NewValue("string", `"`+s+`"`)
+	}
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewMapValue("map[string]int", []interpreter.Value{str("x"), interpreter.NewValue("int", "11"), str("z"), interpreter.NewValue("int", "3")})),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("bool", "true")),
+		"c": interpreter.NewVariable("c", interpreter.NewValue("bool", "false")),
+		"d": interpreter.NewVariable("d", interpreter.NewValue("int", "11")),
+		"e": interpreter.NewVariable("e", interpreter.NewMapValue("map[uint8]string", []interpreter.Value{interpreter.NewValue("uint8", "1"), str("one"), interpreter.NewValue("uint8", "2"), str("two")})),
+		"f": interpreter.NewVariable("f", interpreter.NewMapValue("map[string]map[string]int", []interpreter.Value{
+			str("outer"), interpreter.NewMapValue("map[string]int", []interpreter.Value{str("inner"), interpreter.NewValue("int", "2")}),
+		})),
+		"g": interpreter.NewVariable("g", interpreter.NewValue("int", "1")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitParensExpression(t *testing.T) {
+	input := `int a = (10 * 20)`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "200")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitIndexExpression(t *testing.T) {
+	t.Run("index out of range", func(t *testing.T) {
+		input := `string a = "héllo"
+		string b = a[5]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.IndexOutOfRangeErr{Context: interpreter.NewParseContext(2, 15), Index: 5, Length: 5}.Error())
+	})
+
+	t.Run("index a non-string", func(t *testing.T) {
+		input := `int a = 10
+		int b = a[0]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(2, 10), TypeNames: []string{"int"}}.Error())
+	})
+
+	t.Run("arrays", func(t *testing.T) {
+		input := `int[2][3] grid = [[1, 2, 3], [4, 5, 6]]
+		int a = grid[1][2]
+		int b = [7, 8][1]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, interpreter.NewVariable("a", interpreter.NewValue("int", "6")), vars["a"])
+		assert.Equal(t, interpreter.NewVariable("b", interpreter.NewValue("int", "8")), vars["b"])
+	})
+
+	t.Run("array index out of range", func(t *testing.T) {
+		input := `int[3] xs
+		int i = 3
+		int a = xs[i]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.IndexOutOfRangeErr{Context: interpreter.NewParseContext(3, 13), Index: 3, Length: 3}.Error())
+	})
+
+	input := `string a = "héllo"
+	string b = a[1] + a[len(a) - 1]`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("string", `"héllo"`)),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("string", `"éo"`)),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitSliceExpression(t *testing.T) {
+	t.Run("low after high", func(t *testing.T) {
+		input := `int[] xs = [1, 2, 3]
+		int[] ys = xs[2:1]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.IndexOutOfRangeErr{Context: interpreter.NewParseContext(2, 16), Index: 2, Length: 3}.Error())
+	})
+
+	t.Run("high out of range", func(t *testing.T) {
+		input := `int[3] xs
+		int[] ys = xs[1:4]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.IndexOutOfRangeErr{Context: interpreter.NewParseContext(2, 18), Index: 4, Length: 3}.Error())
+	})
+
+	t.Run("slice a non-sequence", func(t *testing.T) {
+		input := `int a = 10
+		int[] b = a[0:1]`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(2, 12), TypeNames: []string{"int"}}.Error())
+	})
+
+	input := `int[] xs = [1, 2, 3, 4]
+	int[] a = xs[1:3]
+	int[] b = xs[:2]
+	int[] c = xs[2:]
+	int[] d = xs[:]
+	d[0] = 10
+	xs = append(xs, 5)
+	xs = insert(xs, 0, 0)
+	xs = remove(xs, 1)
+	int e = len(xs)
+	int[4] arr = [5, 6, 7, 8]
+	int[] f = arr[1:1]
+	bool g = arr[2:] == [7, 8]
+	int[] i = [1, 2, 3][1:]
+	string[] h = split("a b", " ")`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	values := func(typeName string, data ...string) []interpreter.Value {
+		elements := make([]interpreter.Value, len(data))
+		for i := range data {
+			elements[i] = interpreter.NewValue(typeName, data[i])
+		}
+
+		return elements
+	}
+
+	list := func(typeName string, data ...string) interpreter.Value {
+		return interpreter.NewListValue(typeName+"[]", values(typeName, data...))
+	}
+
+	expectedVars := map[string]interpreter.Variable{
+		"xs":  interpreter.NewVariable("xs", list("int", "0", "2", "3", "4", "5")),
+		"a":   interpreter.NewVariable("a", list("int", "2", "3")),
+		"b":   interpreter.NewVariable("b", list("int", "1", "2")),
+		"c":   interpreter.NewVariable("c", list("int", "3", "4")),
+		"d":   interpreter.NewVariable("d", list("int", "10", "2", "3", "4")),
+		"e":   interpreter.NewVariable("e", interpreter.NewValue("int", "5")),
+		"arr": interpreter.NewVariable("arr", interpreter.NewArrayValue("int[4]", values("int", "5", "6", "7", "8"))),
+		"f":   interpreter.NewVariable("f", list("int")),
+		"g":   interpreter.NewVariable("g", interpreter.NewValue("bool", "true")),
+		"h":   interpreter.NewVariable("h", list("string", `"a"`, `"b"`)),
+		"i":   interpreter.NewVariable("i", list("int", "2", "3")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitNegateExpression(t *testing.T) {
+	input := `int a = 10
+	int b = -a
+	c := -5
+	float d = -(2.5)
+	int e = 2 - -1`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "10")),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("int", "-10")),
+		"c": interpreter.NewVariable("c", interpreter.NewValue("int", "-5")),
+		"d": interpreter.NewVariable("d", interpreter.NewValue("float", "-2.5")),
+		"e": interpreter.NewVariable("e", interpreter.NewValue("int", "3")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitNotExpression(t *testing.T) {
+	input := `bool a = false
+	bool b = not a`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("bool", "false")),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("bool", "true")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitMulDivExpression(t *testing.T) {
+	input := `int a = 10 * 20
+	a = a / 5`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "40")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitAddSubExpression(t *testing.T) {
+	input := `int a = 10 + 20
+	a = a - 5`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "25")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitBitwiseExpression(t *testing.T) {
+	t.Run("negative shift", func(t *testing.T) {
+		input := `int a = 1 << -1`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.NegativeShiftErr{Context: interpreter.NewParseContext(1, 13), Count: "-1"}.Error())
+	})
+
+	t.Run("not an integer", func(t *testing.T) {
+		input := `float a = 1.5
+		float b = a & 1.0`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownOperatorErr{Context: interpreter.NewParseContext(2, 12), Operator: "&"}.Error())
+	})
+
+	t.Run("no spaces", func(t *testing.T) {
+		input := `int a = 4
+		int b = 2
+		int c = a|b
+		int x = 8
+		x|=1`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		expectedVars := map[string]interpreter.Variable{
+			"a": interpreter.NewVariable("a", interpreter.NewValue("int", "4")),
+			"b": interpreter.NewVariable("b", interpreter.NewValue("int", "2")),
+			"c": interpreter.NewVariable("c", interpreter.NewValue("int", "6")),
+			"x": interpreter.NewVariable("x", interpreter.NewValue("int", "9")),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+
+	// Shifts bind looser than arithmetic, and bitwise operators bind tighter than comparisons
+	input := `int a = 1 + 2 << 3
+	bool b = 6 & 3 == 2
+	int c = 1 | 2 ^ 3 & 4
+	int d = ~5
+	int8 e = 1
+	e <<= 7
+	uint8 f = 255
+	f >>= 4
+	int g = 1
+	g <<= 4
+	g |= 3
+	g &= 7
+	g ^= 1
+	g >>= 1
+	const int FLAGS = 1 << 3 | 1`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a":     interpreter.NewVariable("a", interpreter.NewValue("int", "24")),
+		"b":     interpreter.NewVariable("b", interpreter.NewValue("bool", "true")),
+		"c":     interpreter.NewVariable("c", interpreter.NewValue("int", "3")),
+		"d":     interpreter.NewVariable("d", interpreter.NewValue("int", "-6")),
+		"e":     interpreter.NewVariable("e", interpreter.NewValue("int8", "-128")),
+		"f":     interpreter.NewVariable("f", interpreter.NewValue("uint8", "15")),
+		"g":     interpreter.NewVariable("g", interpreter.NewValue("int", "1")),
+		"FLAGS": interpreter.NewConstant("FLAGS", interpreter.NewValue("int", "9"), interpreter.NewParseContext(15, 1)),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitNumericWidths(t *testing.T) {
+	t.Run("literal too wide", func(t *testing.T) {
+		input := `int8 a = 128`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.Error(t, err)
+		assert.Empty(t, simInterpreter.GetAllVars())
+	})
+
+	t.Run("untyped operand too wide", func(t *testing.T) {
+		input := `byte a = 1
+		byte b = a + 256`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.DataTypeErr{Context: interpreter.NewParseContext(2, 15), TypeName: "byte"}.Error())
+	})
+
+	input := `int8 a = 127
+	a += 1
+	int16 b = -32768
+	b -= 1
+	int64 c = 9223372036854775807
+	int64 d = c / 2
+	uint64 e = 18446744073709551615
+	e += 1
+	byte f = 255
+	f += 2
+	uint g = 4000000000
+	float64 h = 0.1 + 0.2
+	float i = 1.0 / 3.0
+	float64 j = 1 + 0.123456789012
+	float k = 1 + 0.123456789012
+	x := 3000000000 - 2000000000`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("int8", "-128")),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("int16", "32767")),
+		"c": interpreter.NewVariable("c", interpreter.NewValue("int64", "9223372036854775807")),
+		"d": interpreter.NewVariable("d", interpreter.NewValue("int64", "4611686018427387903")),
+		"e": interpreter.NewVariable("e", interpreter.NewValue("uint64", "0")),
+		"f": interpreter.NewVariable("f", interpreter.NewValue("byte", "1")),
+		"g": interpreter.NewVariable("g", interpreter.NewValue("uint", "4000000000")),
+		"h": interpreter.NewVariable("h", interpreter.NewValue("float64", "0.30000000000000004")),
+		"i": interpreter.NewVariable("i", interpreter.NewValue("float", "0.33333334")),
+		"j": interpreter.NewVariable("j", interpreter.NewValue("float64", "1.123456789012")),
+		"k": interpreter.NewVariable("k", interpreter.NewValue("float", "1.1234568")),
+		"x": interpreter.NewVariable("x", interpreter.NewValue("int", "1000000000")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitInequalityExpression(t *testing.T) {
+	t.Run("mismatched types", func(t *testing.T) {
+		input := `int a = 10
+		float b = 20
+		
+		bool c = a > b`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(4, 11), TypeNames: []string{"int", "float"}}.Error())
+
+		expectedVars := map[string]interpreter.Variable{
+			"a": interpreter.NewVariable("a", interpreter.NewValue("int", "10")),
+			"b": interpreter.NewVariable("b", interpreter.NewValue("float", "20")),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+
+	t.Run("custom type without comparison", func(t *testing.T) {
+		input := `type Point struct { int x; int y }
+		bool a = Point(1, 2) < Point(3, 4)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(2, 11), TypeNames: []string{"Point", "Point"}}.Error())
+	})
+
+	t.Run("function named after the type", func(t *testing.T) {
+		input := `type Point struct { int x; int y }
+		function comparePoint(Point a, Point b) : int {
+			return a.x - b.x
+		}
+
+		bool a = Point(1, 2) < Point(3, 4)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(6, 11), TypeNames: []string{"Point", "Point"}}.Error())
+	})
+
+	t.Run("undeclared comparison", func(t *testing.T) {
+		input := `type Point struct { int x; int y } ordered by comparePoint
+		bool a = Point(1, 2) < Point(3, 4)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownFunctionErr{Context: interpreter.NewParseContext(2, 11), FuncName: "comparePoint"}.Error())
+	})
+
+	t.Run("comparison with the wrong signature", func(t *testing.T) {
+		input := `type Point struct { int x; int y } ordered by less
+		function less(Point a, Point b) : bool {
+			return a.x < b.x
+		}
+
+		bool a = Point(1, 2) < Point(3, 4)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidCompareFunctionErr{Context: interpreter.NewParseContext(6, 11), TypeName: "Point", FuncName: "less"}.Error())
+	})
+
+	t.Run("union with comparison", func(t *testing.T) {
+		input := `type Shape = Circle(float r) | Square(float side) ordered by byArea
+		function byArea(Shape a, Shape b) : int {
+			return int(area(a) - area(b))
+		}
+		function area(Shape s) : float {
+			match s {
+				Circle(r) => { return 3 * r * r }
+				Square(side) => { return side * side }
+			}
+			return 0
+		}
+
+		bool a = Circle(1.0) < Square(2.0)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, interpreter.NewVariable("a", interpreter.NewValue("bool", "true")), simInterpreter.GetAllVars()["a"])
+	})
+
+	t.Run("custom type with comparison", func(t *testing.T) {
+		input := `type Version struct { int major; int minor } ordered by compareVersion
+
+		function compareVersion(Version a, Version b) : int {
+			if a.major != b.major {
+				return a.major - b.major
+			}
+
+			return a.minor - b.minor
+		}
+
+		Version a = Version(1, 2)
+		Version b = Version(1, 10)
+		bool c = a < b
+		bool d = a >= b
+		bool e = b > Version(0, 20)
+		bool f = a <= Version(1, 2)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, interpreter.NewVariable("c", interpreter.NewValue("bool", "true")), vars["c"])
+		assert.Equal(t, interpreter.NewVariable("d", interpreter.NewValue("bool", "false")), vars["d"])
+		assert.Equal(t, interpreter.NewVariable("e", interpreter.NewValue("bool", "true")), vars["e"])
+		assert.Equal(t, interpreter.NewVariable("f", interpreter.NewValue("bool", "true")), vars["f"])
+	})
+
+	input := `int a = 10
+	int b = 20
+	int c = 10
+	
+	bool d = a > b
+	bool e = a < b
+	bool f = a >= b
+	bool g = a <= b
+
+	bool h = a > c
+	bool i = a < c
+	bool j = a >= c
+	bool k = a <= c`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
 		"a": interpreter.NewVariable("a", interpreter.NewValue("int", "10")),
 		"b": interpreter.NewVariable("b", interpreter.NewValue("int", "20")),
 		"c": interpreter.NewVariable("c", interpreter.NewValue("int", "10")),
@@ -396,7 +2649,29 @@ func TestVisitEqualityExpression(t *testing.T) {
 		}
 	}
 
-	// TODO: more types, custom types
+	t.Run("custom types", func(t *testing.T) {
+		input := `type Point struct { float x; float y }
+		type Line struct { Point start; Point end }
+		Line a = Line(Point(1, 2), Point(3, 4))
+		Line b = Line(Point(1, 2), Point(3, 4))
+		bool c = a == b
+		b.end.y = 5
+		bool d = a == b
+		bool e = a.start != b.start`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, interpreter.NewVariable("c", interpreter.NewValue("bool", "true")), vars["c"])
+		assert.Equal(t, interpreter.NewVariable("d", interpreter.NewValue("bool", "false")), vars["d"])
+		assert.Equal(t, interpreter.NewVariable("e", interpreter.NewValue("bool", "false")), vars["e"])
+	})
+
+	// TODO: more types
 }
 
 func TestVisitAndExpression(t *testing.T) {
@@ -464,7 +2739,449 @@ func TestVisitVariableExpression(t *testing.T) {
 	assert.Equal(t, expectedVars, vars)
 }
 
+func TestVisitCallExpression(t *testing.T) {
+	t.Run("unknown function", func(t *testing.T) {
+		input := `int a = f()`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownFunctionErr{Context: interpreter.NewParseContext(1, 8), FuncName: "f"}.Error())
+	})
+
+	t.Run("mismatched argument count", func(t *testing.T) {
+		input := `function f(int a, int b) : int
+		{
+			return a + b
+		}
+
+		int c = f(1)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedArgCountErr{Context: interpreter.NewParseContext(6, 10), FuncName: "f", Expected: 2, Actual: 1}.Error())
+	})
+
+	t.Run("mismatched argument type", func(t *testing.T) {
+		input := `function f(int a, int b) : int
+		{
+			return a + b
+		}
+
+		int c = f(1, true)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedArgTypeErr{Context: interpreter.NewParseContext(6, 15), FuncName: "f", ParamName: "b", TypeName: "int", ValueTypeName: "bool"}.Error())
+	})
+
+	t.Run("string builtins", func(t *testing.T) {
+		input := `string s = "  Hello, 世界  "
+		string t = trim(s)
+		string u = upper(t) + "!"
+		int a = len(t)
+		int b = find(t, "世界")
+		string c = substr(t, b, 2)
+		string d = replace(lower(t), "l", "L")
+		bool e = startsWith(t, "Hell")
+		t += "?"`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		expectedVars := map[string]interpreter.Variable{
+			"s": interpreter.NewVariable("s", interpreter.NewValue("string", `"  Hello, 世界  "`)),
+			"t": interpreter.NewVariable("t", interpreter.NewValue("string", `"Hello, 世界?"`)),
+			"u": interpreter.NewVariable("u", interpreter.NewValue("string", `"HELLO, 世界!"`)),
+			"a": interpreter.NewVariable("a", interpreter.NewValue("int", "9")),
+			"b": interpreter.NewVariable("b", interpreter.NewValue("int", "7")),
+			"c": interpreter.NewVariable("c", interpreter.NewValue("string", `"世界"`)),
+			"d": interpreter.NewVariable("d", interpreter.NewValue("string", `"heLLo, 世界"`)),
+			"e": interpreter.NewVariable("e", interpreter.NewValue("bool", "true")),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+		input := `int a = 7
+		float b = float(a) / 2
+		int8 c = int8(a * 40)
+		int64 d = int64(b)
+		string e = string(c) + string(true)
+		float64 f = float64(string(b))
+		bool g = bool(a)
+		int h = int(a > 10)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		expectedVars := map[string]interpreter.Variable{
+			"a": interpreter.NewVariable("a", interpreter.NewValue("int", "7")),
+			"b": interpreter.NewVariable("b", interpreter.NewValue("float", "3.5")),
+			"c": interpreter.NewVariable("c", interpreter.NewValue("int8", "24")),
+			"d": interpreter.NewVariable("d", interpreter.NewValue("int64", "3")),
+			"e": interpreter.NewVariable("e", interpreter.NewValue("string", `"24true"`)),
+			"f": interpreter.NewVariable("f", interpreter.NewValue("float64", "3.5")),
+			"g": interpreter.NewVariable("g", interpreter.NewValue("bool", "true")),
+			"h": interpreter.NewVariable("h", interpreter.NewValue("int", "0")),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+
+	t.Run("invalid conversion", func(t *testing.T) {
+		input := `enum Color { Red }
+		int b = int(Color.Red)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidConversionErr{Context: interpreter.NewParseContext(2, 14), FromTypeName: "Color", ToTypeName: "int"}.Error())
+	})
+
+	t.Run("literal above int64", func(t *testing.T) {
+		input := `uint64 a = uint64(18446744073709551615)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		expectedVars := map[string]interpreter.Variable{
+			"a": interpreter.NewVariable("a", interpreter.NewValue("uint64", "18446744073709551615")),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+
+	t.Run("conversion with multiple arguments", func(t *testing.T) {
+		input := `int a = int(1, 2)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedArgCountErr{Context: interpreter.NewParseContext(1, 8), FuncName: "int", Expected: 1, Actual: 2}.Error())
+	})
+
+	t.Run("struct literals", func(t *testing.T) {
+		input := `type Point struct { float x; float y }
+		Point p = Point(1, 2, 3)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedArgCountErr{Context: interpreter.NewParseContext(2, 12), FuncName: "Point", Expected: 2, Actual: 3}.Error())
+
+		input = `type Point struct { float x; float y }
+		Point p = Point(1, true)`
+
+		simInterpreter = interpreter.NewSimInterpreter(nil)
+
+		err = walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedFieldTypeErr{Context: interpreter.NewParseContext(2, 21), TypeName: "Point", FieldName: "y", FieldTypeName: "float", ValueTypeName: "bool"}.Error())
+
+		input = `type Point struct { float x; float y }
+		int p = Point(1, 2)`
+
+		simInterpreter = interpreter.NewSimInterpreter(nil)
+
+		err = walkTree(t, input, simInterpreter)
+		assert.Error(t, err)
+		assert.IsType(t, interpreter.MismatchedTypeAssignErr{}, err)
+	})
+
+	t.Run("recursion", func(t *testing.T) {
+		input := `function factorial(int n) : int
+		{
+			if n <= 1
+			{
+				return 1
+			}
+
+			return n * factorial(n - 1)
+		}
+
+		int a = factorial(5)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		expectedVars := map[string]interpreter.Variable{
+			"a": interpreter.NewVariable("a", interpreter.NewValue("int", "120")),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+	})
+
+	t.Run("runaway recursion", func(t *testing.T) {
+		input := `function f(int n) : int {
+			return f(n + 1)
+		}
+
+		int a = f(0)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.CallDepthErr{Context: interpreter.NewParseContext(2, 10), FuncName: "f", Depth: interpreter.MaxCallDepth}.Error())
+
+		// Every frame is popped on the way out, so the program can keep running
+		_, ok := simInterpreter.CurrentFunction()
+		assert.False(t, ok)
+	})
+
+	input := `function scale(float x, float factor) : float
+	{
+		return x * factor
+	}
+
+	float a = 2
+	float b = scale(a, 3) + scale(1.5, 2)`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	expectedVars := map[string]interpreter.Variable{
+		"a": interpreter.NewVariable("a", interpreter.NewValue("float", "2")),
+		"b": interpreter.NewVariable("b", interpreter.NewValue("float", "9")),
+	}
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, expectedVars, vars)
+}
+
+func TestVisitFunctionExpression(t *testing.T) {
+	t.Run("unknown param type", func(t *testing.T) {
+		input := `fn(vec):int f = fn(vec v) : int { return 0 }`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.UnknownTypeErr{Context: interpreter.NewParseContext(1, 0), TypeName: "vec"}.Error())
+	})
+
+	t.Run("mismatched function type", func(t *testing.T) {
+		input := `fn(int):int f = fn(int a, int b) : int { return a + b }`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.Error(t, err)
+	})
+
+	t.Run("builtin with any params", func(t *testing.T) {
+		input := `fn(string):int f = len`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidFunctionValueErr{Context: interpreter.NewParseContext(1, 19), FuncName: "len"}.Error())
+	})
+
+	t.Run("captured variables outlive their scope", func(t *testing.T) {
+		input := `function counter() : fn():int {
+			int count = 0
+			return fn() : int {
+				count += 1
+				return count
+			}
+		}
+
+		fn():int a = counter()
+		fn():int b = counter()
+		print(a())
+		print(a())
+		print(b())
+		print(counter()())`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "1\n2\n1\n1\n", buf.String())
+	})
+
+	t.Run("higher-order functions", func(t *testing.T) {
+		input := `function mapList(int[] xs, fn(int):int f) : int[] {
+			int[] result
+			loop i = 0 to len(xs) {
+				result = append(result, f(xs[i]))
+			}
+			return result
+		}
+
+		function double(int x) : int {
+			return x * 2
+		}
+
+		int offset = 10
+		print(mapList([1, 2, 3], double))
+		print(mapList([1, 2, 3], fn(int x) : int { return x + offset }))
+		map[string]fn(string):string transforms = {"upper": upper}
+		print(transforms["upper"]("abc"))`
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+		assert.Equal(t, "[2, 4, 6]\n[11, 12, 13]\nABC\n", buf.String())
+	})
+
+	t.Run("recursion", func(t *testing.T) {
+		input := `fn(int):int fact
+		fact = fn(int n) : int {
+			if n <= 1 {
+				return 1
+			}
+			return n * fact(n - 1)
+		}
+		int result = fact(5)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		variable, err := simInterpreter.GetVar(interpreter.NewParseContext(0, 0), "result")
+		assert.NoError(t, err)
+		assert.Equal(t, interpreter.NewValue("int", "120"), variable.Value())
+	})
+
+	input := `function add(int a, int b) : int {
+		return a + b
+	}
+
+	fn(int,int):int f
+	print(f)
+	f = add
+	print(f)
+	int a = f(1, 2)
+	f = fn(int x, int y) : int { return x * y }
+	int b = f(3, 4)`
+
+	var buf bytes.Buffer
+	simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+	assert.Equal(t, "nil\nadd\n", buf.String())
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, interpreter.NewValue("int", "3"), vars["a"].Value())
+	assert.Equal(t, interpreter.NewValue("int", "12"), vars["b"].Value())
+}
+
+func TestVisitInvokeExpression(t *testing.T) {
+	t.Run("nil function", func(t *testing.T) {
+		input := `fn():int f
+		int a = f()`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.NilFunctionErr{Context: interpreter.NewParseContext(2, 10)}.Error())
+	})
+
+	t.Run("not a function", func(t *testing.T) {
+		input := `int[] a = [1]
+		int b = a[0](1)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidOperationErr{Context: interpreter.NewParseContext(2, 10), TypeNames: []string{"int"}}.Error())
+	})
+
+	t.Run("mismatched arg count", func(t *testing.T) {
+		input := `fn(int):int f = fn(int x) : int { return x }
+		int a = f(1, 2)`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.MismatchedArgCountErr{Context: interpreter.NewParseContext(2, 10), FuncName: "fn", Expected: 1, Actual: 2}.Error())
+	})
+
+	input := `type Handler struct { fn(int):int handle }
+	Handler h = Handler(fn(int x) : int { return -x })
+	int a = h.handle(5)
+	map[int]fn(int):int fs = {1: fn(int x) : int { return x + 1 }, 2: fn(int x) : int { return x + 2 }}
+	int b = fs[2](1)`
+
+	simInterpreter := interpreter.NewSimInterpreter(nil)
+
+	err := walkTree(t, input, simInterpreter)
+	assert.NoError(t, err)
+
+	vars := simInterpreter.GetAllVars()
+
+	assert.Equal(t, interpreter.NewValue("int", "-5"), vars["a"].Value())
+	assert.Equal(t, interpreter.NewValue("int", "3"), vars["b"].Value())
+}
+
 func TestVisitLiteralExpression(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		input := "string a = \"tab\\tquote\\\"\\u{263A}\"\n" +
+			"string b = `raw\\n\"path\"`\n" +
+			"string c = \"\"\"first\n\tsecond\\n\"\"\"\n" +
+			"string d = `one\ntwo`\n" +
+			"bool e = a == \"tab\\tquote\\\"\u263A\"\n" +
+			"print(a)\n" +
+			"print(d)"
+
+		var buf bytes.Buffer
+		simInterpreter := interpreter.NewSimInterpreter(&buf)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.NoError(t, err)
+
+		expectedVars := map[string]interpreter.Variable{
+			"a": interpreter.NewVariable("a", interpreter.NewValue("string", "\"tab\tquote\"\u263A\"")),
+			"b": interpreter.NewVariable("b", interpreter.NewValue("string", "\"raw\\n\"path\"\"")),
+			"c": interpreter.NewVariable("c", interpreter.NewValue("string", "\"first\n\tsecond\n\"")),
+			"d": interpreter.NewVariable("d", interpreter.NewValue("string", "\"one\ntwo\"")),
+			"e": interpreter.NewVariable("e", interpreter.NewValue("bool", "true")),
+		}
+
+		vars := simInterpreter.GetAllVars()
+
+		assert.Equal(t, expectedVars, vars)
+		assert.Equal(t, "tab\tquote\"\u263A\none\ntwo\n", buf.String())
+	})
+
+	t.Run("invalid escape", func(t *testing.T) {
+		input := `int a = 10
+		string b = "bad \q escape"`
+
+		simInterpreter := interpreter.NewSimInterpreter(nil)
+
+		err := walkTree(t, input, simInterpreter)
+		assert.EqualError(t, err, interpreter.InvalidEscapeErr{Context: interpreter.NewParseContext(2, 13), Sequence: `\q`}.Error())
+	})
+
 	input := `int a = 10`
 
 	simInterpreter := interpreter.NewSimInterpreter(nil)
@@ -482,6 +3199,14 @@ func TestVisitLiteralExpression(t *testing.T) {
 }
 
 func walkTree(t *testing.T, input string, interpreter *interpreter.SimInterpreter) error {
+	if err, ok := visitTree(t, input, interpreter).(error); ok && err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func visitTree(t *testing.T, input string, interpreter *interpreter.SimInterpreter) interface{} {
 	inputStream := antlr.NewInputStream(input)
 
 	lexer := parser.NewSimLexer(inputStream)
@@ -500,9 +3225,21 @@ func walkTree(t *testing.T, input string, interpreter *interpreter.SimInterprete
 		return err
 	}
 
-	if err, ok := visitor.Visit(tree).(error); ok && err != nil {
-		return err
+	return visitor.Visit(tree)
+}
+
+func callTestFunction(t *testing.T, simInterpreter *interpreter.SimInterpreter, funcName string, args ...interpreter.Value) (interpreter.Value, error) {
+	context := interpreter.NewParseContext(0, 0)
+
+	function, err := simInterpreter.GetFunction(context, funcName)
+	if err != nil {
+		return interpreter.NewErrorValue(err), err
 	}
 
-	return nil
+	argContexts := make([]interpreter.ParseContext, len(args))
+	for i := range argContexts {
+		argContexts[i] = context
+	}
+
+	return NewSimVisitor(simInterpreter).callFunction(context, function, args, argContexts)
 }
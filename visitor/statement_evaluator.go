@@ -24,6 +24,22 @@ const (
 	ControlFlowContinue ControlFlow = 3
 )
 
+// Return is the result of a return statement. It carries the returned value along with
+// ControlFlowReturn, so that it can be passed up to the function call or program that picks it up.
+type Return struct {
+	Value interpreter.Value
+}
+
+// newStatementResult converts a control flow back into the result of a statement,
+// carrying the value along if the control flow is a return.
+func newStatementResult(controlFlow ControlFlow, value interpreter.Value) interface{} {
+	if controlFlow == ControlFlowReturn {
+		return Return{Value: value}
+	}
+
+	return controlFlow
+}
+
 // StatementEvaluator evaluates all statements in a Sim program.
 type StatementEvaluator struct{}
 
@@ -34,26 +50,31 @@ func NewStatementEvaluator() *StatementEvaluator {
 
 // Evaluate actually evaluates the statement by visiting the statement's children,
 // breaking out any errors that may have been generated into a separate return variable.
-// The returned control flow describes how the caller should handle following statements.
-func (s *StatementEvaluator) Evaluate(visitor antlr.ParseTreeVisitor, ctx parser.IStatementContext) (ControlFlow, error) {
+// The returned control flow describes how the caller should handle following statements,
+// and the returned value is the value being returned when the control flow is ControlFlowReturn.
+func (s *StatementEvaluator) Evaluate(visitor antlr.ParseTreeVisitor, ctx parser.IStatementContext) (ControlFlow, interpreter.Value, error) {
 	result := ctx.Accept(visitor)
 	if result == nil {
-		return ControlFlowNormal, nil
+		return ControlFlowNormal, interpreter.Value{}, nil
 	}
 
 	if err, ok := result.(error); ok && err != nil {
-		return ControlFlowNormal, err
+		return ControlFlowNormal, interpreter.Value{}, err
+	}
+
+	if returnResult, ok := result.(Return); ok {
+		return ControlFlowReturn, returnResult.Value, nil
 	}
 
 	if controlFlow, ok := result.(ControlFlow); ok {
-		return controlFlow, nil
+		return controlFlow, interpreter.Value{}, nil
 	}
 
 	start := ctx.GetStart()
 	line := start.GetLine()
 	column := start.GetColumn()
 
-	return ControlFlowNormal, interpreter.InvalidControlFlowErr{
+	return ControlFlowNormal, interpreter.Value{}, interpreter.InvalidControlFlowErr{
 		Context: interpreter.NewParseContext(line, column),
 		Data:    result,
 	}
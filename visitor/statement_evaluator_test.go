@@ -46,34 +46,47 @@ func TestStatementEvaluatorEvaluate(t *testing.T) {
 	statement := NewStatementEvaluator()
 
 	t.Run("evaluate nil", func(t *testing.T) {
-		controlFlow, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
+		controlFlow, value, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
 			return nil
 		}))
 		assert.Equal(t, ControlFlowNormal, controlFlow)
+		assert.Equal(t, interpreter.Value{}, value)
 		assert.NoError(t, err)
 	})
 
 	t.Run("evaluate error", func(t *testing.T) {
-		controlFlow, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
+		controlFlow, value, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
 			return errors.New("test error")
 		}))
 		assert.Equal(t, ControlFlowNormal, controlFlow)
+		assert.Equal(t, interpreter.Value{}, value)
 		assert.EqualError(t, err, "test error")
 	})
 
 	t.Run("evaluate control flow", func(t *testing.T) {
-		controlFlow, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
+		controlFlow, value, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
 			return ControlFlowBreak
 		}))
 		assert.Equal(t, ControlFlowBreak, controlFlow)
+		assert.Equal(t, interpreter.Value{}, value)
+		assert.NoError(t, err)
+	})
+
+	t.Run("evaluate return", func(t *testing.T) {
+		controlFlow, value, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
+			return Return{Value: interpreter.NewValue("int", "10")}
+		}))
+		assert.Equal(t, ControlFlowReturn, controlFlow)
+		assert.Equal(t, interpreter.NewValue("int", "10"), value)
 		assert.NoError(t, err)
 	})
 
 	t.Run("evaluate unknown statement result", func(t *testing.T) {
-		controlFlow, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
+		controlFlow, value, err := statement.Evaluate(newMockVisitor(), newMockStatementContext(func() interface{} {
 			return 2
 		}))
 		assert.Equal(t, ControlFlowNormal, controlFlow)
+		assert.Equal(t, interpreter.Value{}, value)
 		assert.EqualError(t, err, interpreter.InvalidControlFlowErr{Context: interpreter.NewParseContext(1, 0), Data: 2}.Error())
 	})
 }